@@ -16,9 +16,27 @@ type ServerInterface interface {
 	// health check
 	// (GET /v3/)
 	Get(c *gin.Context)
+	// get log level
+	// (GET /v3/admin/log-level)
+	GetV3AdminLogLevel(c *gin.Context)
+	// update log level
+	// (PUT /v3/admin/log-level)
+	PutV3AdminLogLevel(c *gin.Context)
+	// get backup status
+	// (GET /v3/backup/status)
+	GetV3BackupStatus(c *gin.Context)
 	// get categories
 	// (GET /v3/categories)
 	GetV3Categories(c *gin.Context)
+	// suggest categories
+	// (GET /v3/categories/suggest)
+	GetV3CategoriesSuggest(c *gin.Context, params GetV3CategoriesSuggestParams)
+	// liveness probe
+	// (GET /v3/health/live)
+	GetV3HealthLive(c *gin.Context)
+	// readiness probe
+	// (GET /v3/health/ready)
+	GetV3HealthReady(c *gin.Context)
 	// get records
 	// (GET /v3/record)
 	GetV3Record(c *gin.Context, params GetV3RecordParams)
@@ -28,18 +46,57 @@ type ServerInterface interface {
 	// record available
 	// (GET /v3/record/available)
 	GetV3RecordAvailable(c *gin.Context)
+	// compare months
+	// (GET /v3/record/compare/month/{yyyymm})
+	GetV3RecordCompareMonth(c *gin.Context, yyyymm string, params GetV3RecordCompareMonthParams)
+	// compare fiscal years
+	// (GET /v3/record/compare/year/{year})
+	GetV3RecordCompareYear(c *gin.Context, year int, params GetV3RecordCompareYearParams)
 	// record count
 	// (GET /v3/record/count)
-	GetV3RecordCount(c *gin.Context)
+	GetV3RecordCount(c *gin.Context, params GetV3RecordCountParams)
+	// parse quick entry
+	// (POST /v3/record/parse)
+	PostV3RecordParse(c *gin.Context)
+	// get calendar year summary
+	// (GET /v3/record/summary/calendar/{year})
+	GetV3RecordSummaryCalendar(c *gin.Context, year int, params GetV3RecordSummaryCalendarParams)
+	// get period summary
+	// (GET /v3/record/summary/range)
+	GetV3RecordSummaryRange(c *gin.Context, params GetV3RecordSummaryRangeParams)
 	// get year summary
 	// (GET /v3/record/summary/{year})
-	GetV3RecordYear(c *gin.Context, year int)
+	GetV3RecordYear(c *gin.Context, year int, params GetV3RecordYearParams)
+	// purge trash
+	// (DELETE /v3/record/trash)
+	DeleteV3RecordTrash(c *gin.Context)
+	// get trashed records
+	// (GET /v3/record/trash)
+	GetV3RecordTrash(c *gin.Context, params GetV3RecordTrashParams)
+	// restore record from trash
+	// (POST /v3/record/trash/{id}/restore)
+	PostV3RecordTrashIdRestore(c *gin.Context, id int)
 	// delete record from id
 	// (DELETE /v3/record/{id})
 	DeleteV3RecordId(c *gin.Context, id int)
 	// get record from id
 	// (GET /v3/record/{id})
 	GetV3RecordId(c *gin.Context, id int)
+	// update record from id
+	// (PUT /v3/record/{id})
+	PutV3RecordId(c *gin.Context, id int)
+	// get record history
+	// (GET /v3/record/{id}/history)
+	GetV3RecordIdHistory(c *gin.Context, id int)
+	// get auto-categorization rules
+	// (GET /v3/rules)
+	GetV3Rules(c *gin.Context)
+	// apply auto-categorization rules
+	// (POST /v3/rules/apply)
+	PostV3RulesApply(c *gin.Context)
+	// dry-run auto-categorization rules
+	// (POST /v3/rules/dry-run)
+	PostV3RulesDryRun(c *gin.Context)
 	// get version
 	// (GET /v3/version)
 	GetV3Version(c *gin.Context)
@@ -67,6 +124,45 @@ func (siw *ServerInterfaceWrapper) Get(c *gin.Context) {
 	siw.Handler.Get(c)
 }
 
+// GetV3AdminLogLevel operation middleware
+func (siw *ServerInterfaceWrapper) GetV3AdminLogLevel(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV3AdminLogLevel(c)
+}
+
+// PutV3AdminLogLevel operation middleware
+func (siw *ServerInterfaceWrapper) PutV3AdminLogLevel(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutV3AdminLogLevel(c)
+}
+
+// GetV3BackupStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetV3BackupStatus(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV3BackupStatus(c)
+}
+
 // GetV3Categories operation middleware
 func (siw *ServerInterfaceWrapper) GetV3Categories(c *gin.Context) {
 
@@ -80,6 +176,89 @@ func (siw *ServerInterfaceWrapper) GetV3Categories(c *gin.Context) {
 	siw.Handler.GetV3Categories(c)
 }
 
+// GetV3CategoriesSuggest operation middleware
+func (siw *ServerInterfaceWrapper) GetV3CategoriesSuggest(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV3CategoriesSuggestParams
+
+	// ------------- Required query parameter "memo" -------------
+
+	if paramValue := c.Query("memo"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument memo is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "memo", c.Request.URL.Query(), &params.Memo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter memo: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "price" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "price", c.Request.URL.Query(), &params.Price)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter price: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV3CategoriesSuggest(c, params)
+}
+
+// GetV3HealthLive operation middleware
+func (siw *ServerInterfaceWrapper) GetV3HealthLive(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV3HealthLive(c)
+}
+
+// GetV3HealthReady operation middleware
+func (siw *ServerInterfaceWrapper) GetV3HealthReady(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV3HealthReady(c)
+}
+
 // GetV3Record operation middleware
 func (siw *ServerInterfaceWrapper) GetV3Record(c *gin.Context) {
 
@@ -156,9 +335,204 @@ func (siw *ServerInterfaceWrapper) GetV3RecordAvailable(c *gin.Context) {
 	siw.Handler.GetV3RecordAvailable(c)
 }
 
+// GetV3RecordCompareMonth operation middleware
+func (siw *ServerInterfaceWrapper) GetV3RecordCompareMonth(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "yyyymm" -------------
+	var yyyymm string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "yyyymm", c.Param("yyyymm"), &yyyymm, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter yyyymm: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV3RecordCompareMonthParams
+
+	// ------------- Optional query parameter "with" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "with", c.Request.URL.Query(), &params.With)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter with: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV3RecordCompareMonth(c, yyyymm, params)
+}
+
+// GetV3RecordCompareYear operation middleware
+func (siw *ServerInterfaceWrapper) GetV3RecordCompareYear(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "year", c.Param("year"), &year, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter year: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV3RecordCompareYearParams
+
+	// ------------- Optional query parameter "with" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "with", c.Request.URL.Query(), &params.With)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter with: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV3RecordCompareYear(c, year, params)
+}
+
 // GetV3RecordCount operation middleware
 func (siw *ServerInterfaceWrapper) GetV3RecordCount(c *gin.Context) {
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV3RecordCountParams
+
+	// ------------- Optional query parameter "yyyymm" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "yyyymm", c.Request.URL.Query(), &params.Yyyymm)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter yyyymm: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "category_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category_id", c.Request.URL.Query(), &params.CategoryId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter category_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV3RecordCount(c, params)
+}
+
+// PostV3RecordParse operation middleware
+func (siw *ServerInterfaceWrapper) PostV3RecordParse(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostV3RecordParse(c)
+}
+
+// GetV3RecordSummaryCalendar operation middleware
+func (siw *ServerInterfaceWrapper) GetV3RecordSummaryCalendar(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "year", c.Param("year"), &year, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter year: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV3RecordSummaryCalendarParams
+
+	// ------------- Optional query parameter "bucket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bucket", c.Request.URL.Query(), &params.Bucket)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter bucket: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV3RecordSummaryCalendar(c, year, params)
+}
+
+// GetV3RecordSummaryRange operation middleware
+func (siw *ServerInterfaceWrapper) GetV3RecordSummaryRange(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV3RecordSummaryRangeParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "bucket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bucket", c.Request.URL.Query(), &params.Bucket)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter bucket: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -166,7 +540,7 @@ func (siw *ServerInterfaceWrapper) GetV3RecordCount(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetV3RecordCount(c)
+	siw.Handler.GetV3RecordSummaryRange(c, params)
 }
 
 // GetV3RecordYear operation middleware
@@ -183,6 +557,88 @@ func (siw *ServerInterfaceWrapper) GetV3RecordYear(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV3RecordYearParams
+
+	// ------------- Optional query parameter "as_of" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "as_of", c.Request.URL.Query(), &params.AsOf)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter as_of: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV3RecordYear(c, year, params)
+}
+
+// DeleteV3RecordTrash operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV3RecordTrash(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteV3RecordTrash(c)
+}
+
+// GetV3RecordTrash operation middleware
+func (siw *ServerInterfaceWrapper) GetV3RecordTrash(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV3RecordTrashParams
+
+	// ------------- Optional query parameter "num" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "num", c.Request.URL.Query(), &params.Num)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter num: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV3RecordTrash(c, params)
+}
+
+// PostV3RecordTrashIdRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostV3RecordTrashIdRestore(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -190,7 +646,7 @@ func (siw *ServerInterfaceWrapper) GetV3RecordYear(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetV3RecordYear(c, year)
+	siw.Handler.PostV3RecordTrashIdRestore(c, id)
 }
 
 // DeleteV3RecordId operation middleware
@@ -241,6 +697,93 @@ func (siw *ServerInterfaceWrapper) GetV3RecordId(c *gin.Context) {
 	siw.Handler.GetV3RecordId(c, id)
 }
 
+// PutV3RecordId operation middleware
+func (siw *ServerInterfaceWrapper) PutV3RecordId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutV3RecordId(c, id)
+}
+
+// GetV3RecordIdHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetV3RecordIdHistory(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV3RecordIdHistory(c, id)
+}
+
+// GetV3Rules operation middleware
+func (siw *ServerInterfaceWrapper) GetV3Rules(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV3Rules(c)
+}
+
+// PostV3RulesApply operation middleware
+func (siw *ServerInterfaceWrapper) PostV3RulesApply(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostV3RulesApply(c)
+}
+
+// PostV3RulesDryRun operation middleware
+func (siw *ServerInterfaceWrapper) PostV3RulesDryRun(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostV3RulesDryRun(c)
+}
+
 // GetV3Version operation middleware
 func (siw *ServerInterfaceWrapper) GetV3Version(c *gin.Context) {
 
@@ -282,13 +825,32 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	}
 
 	router.GET(options.BaseURL+"/v3/", wrapper.Get)
+	router.GET(options.BaseURL+"/v3/admin/log-level", wrapper.GetV3AdminLogLevel)
+	router.PUT(options.BaseURL+"/v3/admin/log-level", wrapper.PutV3AdminLogLevel)
+	router.GET(options.BaseURL+"/v3/backup/status", wrapper.GetV3BackupStatus)
 	router.GET(options.BaseURL+"/v3/categories", wrapper.GetV3Categories)
+	router.GET(options.BaseURL+"/v3/categories/suggest", wrapper.GetV3CategoriesSuggest)
+	router.GET(options.BaseURL+"/v3/health/live", wrapper.GetV3HealthLive)
+	router.GET(options.BaseURL+"/v3/health/ready", wrapper.GetV3HealthReady)
 	router.GET(options.BaseURL+"/v3/record", wrapper.GetV3Record)
 	router.POST(options.BaseURL+"/v3/record", wrapper.PostV3Record)
 	router.GET(options.BaseURL+"/v3/record/available", wrapper.GetV3RecordAvailable)
+	router.GET(options.BaseURL+"/v3/record/compare/month/:yyyymm", wrapper.GetV3RecordCompareMonth)
+	router.GET(options.BaseURL+"/v3/record/compare/year/:year", wrapper.GetV3RecordCompareYear)
 	router.GET(options.BaseURL+"/v3/record/count", wrapper.GetV3RecordCount)
+	router.POST(options.BaseURL+"/v3/record/parse", wrapper.PostV3RecordParse)
+	router.GET(options.BaseURL+"/v3/record/summary/calendar/:year", wrapper.GetV3RecordSummaryCalendar)
+	router.GET(options.BaseURL+"/v3/record/summary/range", wrapper.GetV3RecordSummaryRange)
 	router.GET(options.BaseURL+"/v3/record/summary/:year", wrapper.GetV3RecordYear)
+	router.DELETE(options.BaseURL+"/v3/record/trash", wrapper.DeleteV3RecordTrash)
+	router.GET(options.BaseURL+"/v3/record/trash", wrapper.GetV3RecordTrash)
+	router.POST(options.BaseURL+"/v3/record/trash/:id/restore", wrapper.PostV3RecordTrashIdRestore)
 	router.DELETE(options.BaseURL+"/v3/record/:id", wrapper.DeleteV3RecordId)
 	router.GET(options.BaseURL+"/v3/record/:id", wrapper.GetV3RecordId)
+	router.PUT(options.BaseURL+"/v3/record/:id", wrapper.PutV3RecordId)
+	router.GET(options.BaseURL+"/v3/record/:id/history", wrapper.GetV3RecordIdHistory)
+	router.GET(options.BaseURL+"/v3/rules", wrapper.GetV3Rules)
+	router.POST(options.BaseURL+"/v3/rules/apply", wrapper.PostV3RulesApply)
+	router.POST(options.BaseURL+"/v3/rules/dry-run", wrapper.PostV3RulesDryRun)
 	router.GET(options.BaseURL+"/v3/version", wrapper.GetV3Version)
 }
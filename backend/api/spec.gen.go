@@ -18,35 +18,145 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xYbW8TyR3/KqtppWulzXl2nRy5fdfL9aqoura6F0hRsKLJepzsdZ+YHRssy1LWhhKe",
-	"igQNlKq0VKWQQpsiQlEhUD7MxCF5xVeoZvbZOzEJiUNfeb0z83/6/f4Psx1geo7vudilATA6IDCXsYPE",
-	"o4koXvJImz/j88jxbRwAYz5bWLDqwIBq9t9FDgYGCCix3CWQW6Btny9Yruk5GHRrKvCJ52NCLVxQJSR2",
-	"QLqd4iVMQLekIt0Sq+qWdHXADwluAAP8oJI5WIm9qxQ3d7sqIPhs0yK4Doz5gjXDqocV1VRALWpzW9J4",
-	"qYl13uL32KRS67DbdLiuOCQq8Jp0yYvCFqBW9GC5LRxQ/lxT8xAkpyTKIwXqiPi0MSILQdNx0Ieg1aFW",
-	"Bnfvr3/efbYlATfngOk1XQoMfZLjbJkYGPOTUJ2C6hdQPQXVaah+CVUNQlXToKrp/FnVoVqF3CGPIhsY",
-	"p6bh/x1NUsdkqmNHO8Ci2BHW1nEDNW0qUiQX5CoUbpYEOOj8bHRU01XgWG7uX7wbEYLafG8cpLIdR2By",
-	"4l3iSqJFRrIChyR0J9j0SH2IXeOAs44opla02PCIgygwxMsJ8VaSBw3iOVJR+xnhYMeTHkgRL59JyDV0",
-	"ZgidIihSiFL/YsNj0RlGwrocRHHk98VkIaVwEQtRjqR8KkpeSEgikX92QYr7ENA833O4AR3qVahBLXHR",
-	"AHUriJ2IYg9Sfw1dg6nqj+kjeb7kC6oO4RdQgzoPpY8oxcQFBpiHE1/WDkWi8ZGlyJNIXAH3NPolbLgo",
-	"y20Iw9LCDxx0jhtBJpBvTbSqIJNVXmlhElgeD4n2OeQ2ez52kW8BA1Q/569E1JYFBpVWtcJ/lzCNqmBg",
-	"Esun0fFljGy6rJjL2Pz1GRfZ51A7UAimTeIqn81SxQoUuowV4nlU8dES/gwIXQTx87N1YICfYc4+ggPf",
-	"c4MIdR3CsqZf/lyEMMCEGy9oWNxQ4faroElsbhelvlGp2J6J7GUvoMY0nIaCYFnA8rYL2dzTGJaYf7HP",
-	"JYtPV2eyfXLrTc+lOMpM5Pu2ZQoBle8DbmyerB3w0+hZ0fifFrKbWJJlUDKYDW78dnDxbyMGM7U0Acik",
-	"bLzY3eyPmgBKck7J5OxcWdvdvCS1Jhl7ujWBoWjHhd56kK6d5VbSOLmwcbNkCVMlR4qEJ1lllOaFVWfh",
-	"xt7d63v3f8PCJ9+Jzax3c3Dj9uC/d1h4l/WuspXeGZf1L7H+Guv9nfWfsP4qCx+x8F863N56MbRVljen",
-	"q98lFcJHBDmYyn3mGeg2nUVMFK+hRJYHYhwFBjjbxKLnxyDyvpFHKB16dKhKuklHKsRrNAKR1pmcgx5t",
-	"t9ttx5Edzeqo/GSxoI7QXPuohD0kaWN+fDLKJijzVuUFEoay/j9Yb5P1X7P+Zda7qbHwwe7bN4Mr9yPK",
-	"lfj2Ky/IE463MhzQr7x6+1DBGx2ztOd1u1G/LMCkHaOmWItaEHLesT9CRgnRGYIRxfXjhtUUYpWc7Vkh",
-	"qqAWsmy0aON9SxILb7Fw4+uvWPjk3d2tvWvPWLjGetdY+JCFF3iRyRFicHF9+w3fzlbCwcvnO39cff96",
-	"dW5ubu7bb9+/vszCdeUbKzCRrcxhRH7UaP9YYeHG9n9Wdh8+Yr2bu29/N7j+PKty5IzLVYR/EOo2hKKX",
-	"rH+P9Tf5Q7ixd/H6YPUO6/XYSrhz+ykL77DwQlQ6We+NMGl12NrRJfEnaTjG0p07oNGOxkx9Eqhi5OXD",
-	"W1y8ooUpqEdLU2IW5lu1+M0krManNB3UusWeWJyAG+1CySnOuPoUqEmm2eHbZWLWCDlQO4CkrnQSHXc1",
-	"iwiuZAQfYn56AZKyfvvtvcE/fz+C6izc4GzferGz9pRz9y/r7x68OlDbnYlvTmPhl7i/aXp1cogdH65I",
-	"8Y3uJLFJNBZwiXdVOm2MSHdfgPiqMnj5fPDqIa8NvX+z/p9Y/zEHp4jGKCh4GSpPQWJK4PeY3HgRbcwu",
-	"YpQ08RimhcOO95/601z5oqCX7WHhY9a7xcJ1Fl49sW+GR7gwFD9rHXAUU8EknCzT9BceVb7xmm59HMOa",
-	"yIHUzmIadax6NzLHxhSXb6Nfi/dJIszWD5QGVv2oSSANkTITZ8UxxyjyPZ58lAbxHMUSs/X+9/MTDgc8",
-	"/tH0RC8KWVBj9qVfiEZ9BDkdbzpiOIoTz2LTsuvSb24E56wqre6/NvTlrZWzOnuO1NY+yYjDcUgs6R6D",
-	"7O7/AgAA///QNqWIBhwAAA==",
+	"H4sIAAAAAAAC/+x9e3PTSPboV1Hp/m7tbq1DZDvh4f9mhn1QO3N3ipndulOQm1LsTqLFljySzJDlpsqS",
+	"eZg8JkwGCI8wDI+QQCYOLAwLcYCq+1UU2c5f+Qq3+iW1pJYfkLAwv6mldhy7dbr7nNPnnD4vnRazWqGo",
+	"qUA1DTFzWtSBUdRUA6A/RuTcsA6+LgHDhH9mNdUEKvooF4t5JSubiqb2F3VtJA8Kv/+HoanwN3BKLhTz",
+	"GMIf8GchCf84KedLAH7IAVNW8mIGfqPkEBRhVFbyIJcRcrIJTKUAMkKhZJjCCBC++uqrrz777PBhQdOF",
+	"o3/8REin04fEhAh0XdMNMXPstDiqgHxOzIj0WTEhFoBhyGNAzIhtwUwOJURFNUxZzcKx/XJR6T+Z7tdB",
+	"VtNzYkIkux9WIHhp9ODIfjkF+tLZZK5vYHQQ9B2SD4z0pXID2f3g4KgkJ0fEhGiYslkyxMyAJCVEUzHz",
+	"EPLfwzsVE6I5UYQ/lXQ1U5C/UVQT6BmCzIyPGXFycjIhGtlxUJAh8v5LB6NiRvwf/T7h+vGvBiUFfiQH",
+	"jKyuFBGMjPixnBOOElpOJiAtR/NKdo/oivEnJFOCYghyXgdybkJQVMHUZWNcbIPy/mTqrbF+yMf6J2SX",
+	"wjeKOS6Y40DIlnQdqKYAh4MONPBwtDsU+MQDBxFgAl2V88MG0E8CfRgx897QArObYGrCGDAFjGZD3Du2",
+	"H2TZ/gjZp/AF2qfwB7RPD+3yiFYyMyN5WT2xS0jmTziZEAuaao4P57XsCZDbG0SjGYSUlBqUkpDvyVx7",
+	"y+2ptI/sz9AC2JnbcTdabh8ZujvIb97ZcGvXG8+rjvV6Z7OK1pOfGIaMr+iFnc0LjlVrLFYdq+ZUfnLs",
+	"J05l06lccKx1996Fxo2njrXsWLOO9dCxzkCSqZo5PKqV1NyeCilVMwU0S0Y4dOhQW3Lh399OOg349DoK",
+	"DK2kZ4G/hA4kUzWzD4/bHXr9L80U/kjgUXBE8WdPlIrDo0oeoayoa0WgmwpGa1YHsglywzIixqimF2ST",
+	"KN8+on3JJgxTV9QxREq5gCBFfjCUfxKqsAtzKpcd+7Zj33Mqq479M/xgb+xsVp3KRfRldWfzgpjw51ZU",
+	"c/+APy/E2hjQ0a4gtRQdnvljeBUJdgNkAUM+UditewC1kX8ALLjJz5SeYdwAVR7JYwFDHh3RtDyQVfgs",
+	"hGlEN7v1+qa7dhWfGnQ0LjqVimOvO/Yd+KGywGJjZ7PauPLIsRYc68z2j+cwHhQTFIxOfMBubNKXwLou",
+	"T3ga6aScj66vdf6hO32Zsyyr5tZutW7PbF/5fvv6JTHBHL5jYmpgXCpIhjjkTeUT/QQAxeGcrOQnorM1",
+	"FpYc65JjrTjWamOxjDZbS27Vnzn2/Nbrm40Zy7GuOfZ0Y2GpcfkRh+YEfF42TA50BneOtcoC3Ko/awuw",
+	"gKUZB+ZitbsVL1bjJoCL9W2ACL7Qz/QwhkTujaet1995pHCs5a2Xi43qRbTLWyznuBdnxUQsbFUxxns8",
+	"0+hJHRilvIlZv1SAhDdK2SwwDHg8ZSVf0oE4FPewYcq6+Sazkjl6elAFp0zyQIh+P912b/zgoXBrowqV",
+	"2MJS45otJroCHhIzVAhEpQoRGxy5kpVNMKbpE0Edduy09wNSN5I/cBiLVLoG5gePvbJaAaAbTkh+sxBP",
+	"c7gxNAWHIUNztRc8wcFhZLGrCU8dnohBKP2hHS6H4WJkXSGmwXuFhYQYXFyIJdcvtTYrVGzc2r7yvWPV",
+	"ts9/t317lis/yK0mCshdf9V6fBuZXZ2h5JTRUaADZPpEAP27tn17dmezSi9QfYK/AayFogCLQM8C1ZTH",
+	"YgHClcXv1bFW3fVX+Cf3wr/ci9Wdzer/dMqW+2iucflR86efklsvZ6FdWbbbgpkRJAEe8B+fuherjrXe",
+	"XLSal5fwYH/laqkwwrEaeuFQnxQBAgdwy+Hi4cDYeIYuAl3RcsNGqVCQdY4q8gZOAFmnwwTHWnEvzjjW",
+	"VfflHWhjl62irmSB4Njz7syGWz3v2FO+BoPq6p6YeP+OSwkzOIfN4G7gT54dFB0TNndMzcS2TgeTsUfi",
+	"o0XSFdFZeAQPUbId0Y3S2BgwTOU9lWLqqJKjMqMgn1IK0AxIJsSCouLP0i4fMH9GHmIZbHWHVI4VT50k",
+	"Ubn87Ypbuw4Nx5evHesusrDYi2ytjREZmrErm523qwgzh7Dpe3jYGdujqq1J4jEGtfCIZZEQtZI5pmHr",
+	"w5BP4g+KehLCVMfglOyFgDzFWwihbHvW7E6b74IqbqteO2nN3pTgO1Bab6WWwnhvxyWsymlvxaakZNSO",
+	"3b57q/WvOseOZZiM6IDUgCfzjw1IiUEpsV9KHJASB6XEISmRlKREMiklkin4OZGSEmlpyBP4Bw5K751F",
+	"3KNyy4FRGd25pMABS0vSEE/uFORTR/CjyRSSysxf75tWDPAQj9koLofHgZw3x6OCgHr3IvfkqWeNs9OO",
+	"VWvdXWw9e4J8Seeh2LZfO5Vr6MMLx6o1V2rbt39wyhb8015Dcv0H6pTZdOznTmXZqTxBPsoHAbPXZwXv",
+	"Hh8yeKsPmpdWWB8nPdm15sVzzUuP+bddE6jZieECRxk172y0HkIb2bGm0T+ojxrX7O0r36Pt3XIqD5vL",
+	"8yFXWU4rjbCuLSo/2vnoPGdXO6bGFEFXXBDneSOQWNKHKcqhOgrv+e6RIMHRjxyn2vPZxtpdx3q4/ePZ",
+	"5o1ajPPDixKe7nClx7P4DzA7YFfHWXwcowbDrt1ZA2FccezaXaBVlEjxpAkAYkwE7YSYEEuqfFJW8tAZ",
+	"EoU2HIrAMTyvjQ3nwUmQZwHmwEgJmxejmpgQv5F1lcaAWdj+szGADWAi4yRCD2/GdnjzwYeRhr8NrYRO",
+	"xkFcUdYNkBsGqqlPRBez1/qGBPo6PKaDr4fJSPhMieu93rawWIPuRuwpdqvnoAy1aq37c+6F89CEuTjr",
+	"XphlHdWR/UQ10QmgcqZrPfyBXlVrreW72+er3fq/CcYR4C6N6C50G1knRQ/DAAEKx3MAXk+EA04oao7l",
+	"f8bfRdVoDp+fAihoXBerCU6ZnSUbGpXA80VXj1fHW33EExFc/0gpewKYnWhCHh8mo2F8BX3iEJ7xVBBn",
+	"1s5m1Z2758VBnLJNkKQAAxrOAvFyUO/H9o/nuuWV4LqGdVkd4wZN/Al7v9KFMMiBPqprBV64gdwT3OrN",
+	"xsLSzmaVZrbE2COm1gZIY7Hc/NnuAk5YIcK1IdiUZqJPvABiWKbq6PSgwcqu7TrHgrtw7BWn8gBaaFat",
+	"9eBJ8+mjWMOMJ8KQieCJlca9xdbKpgcSRtyC3zCXshqKdHcdg2NtBW4MjkaeI9v0555pXttoXrpFAzwP",
+	"UUhuBdqrFRxZ/86xX/C2z8atowHXABzhyOGdzer/7iMJQ31HDuMD5lTWHPsRxlHz6R3sCEHiP3gp5dmO",
+	"wQn//OWXnwtosnPECrdfeI4Urv+EMFEbzFDr3ao17tut+xb3MEwUuwNiz7duw30Jfzt6xCnb3rfIP3oL",
+	"G/AC3kWA/9YFNqkl6ALpENQfYsz0kq706YDezTudRqqMEIp4ZjaZjHvkSvoYYOJ4wXOH1E/H+yAcxc7G",
+	"guRM+XVJyZ6Is32o2mIRh50DQjJ1UBIQlzxxKt87lWmhcXWlsbAE5y4o6qdAHYOmdjLRjc5j1suuh7Nc",
+	"31pi1rQXTgQvf7HroCZVEZEf4haBrAXeA56fIfqMKY8ZPPd6G+uNnLL2ZAg6D7iuBCajk2oczOrUCEL7",
+	"IUtkKOrZbzHEHPY8Lt2yexDyMPVpxME/CXSD67GHn2XqzPesO5SRIibEUpFYdTmQB+iDDgxTiwmid2vG",
+	"eyY8+tRjwJ3ZSQcpQEcmmE0GJ6V/RUk17D/MQal3D2nrV4QeP+YMiSkplZaSUpIyT0bMKQbhC3wORI+T",
+	"MqmkH6foHDQPORtQykXjmu1Yq9RLC7Wz57rlXox2NqujQDZLOjD2odsDTpBz7FWoFO2nUCeX7caNp40r",
+	"jxDsdff12e0fq07Z5odvGekRXJ+X+uxYr5DyWvcyoAX35R13c84p2x030Zx75S6u4MQIp2wxC+OPp0l9",
+	"C1hZ4mUHUoQkab+UlFKQG4qyaQIdLvaY1HdoqCdZ11mmcWJRHPkWS9T1uIstslseQVvh9Ut36kdsBoWI",
+	"1pg5j2JGyFzD463l5suaY8025m44VtWxp3c2q1wUevDdC1Pb1+5h+NgUY03OgnyK6r6U1EEVBhzC0puK",
+	"bozZwCn2zijvBJe4phsbO7PnMZIda5niGW4WozrIOHDDZnac7HyYUDktSRLmhWEdjIFTyKyrO5UrTuXJ",
+	"/4WGKzQzX+I/UFYUdJD+4Ds+RdawwHJB0xVzAqPJAGZEDGCRgZjnWPDpocmoBPHW3FZWl/JgGI9kPLId",
+	"COqvNBgcCF2g72HP8xnHqjvWA/fMQ/ds1eNYkiDgnd3a9earB/jGzAllArOrjchZUsDAdQbjbWJoLCeV",
+	"+JmXLMSoW4icyNWt5+XW+af0csTGZldbK2voIKJskvIbpDjw5UTg6KPz2pujK/a8sQihG4/FS7GYn2Br",
+	"dXrQXl6OUCiWzSoi4chhLid0cAr6JxheobiakRDlsmPPONZ9yJ7Moe/qUo0YhoPZiYmJiUKh+/26L542",
+	"FqsRHZUaTEpBDfV/kIo6vX/yv8RER7oFCNOJfPw7WHYc+p+6dzEhkPghHl7UEg8pRFljOtza2ay2HtxH",
+	"CaW1iEJHY7AeCmKwcfmRU7aNE0qxCHL4Xmyj/593L64i2wOl9ie6uU4mvG0PxaA07nbJbj+CS3nUBHr3",
+	"FvMIGNV00P34mPPASqWQVxx6MZEodjfuw+B/JKe7owQh+I4/hV7EkcQIcbjR92tZtW3rsjs365QttoKD",
+	"FmuQUKdTtoMAb3XITIgxHQhKE4QUHM85S8A4+nralKsH7HlWAoW59OZtmJxdtlnLjHxpz7tnVxzrfmOj",
+	"jAzla3SPnmIhewwFIbme2ua1+vbMv9yzFUjj2ox7dgUDifHTMrZMhJIkJaS29Xxq+9rFnc3qVn1p6/l0",
+	"XOIlsoKymmrKCi+I4lRuOxXovYPH0i43rpx31xbc6gI89dOP3TWU2n9vmXz/aA5/cCp19+xKa3nenZ1q",
+	"Lc/T7MElz8KP2xdjksWuhEVvY+1u6/5c6/ZKc+7Vzmb1Txry5iMIRfixsTzduHI+bjZF7QKL0wwWp+Kw",
+	"+A0AJ3LyhMEtUkDGfa1xYxEVLNSQXXUdCc9p9vTSG34BK+8SgMFLXJs1XkIXUwVlT6FSlJLKD+SEI1WB",
+	"g0LNqMg5CQVXWOMQ16qJCT+8Kk+IeMNiwvvx65KswyPKHM0QTM5iuaGTzOmuDgwb43mb6EYwVvS2AY7Y",
+	"7ZPNtUF9FylrCk9fhEwvASsG6ID32YymdNt2KGGMfRhqbXjGLU/19hakYjYQH/7CwVBezA7JUs+nvrNZ",
+	"xUmACYEmdSUEnDiYELy8QYGrBbsOb3dack9Z9x7P+J6Trc3rrZWq++Kpu3E/hiW7zcZ/E+jhRCx+Wh/D",
+	"WxEqcdi5fYYfKtoGOcYF9x44vrF/tDdP5q/OcsZZHkAhwxMhakf4YXKSJOG0C6Jh/+KBg9IB4bef49CT",
+	"cBhFcA1hVNNx3Oyjz48Yv6P+R6smxJX1Co613Hp9CZ7Lsn1chesRkG8sLma3AL2TsSHbdQHHgQXHWg1F",
+	"fokZjK8rtoU9I6InzqHeJNG7Prmo9J1Miz7eor94jnMxuU+ChNaKQJWLipgR0/vgV+hOOY6YBBYZw/+O",
+	"AY7YwAlTQnYcZE8cV+X8N/KEIejALOmq8JsjJqz4hq0NdE0zhaI8Bn7DeuCP5MSM+CeAfe9Mb4+UJEVn",
+	"+utfcCUwqp3HrrbgAFgQLSbEkp6H6zLNYqa/P69l5fy4ZpiZg9JBCfnOfYSxa0ew4U7lXEFRYTJVn5d1",
+	"xd04Kch7voYu6yj0XPkJpWuuQiLNXXFfLXgeLN6m/57+CE71qTb2KUkK4+EgprS8+5Jysgt8LnoozWZz",
+	"xDjl2ZAaCXFAGoiD422m3y+V32X6wZ4ReW1MwFtE4eLeCUX8BtTVeG629fO/kav3BjRmrFUBZfcJ5El7",
+	"fut5uXHNbl4/A68o5zfcqRv4YXqE4Y00lHuARAOdaB0VTa9B9y/O6v3Z3to4h5TscmPxgjv1ApdBMStB",
+	"MKwV4o5iy88r9eb8I/dOBQK//Mip1OGvlQc4D8It34M1U9V6DA9+XuLyIPIGfazlJnaV/RAW34L/Jt/o",
+	"eOwqq0udWZ1tSvQfPx44XsueECLicMFvv5/+whVwbUrrKSfWQxXezZ8vNn5YdCr1ntoGRMXlcTV+8pnm",
+	"mdvokDxklCYuas4Io3LeAAJOfUKRL6KZ+fL3Y4SHL2jd856IX6/pgqmXgNdm4ViwUwXy5PZJ6T4p+aWU",
+	"zkhSRpJ+Lx3KSFAPkwiQp8PhWCktJfskGFWS9sG15GmziMxgaiCdnBxieyZ47Q6CzQ0OBJoRHAh3EoAl",
+	"GExtf+f5o8X6nG0lvW0FKvSZwvxI8X1b5EQq7tvO6ZXZ+6NS4VE9iKhg4Xys5BjsRnLwmy7tgcLEixa8",
+	"RWORELzwE3nAOTKfsJe3PTgwkbQJidNWwJ371j271KatQCJS1MWDUnvWelJpV9QVgXOAB6cxdbn15Dx3",
+	"NbTacHIoyFQ9eQ44XrYPgtEYlopyWT+p74zVPtvWt+639VAgDJYOQdfRrWbtsXvuLHSlw8SDOZKcgv21",
+	"lbrn23Yqdep/mnbsC6h0ivU/1dzytdbdRceeb97ZIGOYDizMxc4v7IXXO0FyyotJqGeCz10mpht1LfFn",
+	"g9GVamulCp1iEMgqNBqplbh2v/l6nhsibs69Qr416CtDlQY1rFz9hIyyRWuA/TouCMe2mg824BXy7Kxb",
+	"XeisF/1D/gWhEqoIkAvA5PMHxjyqgEEZiwDlKlLFhS/2vr8Aa0L/NHTIjoyNXMTMR9wLPvyOEL22Ezxw",
+	"1D8RWG9swk4UOMa1R/rGYtm9t+yVYvNmzCsFxQzM6PnG0wmmjF1i6tiTnKUM7ZFJ41WgJwdhRk+gdvwY",
+	"Lw3lzcpp/dp9ad/BA1GZPsiTxQtLzUsr7vdWL8ClQ1A+d631uSXqu3pteH/kONkiT5Zj50l/XjkJYoV4",
+	"8Ko7475ebK5dItIJXmm/h64te54WixLrf+sVvDzQnKWbSADOos8onksG+5l7x9W/lEaArgKT1NbANanA",
+	"MKB3DwjRyzhf6v0ZbehTuJ89Ojj0wgUrEHtgOFpQyWex3SU4RZ0AnZwgTGzUK7UNtYP1HvBat+BY323V",
+	"rzrWd1BHotQMWu08jRwl5+CHCAscV3kV0M+h/Px2qfnsOuKbtkXQPsgFpPa5zERcq2RFjF48rrIxU6TJ",
+	"uQBmYguny5YXN3HsfzuVu+j+WnUqZce+71SeYH+Uf4UdlNJCcAUhpoa4V96Uq48iwu0RW7PVwsdOB8rC",
+	"JU+pYR4VE8FDkGBblE4YX+N7pP98cl/Kg5CTTXlENkA7GJJ0YFjO0QDB8LhimJo+sS8KOL1vwF8aPoUh",
+	"sEOJvTmvULyn3wO8k5J1pgVvEQY4KZYzQk6R84KZLQpJaR/8XzKTTkv7M0JWU1WQNb0PsG20DkZLBsoc",
+	"YFeRkiR/ITzysUXYk0NxP+wG5mHHXVhw+bcA4F0Vnd4BDclOPzTJlZpKDlrm12bxleMoGszziCGBeNmx",
+	"HyC/bxWJnPWUtFV/1lWs4SgNmbU15GGoBjddELTRQD/mqJmKE+E4Rmoqxi7mAdFGRw1g8sz1jo+SFEr2",
+	"0W7TIPkAgwHMNgsaektfdHeJkl7eXneX/w/b0GTbf8NAjmaYHXPyk451j01wjgY4NIPl/N5CG91JHLYN",
+	"AS9IkdzFmcgsiQCQU4X8G8CI9n/H3ug3DnSk0p2fCXQ5f6+4D/viBQbFTGNtX2HEGr6ozvvwx9BJg5wT",
+	"oZRxlm9houVLOBz6iVBSt5dpg7xYK8IfFSMr54WvgKz/dnTidwLKCSy37i9j89Cd9aOVx/WAoYp9ZS+Q",
+	"lfoERxepywcVRQX7C6OCk02kSoKrba9CPvLQsUfm5OgEyW0fEBPwP2lxyM+XRz8MSin80yCqn4NDk+Sb",
+	"ASlNnkqmxPCtPpTmNxGQxeHE+i6yHdk0/lg4UrLLvMloKsv77eMlLeqD9hRzbnDiFsDHvv80RtVk7CHC",
+	"vwt+F37GdwoTeSt1nEgDP3t96Ox5p2yh92fg5+Jq1dwLs+6Lp8jzCkuJ0Eh42NisOmRlsTluaBE/w+tl",
+	"5SHutcDsjuCh//QEkPVJ7/UBodWhVoznOpyoTzCiPiOZrSEDDVkqMBeHY/nE+1S7t4TapRkGZFOMGQix",
+	"/yZG2F55J9lI1i56I/3MzIGD6FrjJVIO4msO22pxAH3DNlc8uC89ORSaIrTAYAwrOCc7X2guaTIRBbLb",
+	"S+dM4TXVfJt1+iGyHuAMBcdi+S8xfSQztDKqh6sjJ930F2pqE7ksILlsxEltKNaIcIsV2aywFOBQoaPc",
+	"xpEvVnoHgEBh0l6G49zjqOjuQsBCi6o7+YoHxkvXzlEfdnXsDrsVort+5/yV91neH8U2NiQ05wSQ7ht8",
+	"nkeZTW0MfK/RMs74bd7b8HgU622nUmeUkoDa9vi1Xh67k6KvYPWRD7l9BJfyPeltyuH4D9yZ0tkOQMVD",
+	"yVR6oAclEOif8gs9AsRsp3sMsD5qrIdoz/e/lGc6NDtyyrNOeSaVlNAI+BdUCFUU5nnYfLDRvPaSrX9q",
+	"3lpzrDONK7AoEjdMxOEaeovmFEi65866tRfsCQgOWKfPsvFBNBaeLnLFh4fOsW7CxcEgzSvh879+8aXg",
+	"IwKl35etrde3veRbCpbGLiHYPoFVdpnAX+7FWViTWF1yL86yzVS8cyHnFdnAfVWYWJH3bgmcE9K4/Ahl",
+	"8c5s1a+gL20m07iGf0VJMYGSrJ3NC3BxGFZG8IYlE5AolTqkjXvunFOp/7+lJP4KF0/CkWsL5PHGwtJW",
+	"/WpG2KpPQcpW6oTElTpMgfY+16e2y4/73bPV7fJjr+gQ1u8uVhs3Ft3labg3ewoVZNeZkkT4EjacPop/",
+	"+qz/sFOpw9tGv/+x77O+w3HV+ZgipNQR1TwiiqBUlIyw9XyqtXJ1q77k3rsCM1Yf/tDWPfg54vy98RGy",
+	"HbwmJyfDhsXk3l6Eerrn+LGK6L0p2LxoMCklD/q9iqL9SbIAit+DEtMv9RhtHRp4Qw5qqUZXN5nwxngd",
+	"ufEACIv9mdYL4V8D8zOjSMcqMgpzrdhTukegVeovVCegPQqITwW60YBiIEP7s3IeqLnONwP4q+C+eAqF",
+	"DnTnlBeTKep0idwQGB8LvhLgClZYWkWUBeoMQEXjCvwHezeshswr3B+geemJ+91UXB1GO3PpC7zJT8ge",
+	"39VVwbsMUc047c5eRa8OqsKaZ5hvGJKlTtki5c/wx6RTqQ84lfoBKNkl6INiZG7MfcMrke7tgkAe2zuj",
+	"jUyQ8cq7maa7x2hlNnGrIt+vqdG/0+kkOvjsmIHQmP1pKTLmQGjMoeiYpBQck0zBuYYSe+VrQvcP1DCO",
+	"vkniEHLcJCXkrzk0KOHXSEgS8/KIAQl+DVfVBkt45T0Iv1Af4F9u8JFKNuzH8DfMFYNe4wCu8IP4F3BW",
+	"MEyrwKVd6FpYb5yZY993tleS8LgK00yrN6GbZLHsvkKdcny7e50uYNWd+xaJimlqpK/iMa0Hj7wa00DD",
+	"gsuPYLJSev9+uq2uROpR0ougbe5Bp97RbXJ9e/CDH+zSD96uBzUy2L/HZictyC3HL9LU9mSJ/330xq+y",
+	"Kiir8E47CakuTbRooCvkuGp3vt+pS5XtiITue7AOF0W53cdLjbWnUHrd2nCsJRQSvx/e0kq1WVvwtsTj",
+	"btkY1kYDzN3VC1aH3kkl1H/6xVTRmqpUdD3Ia4Gyuq3pd/bGrLeorQq+1KmHXKuB6IFiXlj+vgmMdjYN",
+	"6qmBd4O6OvM83o0Zizb0md+2vnXgv1uBSqxQw0J7vnnhQfPiObY1LM9UOIzmpNLkS7SUvfQHp3uxfdlG",
+	"8R9APgZar4DJOZmIyVSiNIOpSst11F2AdFUM0Q9Tzu8lFswd2pvsVEr/Dy9F9Z1khIa633ygZaFkF362",
+	"J0cc9Z9WcpP9tLt8fDyirQSC9Z+4/QU/UMa6fxHnHckdJTN2Y9Eoud7smb00fePzOt+sTQt84lDnJ2AZ",
+	"XV7J/hIyQAmv0fgQusITURrkT8iZ7bRliAl5Ahf3Sa+2Vq94GtJrm95OPR7JvSPG5Bo3wieEU3/lpy74",
+	"CbNHgJ2UHKOWY3XgOyPy+yx93se6BJaI3D5ToZOPX7PAuuTQF+6FWT+Kba3Tmyt+SeF9bGt7Xaydsk2j",
+	"bjBkzQ2DEhPN72QVeKUFp+PT3vPZuy+zeJfMvPdtoD546Uc6T4UPTlST9pMKzTY1xcHsD8Tj5NDASyZM",
+	"g/BetBi9mAQsxEqdvZQyzanYc2uTPqjtbypHcn8mC38fhXUP9WXeq4169X186NKcMp7HlLQ5Pr8XGvdN",
+	"EF2/OMmeD7XRZ5vLxPAZWs87YQTuKyo+jMukXDK1PhqF/aeMa5ER5gJ07Ydomoi/SHIpCXX4wh2U7Nl9",
+	"E6JV/5XDZYvRyGH3ikd9OsazBFZbK1f9EjJiO2CoJJBHbIcZ+oaEVdIaNpIuh1aKX+pEc1Jpl364hGdn",
+	"8QuemLcocN+xEJSQZQsvBjUwWKUOPp4TyX8pB2qlWbb8l2+s4tc8ILf8GvXq32eaH1DraT0IETdsWEXv",
+	"/oBlNYc/Rh25ry+6tSc05WOZPrvq3nvcuLwQspeEQUliOi1YMyiadxOtHwdqV2PpFnrNiHULpw+iRT2M",
+	"67KJ3QyQDT9CXLhHFlL0hS57bSlF3nfyCw25oT12LWpy+kSfXlLfsbDxGXw19CacOMETw9iBtFm8NGuG",
+	"fTtVoKcZKu5AlR3LW8+nGjeeozYouOMK/+1zCEmxl5k2b1xqW03HHLLD+sTRkvrrKfvQThk5N12cM+al",
+	"n/HelL+TQW9JmvCL45V8jts3XwfRV5G2e01pTCP9k8yq/c942qEua4R338ry7gi7AHvy/w8AUKGM7dWh",
+	"AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
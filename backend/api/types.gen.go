@@ -7,6 +7,12 @@ import (
 	"time"
 )
 
+// Defines values for BackupStatusLastResult.
+const (
+	Failure BackupStatusLastResult = "failure"
+	Success BackupStatusLastResult = "success"
+)
+
 // Defines values for CategoryType.
 const (
 	Income    CategoryType = "income"
@@ -15,6 +21,98 @@ const (
 	Saving    CategoryType = "saving"
 )
 
+// Defines values for HealthState.
+const (
+	Ok          HealthState = "ok"
+	Unavailable HealthState = "unavailable"
+)
+
+// Defines values for LogLevel.
+const (
+	Debug LogLevel = "debug"
+	Error LogLevel = "error"
+	Info  LogLevel = "info"
+	Warn  LogLevel = "warn"
+)
+
+// Defines values for ParsedTokenKind.
+const (
+	ParsedTokenKindCategory ParsedTokenKind = "category"
+	ParsedTokenKindDate     ParsedTokenKind = "date"
+	ParsedTokenKindMemo     ParsedTokenKind = "memo"
+	ParsedTokenKindPrice    ParsedTokenKind = "price"
+)
+
+// Defines values for RecordVersionOperation.
+const (
+	Create  RecordVersionOperation = "create"
+	Delete  RecordVersionOperation = "delete"
+	Restore RecordVersionOperation = "restore"
+	Update  RecordVersionOperation = "update"
+)
+
+// Defines values for RuleMatchWeekdays.
+const (
+	Fri RuleMatchWeekdays = "fri"
+	Mon RuleMatchWeekdays = "mon"
+	Sat RuleMatchWeekdays = "sat"
+	Sun RuleMatchWeekdays = "sun"
+	Thu RuleMatchWeekdays = "thu"
+	Tue RuleMatchWeekdays = "tue"
+	Wed RuleMatchWeekdays = "wed"
+)
+
+// Defines values for SummaryBucket.
+const (
+	Day     SummaryBucket = "day"
+	Month   SummaryBucket = "month"
+	Quarter SummaryBucket = "quarter"
+	Week    SummaryBucket = "week"
+)
+
+// BackupFile defines model for backup_file.
+type BackupFile struct {
+	CreatedAt time.Time `json:"created_at"`
+	Name      string    `json:"name"`
+
+	// Size ファイルサイズ（バイト）
+	Size int64 `json:"size"`
+}
+
+// BackupStatus defines model for backup_status.
+type BackupStatus struct {
+	Enabled bool `json:"enabled"`
+
+	// Files 保存済みのバックアップファイル（新しい順）
+	Files *[]BackupFile `json:"files,omitempty"`
+
+	// Interval 自動バックアップの実行間隔
+	Interval *string `json:"interval,omitempty"`
+
+	// KeepDaily 日ごとに最新の1件を保持する日数
+	KeepDaily *int `json:"keep_daily,omitempty"`
+
+	// KeepLast 新しい順に保持する件数
+	KeepLast *int `json:"keep_last,omitempty"`
+
+	// KeepMonthly 月ごとに最新の1件を保持する月数
+	KeepMonthly *int    `json:"keep_monthly,omitempty"`
+	LastError   *string `json:"last_error,omitempty"`
+
+	// LastFile 直近の実行で作成したファイル名
+	LastFile       *string                 `json:"last_file,omitempty"`
+	LastFinishedAt *time.Time              `json:"last_finished_at,omitempty"`
+	LastResult     *BackupStatusLastResult `json:"last_result,omitempty"`
+	LastStartedAt  *time.Time              `json:"last_started_at,omitempty"`
+	LastSuccessAt  *time.Time              `json:"last_success_at,omitempty"`
+
+	// NextAt 次回の実行予定日時
+	NextAt *time.Time `json:"next_at,omitempty"`
+}
+
+// BackupStatusLastResult defines model for BackupStatus.LastResult.
+type BackupStatusLastResult string
+
 // Category defines model for category.
 type Category struct {
 	CategoryId   int          `json:"category_id"`
@@ -22,9 +120,64 @@ type Category struct {
 	CategoryType CategoryType `json:"category_type"`
 }
 
+// CategoryComparison defines model for category_comparison.
+type CategoryComparison struct {
+	CategoryId   int          `json:"category_id"`
+	CategoryName string       `json:"category_name"`
+	CategoryType CategoryType `json:"category_type"`
+
+	// Comparison 比較する期間の金額
+	Comparison int `json:"comparison"`
+
+	// Current 対象の期間の金額
+	Current int `json:"current"`
+
+	// Difference 差額（current - comparison）
+	Difference int `json:"difference"`
+
+	// Percentage 差額の比較する期間の金額に対する割合（%、小数第1位）。比較する期間の金額が 0 の場合は省略する
+	Percentage *float32 `json:"percentage,omitempty"`
+}
+
+// CategoryPeriodSummary category_year_summary と同じ形で、price を区切りごとに持つ
+type CategoryPeriodSummary struct {
+	CategoryId   int          `json:"category_id"`
+	CategoryName string       `json:"category_name"`
+	CategoryType CategoryType `json:"category_type"`
+	Count        int          `json:"count"`
+	Price        []int        `json:"price"`
+	Total        int          `json:"total"`
+}
+
+// CategorySuggestion defines model for category_suggestion.
+type CategorySuggestion struct {
+	CategoryId   int          `json:"category_id"`
+	CategoryName string       `json:"category_name"`
+	CategoryType CategoryType `json:"category_type"`
+	Confidence   float32      `json:"confidence"`
+}
+
+// CategorySuggestions defines model for category_suggestions.
+type CategorySuggestions struct {
+	// Records 推定に使ったレコードの件数
+	Records     int                  `json:"records"`
+	Suggestions []CategorySuggestion `json:"suggestions"`
+}
+
 // CategoryType defines model for category_type.
 type CategoryType string
 
+// CategoryTypeComparison defines model for category_type_comparison.
+type CategoryTypeComparison struct {
+	CategoryType CategoryType `json:"category_type"`
+	Comparison   int          `json:"comparison"`
+	Current      int          `json:"current"`
+	Difference   int          `json:"difference"`
+
+	// Percentage 比較する期間の金額が 0 の場合は省略する
+	Percentage *float32 `json:"percentage,omitempty"`
+}
+
 // CategoryYearSummary defines model for category_year_summary.
 type CategoryYearSummary struct {
 	CategoryId   int          `json:"category_id"`
@@ -35,6 +188,115 @@ type CategoryYearSummary struct {
 	Total        int          `json:"total"`
 }
 
+// ComponentHealth defines model for component_health.
+type ComponentHealth struct {
+	// Detail 状態の補足（データベースの種類、スキーマのバージョンなど）
+	Detail *string `json:"detail,omitempty"`
+
+	// Error 利用できない場合の理由
+	Error *string `json:"error,omitempty"`
+
+	// LatencyMs 確認にかかった時間（ミリ秒）
+	LatencyMs *float64    `json:"latency_ms,omitempty"`
+	Name      string      `json:"name"`
+	Status    HealthState `json:"status"`
+}
+
+// FieldError defines model for field_error.
+type FieldError struct {
+	// Field 不正な項目名
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Health defines model for health.
+type Health struct {
+	Components *[]ComponentHealth `json:"components,omitempty"`
+	Status     HealthState        `json:"status"`
+}
+
+// HealthState defines model for health_state.
+type HealthState string
+
+// LogLevel defines model for log_level.
+type LogLevel string
+
+// LogSetting defines model for log_setting.
+type LogSetting struct {
+	Level LogLevel `json:"level"`
+}
+
+// ParsedEntry defines model for parsed_entry.
+type ParsedEntry struct {
+	CategoryName string       `json:"category_name"`
+	CategoryType CategoryType `json:"category_type"`
+	Record       ReqRecord    `json:"record"`
+
+	// Rules 適用した自動分類の規則の名前
+	Rules []string `json:"rules"`
+
+	// Tokens 語ごとの解釈
+	Tokens []ParsedToken `json:"tokens"`
+}
+
+// ParsedToken defines model for parsed_token.
+type ParsedToken struct {
+	Kind ParsedTokenKind `json:"kind"`
+	Text string          `json:"text"`
+}
+
+// ParsedTokenKind defines model for ParsedToken.Kind.
+type ParsedTokenKind string
+
+// PeriodSummary defines model for period_summary.
+type PeriodSummary struct {
+	Bucket SummaryBucket `json:"bucket"`
+
+	// Buckets 区切りの期間（古い順）。categories の price と同じ順
+	Buckets    []SummaryBucketRange    `json:"buckets"`
+	Categories []CategoryPeriodSummary `json:"categories"`
+
+	// From 期間の初日（YYYYMMDD）
+	From string `json:"from"`
+
+	// To 期間の最終日（YYYYMMDD）
+	To string `json:"to"`
+}
+
+// Problem defines model for problem.
+type Problem struct {
+	// Detail このエラーの詳細
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors 項目ごとの検証エラー（検証エラーの場合のみ）
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// Instance エラーが発生したリクエストのパス
+	Instance *string `json:"instance,omitempty"`
+
+	// RequestId リクエスト ID（X-Request-ID）。ログの検索に使用する
+	RequestId *string `json:"request_id,omitempty"`
+
+	// Status HTTP ステータスコード
+	Status int `json:"status"`
+
+	// Title エラーの種類の概要
+	Title string `json:"title"`
+
+	// Type エラーの種類を表す URI。種類を持たない HTTP のエラーは about:blank
+	Type string `json:"type"`
+}
+
+// PurgeResult defines model for purge_result.
+type PurgeResult struct {
+	Num int `json:"num"`
+}
+
+// QuickEntry defines model for quick_entry.
+type QuickEntry struct {
+	Text string `json:"text"`
+}
+
 // Record defines model for record.
 type Record struct {
 	CategoryId   int       `json:"category_id"`
@@ -44,6 +306,7 @@ type Record struct {
 	Id           int       `json:"id"`
 	Memo         string    `json:"memo"`
 	Price        int       `json:"price"`
+	Tags         []string  `json:"tags"`
 	Type         string    `json:"type"`
 }
 
@@ -52,14 +315,180 @@ type RecordCount struct {
 	Num *int `json:"num,omitempty"`
 }
 
+// RecordVersion defines model for record_version.
+type RecordVersion struct {
+	Operation  RecordVersionOperation `json:"operation"`
+	Record     Record                 `json:"record"`
+	RecordedAt time.Time              `json:"recorded_at"`
+	Version    int                    `json:"version"`
+}
+
+// RecordVersionOperation defines model for RecordVersion.Operation.
+type RecordVersionOperation string
+
 // ReqRecord defines model for req_record.
 type ReqRecord struct {
-	CategoryId int     `json:"category_id"`
-	Datetime   *string `json:"datetime,omitempty"`
-	From       *string `json:"from,omitempty"`
-	Memo       *string `json:"memo,omitempty"`
-	Price      int     `json:"price"`
-	Type       *string `json:"type,omitempty"`
+	// CategoryId 作成時に省略した場合は自動分類の規則（features.rules）のカテゴリ。更新時は必須。
+	CategoryId *int `json:"category_id,omitempty"`
+
+	// Datetime YYYYMMDD または RFC 3339 形式。作成時に省略した場合は現在日時、更新時に省略した場合は変更しない。
+	Datetime *string `json:"datetime,omitempty"`
+	From     *string `json:"from,omitempty"`
+	Memo     *string `json:"memo,omitempty"`
+	Price    int     `json:"price"`
+
+	// Tags 作成時は自動分類の規則のタグを追加する。更新時は指定したタグで置き換える（省略した場合はタグを削除する）。
+	Tags *[]string `json:"tags,omitempty"`
+	Type *string   `json:"type,omitempty"`
+}
+
+// Rule レコードを自動で分類する規則
+type Rule struct {
+	// Match 規則を適用するレコードの条件。指定した条件を全て満たす場合に一致する
+	Match RuleMatch `json:"match"`
+	Name  string    `json:"name"`
+
+	// Priority 大きいほど優先する。同じ場合は定義順
+	Priority *int `json:"priority,omitempty"`
+
+	// Set 規則に一致したレコードに設定する値
+	Set RuleAction `json:"set"`
+}
+
+// RuleAction 規則に一致したレコードに設定する値
+type RuleAction struct {
+	CategoryId *int `json:"category_id,omitempty"`
+
+	// Tags 追加するタグ
+	Tags *[]string `json:"tags,omitempty"`
+	Type *string   `json:"type,omitempty"`
+}
+
+// RuleApplyRequest defines model for rule_apply_request.
+type RuleApplyRequest struct {
+	// CategoryId 対象のレコードのカテゴリ ID
+	CategoryId *int `json:"category_id,omitempty"`
+
+	// Rules 適用する規則。省略した場合は設定されている規則
+	Rules *[]Rule `json:"rules,omitempty"`
+
+	// Yyyymm 対象のレコードの年月
+	Yyyymm *string `json:"yyyymm,omitempty"`
+}
+
+// RuleApplyResult defines model for rule_apply_result.
+type RuleApplyResult struct {
+	Changes []RuleChange `json:"changes"`
+
+	// Num 変更された（試行の場合は変更される）レコードの数。skipped のものを含まない
+	Num int `json:"num"`
+}
+
+// RuleChange defines model for rule_change.
+type RuleChange struct {
+	After  Record `json:"after"`
+	Before Record `json:"before"`
+
+	// Rules 一致した規則の名前（優先度の順）
+	Rules []string `json:"rules"`
+
+	// Skipped 適用できなかった理由（検証の違反、確定済みの月など）。適用できた場合は省略する
+	Skipped *string `json:"skipped,omitempty"`
+}
+
+// RuleMatch 規則を適用するレコードの条件。指定した条件を全て満たす場合に一致する
+type RuleMatch struct {
+	// From 登録元（完全一致）
+	From *string `json:"from,omitempty"`
+
+	// MaxPrice 金額の上限（以下）
+	MaxPrice *int `json:"max_price,omitempty"`
+
+	// MemoContains メモに含む文字列（英字の大文字小文字・全角半角を区別しない）
+	MemoContains *string `json:"memo_contains,omitempty"`
+
+	// MemoRegex メモに一致する正規表現（Go の regexp の構文）
+	MemoRegex *string `json:"memo_regex,omitempty"`
+
+	// MinPrice 金額の下限（以上）
+	MinPrice *int `json:"min_price,omitempty"`
+
+	// Weekdays 日時の曜日のいずれか
+	Weekdays *[]RuleMatchWeekdays `json:"weekdays,omitempty"`
+}
+
+// RuleMatchWeekdays defines model for RuleMatch.Weekdays.
+type RuleMatchWeekdays string
+
+// SummaryBucket defines model for summary_bucket.
+type SummaryBucket string
+
+// SummaryBucketRange defines model for summary_bucket_range.
+type SummaryBucketRange struct {
+	// From 区切りの初日（YYYYMMDD）
+	From string `json:"from"`
+
+	// To 区切りの最終日（YYYYMMDD）
+	To string `json:"to"`
+}
+
+// SummaryComparison defines model for summary_comparison.
+type SummaryComparison struct {
+	// Categories カテゴリ ID の順。いずれの期間も金額が 0 のカテゴリは含めない
+	Categories []CategoryComparison `json:"categories"`
+
+	// CategoryTypes 全ての種類（income, outgoing, saving, investing の順）
+	CategoryTypes []CategoryTypeComparison `json:"category_types"`
+
+	// Comparison 比較する期間（YYYYMM または会計年度）
+	Comparison string `json:"comparison"`
+
+	// Current 対象の期間（YYYYMM または会計年度）
+	Current string `json:"current"`
+}
+
+// TrashedRecord defines model for trashed_record.
+type TrashedRecord struct {
+	CategoryId   int       `json:"category_id"`
+	CategoryName string    `json:"category_name"`
+	Datetime     time.Time `json:"datetime"`
+	DeletedAt    time.Time `json:"deleted_at"`
+	From         string    `json:"from"`
+	Id           int       `json:"id"`
+	Memo         string    `json:"memo"`
+	Price        int       `json:"price"`
+	Tags         []string  `json:"tags"`
+	Type         string    `json:"type"`
+}
+
+// BadRequest defines model for bad_request.
+type BadRequest = Problem
+
+// Conflict defines model for conflict.
+type Conflict = Problem
+
+// InternalServerError defines model for internal_server_error.
+type InternalServerError = Problem
+
+// MonthLocked defines model for month_locked.
+type MonthLocked = Problem
+
+// NotFound defines model for not_found.
+type NotFound = Problem
+
+// GetV3CategoriesSuggestParams defines parameters for GetV3CategoriesSuggest.
+type GetV3CategoriesSuggestParams struct {
+	// Memo メモ
+	Memo string `form:"memo" json:"memo"`
+
+	// From 登録元
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// Price 金額
+	Price *int `form:"price,omitempty" json:"price,omitempty"`
+
+	// Limit 返す候補の最大件数
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // GetV3RecordParams defines parameters for GetV3Record.
@@ -71,5 +500,69 @@ type GetV3RecordParams struct {
 	CategoryId *int    `form:"category_id,omitempty" json:"category_id,omitempty"`
 }
 
+// GetV3RecordCompareMonthParams defines parameters for GetV3RecordCompareMonth.
+type GetV3RecordCompareMonthParams struct {
+	// With 比較する月（YYYYMM）
+	With *string `form:"with,omitempty" json:"with,omitempty"`
+}
+
+// GetV3RecordCompareYearParams defines parameters for GetV3RecordCompareYear.
+type GetV3RecordCompareYearParams struct {
+	// With 比較する会計年度
+	With *int `form:"with,omitempty" json:"with,omitempty"`
+}
+
+// GetV3RecordCountParams defines parameters for GetV3RecordCount.
+type GetV3RecordCountParams struct {
+	Yyyymm     *string `form:"yyyymm,omitempty" json:"yyyymm,omitempty"`
+	CategoryId *int    `form:"category_id,omitempty" json:"category_id,omitempty"`
+}
+
+// GetV3RecordSummaryCalendarParams defines parameters for GetV3RecordSummaryCalendar.
+type GetV3RecordSummaryCalendarParams struct {
+	// Bucket 金額を区切る単位（week は月曜始まり、quarter は1・4・7・10月始まり）
+	Bucket *SummaryBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+}
+
+// GetV3RecordSummaryRangeParams defines parameters for GetV3RecordSummaryRange.
+type GetV3RecordSummaryRangeParams struct {
+	// From 期間の初日（YYYYMMDD）
+	From string `form:"from" json:"from"`
+
+	// To 期間の最終日（YYYYMMDD、この日を含む）
+	To string `form:"to" json:"to"`
+
+	// Bucket 金額を区切る単位（week は月曜始まり、quarter は1・4・7・10月始まり）
+	Bucket *SummaryBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+}
+
+// GetV3RecordYearParams defines parameters for GetV3RecordYear.
+type GetV3RecordYearParams struct {
+	// AsOf 指定した日時時点の履歴に基づいてサマリーを計算する
+	AsOf *time.Time `form:"as_of,omitempty" json:"as_of,omitempty"`
+}
+
+// GetV3RecordTrashParams defines parameters for GetV3RecordTrash.
+type GetV3RecordTrashParams struct {
+	// Num the number of records
+	Num    *int `form:"num,omitempty" json:"num,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PutV3AdminLogLevelJSONRequestBody defines body for PutV3AdminLogLevel for application/json ContentType.
+type PutV3AdminLogLevelJSONRequestBody = LogSetting
+
 // PostV3RecordJSONRequestBody defines body for PostV3Record for application/json ContentType.
 type PostV3RecordJSONRequestBody = ReqRecord
+
+// PostV3RecordParseJSONRequestBody defines body for PostV3RecordParse for application/json ContentType.
+type PostV3RecordParseJSONRequestBody = QuickEntry
+
+// PutV3RecordIdJSONRequestBody defines body for PutV3RecordId for application/json ContentType.
+type PutV3RecordIdJSONRequestBody = ReqRecord
+
+// PostV3RulesApplyJSONRequestBody defines body for PostV3RulesApply for application/json ContentType.
+type PostV3RulesApplyJSONRequestBody = RuleApplyRequest
+
+// PostV3RulesDryRunJSONRequestBody defines body for PostV3RulesDryRun for application/json ContentType.
+type PostV3RulesDryRunJSONRequestBody = RuleApplyRequest
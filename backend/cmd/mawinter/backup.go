@@ -0,0 +1,117 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/adapter/backup"
+	"github.com/spf13/cobra"
+)
+
+var (
+	backupOutput string
+	restoreForce bool
+)
+
+func init() {
+	// backup / restore コマンドを root コマンドに追加
+	rootCmd.AddCommand(backupCmd, restoreCmd)
+
+	// フラグの定義
+	backupCmd.Flags().StringVarP(&backupOutput, "output", "o", "", "出力先のファイル（デフォルト: mawinter-YYYYMMDD-HHMMSS.jsonl）")
+	restoreCmd.Flags().BoolVar(&restoreForce, "force", false, "復元先にデータがある場合も、既存のデータを全て削除して復元する")
+}
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "データベースの内容をファイルにバックアップ",
+	Long:  "カテゴリ・レコード（ゴミ箱・変更履歴を含む）・固定費・月次の確定状態を、チェックサム付きの JSON Lines 形式のファイルに書き出します。",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		db, _, err := openDB(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer closeDB(db)
+
+		output := backupOutput
+		if output == "" {
+			output = backup.FileName(time.Now())
+		}
+
+		// 書き出しに失敗した場合に不完全なファイルを残さないよう、一時ファイルに書き出してから置き換える
+		tmp := output + ".tmp"
+		f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+		if err != nil {
+			return fmt.Errorf("failed to create backup file: %w", err)
+		}
+		defer os.Remove(tmp)
+
+		manifest, err := backup.NewArchiver(db, version).Backup(cmd.Context(), f)
+		if err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("failed to write backup file: %w", err)
+		}
+		if err := os.Rename(tmp, output); err != nil {
+			return fmt.Errorf("failed to write backup file: %w", err)
+		}
+
+		fmt.Printf("Backup written to %s\n", output)
+		printManifest(manifest)
+		return nil
+	},
+}
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore <file>",
+	Short: "バックアップファイルからデータベースを復元",
+	Long:  "mawinter backup で作成したファイルを検証し、データベースに復元します。復元先にレコード等がある場合は --force を指定しない限り中止します。カテゴリは常にバックアップの内容で置き換えます。",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to open backup file: %w", err)
+		}
+		defer f.Close()
+
+		db, _, err := openDB(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer closeDB(db)
+
+		// マイグレーションが適用済みであることを確認（未適用のテーブルには復元できない）
+		if err := ensureSchema(cmd.Context(), db, false); err != nil {
+			return err
+		}
+
+		manifest, err := backup.NewArchiver(db, version).Restore(cmd.Context(), f, backup.RestoreOptions{Force: restoreForce})
+		if errors.Is(err, backup.ErrNotEmpty) {
+			return fmt.Errorf("%w; use --force to replace the existing data", err)
+		}
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Restored from %s (created at %s by %s)\n",
+			args[0],
+			manifest.Header.CreatedAt.Format(time.RFC3339),
+			manifest.Header.AppVersion,
+		)
+		printManifest(manifest)
+		return nil
+	},
+}
+
+// printManifest はセクションごとの行数を表示する
+func printManifest(manifest *backup.Manifest) {
+	for _, section := range backup.Sections {
+		fmt.Printf("  %-20s %d\n", section, manifest.Footer.Counts[section])
+	}
+	fmt.Printf("  %-20s %s\n", "sha256", manifest.Footer.SHA256)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/azuki774/mawinter/api"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	// categories コマンドを root コマンドに追加
+	rootCmd.AddCommand(categoriesCmd)
+	addClientFlags(categoriesCmd)
+
+	// categories suggest コマンドを categories コマンドに追加
+	categoriesCmd.AddCommand(categoriesSuggestCmd)
+	categoriesSuggestCmd.Flags().StringVar(&suggestFrom, "from", "", "登録元")
+	categoriesSuggestCmd.Flags().IntVar(&suggestPrice, "price", 0, "金額")
+	categoriesSuggestCmd.Flags().IntVar(&suggestLimit, "limit", 3, "表示する候補の最大件数（1〜10）")
+}
+
+var (
+	suggestFrom  string
+	suggestPrice int
+	suggestLimit int
+)
+
+var categoriesCmd = &cobra.Command{
+	Use:   "categories",
+	Short: "サーバのカテゴリの一覧を表示",
+	Long:  "--url（MAWINTER_URL）で指定したサーバに接続し、カテゴリの一覧を表示します。",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newAPIClient(cfg.Client)
+		if err != nil {
+			return err
+		}
+		res, err := client.GetV3CategoriesWithResponse(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("failed to send request: %w", err)
+		}
+		if res.JSON200 == nil {
+			return responseError(res.HTTPResponse, res.Body)
+		}
+		return writeOutput(os.Stdout, cfg.Client.Output, categoriesOutput(*res.JSON200))
+	},
+}
+
+// categoriesOutput はカテゴリの一覧の出力内容を返す
+func categoriesOutput(categories []api.Category) output {
+	out := output{
+		header: []string{"category_id", "category_name", "category_type"},
+		value:  categories,
+	}
+	for _, c := range categories {
+		out.rows = append(out.rows, []string{strconv.Itoa(c.CategoryId), c.CategoryName, string(c.CategoryType)})
+	}
+	return out
+}
+
+var categoriesSuggestCmd = &cobra.Command{
+	Use:   "suggest <memo>...",
+	Short: "メモからカテゴリの候補を表示",
+	Long:  "サーバが過去のレコードのメモ・登録元・金額から推定したカテゴリの候補を、確からしい順に表示します。",
+	Example: `  mawinter categories suggest セブンイレブン
+  mawinter categories suggest 電気代 --from bank --price 8000`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newAPIClient(cfg.Client)
+		if err != nil {
+			return err
+		}
+		params := &api.GetV3CategoriesSuggestParams{Memo: strings.Join(args, " "), Limit: &suggestLimit}
+		if cmd.Flags().Changed("from") {
+			params.From = &suggestFrom
+		}
+		if cmd.Flags().Changed("price") {
+			params.Price = &suggestPrice
+		}
+		res, err := client.GetV3CategoriesSuggestWithResponse(cmd.Context(), params)
+		if err != nil {
+			return fmt.Errorf("failed to send request: %w", err)
+		}
+		if res.JSON200 == nil {
+			return responseError(res.HTTPResponse, res.Body)
+		}
+		return writeOutput(os.Stdout, cfg.Client.Output, suggestionsOutput(*res.JSON200))
+	},
+}
+
+// suggestionsOutput はカテゴリの候補の出力内容を返す
+func suggestionsOutput(suggestions api.CategorySuggestions) output {
+	out := output{
+		header: []string{"category_id", "category_name", "category_type", "confidence"},
+		value:  suggestions,
+	}
+	for _, s := range suggestions.Suggestions {
+		out.rows = append(out.rows, []string{strconv.Itoa(s.CategoryId), s.CategoryName, string(s.CategoryType), strconv.FormatFloat(float64(s.Confidence), 'f', 3, 32)})
+	}
+	return out
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/azuki774/mawinter/api"
+	"github.com/azuki774/mawinter/pkg/config"
+	"github.com/spf13/cobra"
+	"golang.org/x/text/width"
+)
+
+// addClientFlags はリモートのサーバに接続するコマンドに共通のフラグを追加する
+// サブコマンドでも使えるよう、永続フラグとして追加する
+func addClientFlags(cmd *cobra.Command) {
+	client := &cfg.Client
+	flags := cmd.PersistentFlags()
+	flags.StringVar(&client.URL, "url", client.URL, "接続するサーバの URL（MAWINTER_URL）")
+	flags.StringVar(&client.Token, "token", client.Token, "Authorization: Bearer で送信するトークン（MAWINTER_TOKEN / MAWINTER_TOKEN_FILE での指定を推奨）")
+	flags.StringVarP(&client.Output, "output", "o", client.Output, "出力形式（table, json, csv）")
+	flags.DurationVar(&client.Timeout, "timeout", client.Timeout, "リクエストのタイムアウト（0: なし）")
+}
+
+// newAPIClient は設定されたサーバの API に接続するクライアントを返す
+func newAPIClient(c config.ClientConfig) (*api.ClientWithResponses, error) {
+	server := strings.TrimRight(c.URL, "/") + "/api"
+	editor := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("User-Agent", "mawinter/"+version)
+		if c.Token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.Token)
+		}
+		return nil
+	}
+	return api.NewClientWithResponses(server,
+		api.WithHTTPClient(&http.Client{Timeout: c.Timeout}),
+		api.WithRequestEditorFn(editor),
+	)
+}
+
+// responseError は想定外のレスポンスをエラーに変換する
+// problem+json の場合はエラーの概要と、詳細または項目ごとのエラーを含める
+func responseError(res *http.Response, body []byte) error {
+	var p api.Problem
+	if err := json.Unmarshal(body, &p); err != nil || p.Title == "" {
+		return fmt.Errorf("unexpected response from server: %s", res.Status)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (%d)", p.Title, p.Status)
+	// 検証エラーの detail は項目ごとのエラーを連結したものなので、項目ごとのエラーのみ表示する
+	if p.Detail != nil && *p.Detail != "" && p.Errors == nil {
+		fmt.Fprintf(&b, ": %s", *p.Detail)
+	}
+	if p.Errors != nil {
+		for _, e := range *p.Errors {
+			fmt.Fprintf(&b, "\n  %s: %s", e.Field, e.Message)
+		}
+	}
+	if p.RequestId != nil {
+		fmt.Fprintf(&b, "\n  request_id: %s", *p.RequestId)
+	}
+	return errors.New(b.String())
+}
+
+// output はコマンドの出力内容
+// table・csv 形式では header と rows を、json 形式では value を出力する
+type output struct {
+	header []string
+	rows   [][]string
+	value  any
+}
+
+// writeOutput は出力内容を指定された形式で書き出す
+func writeOutput(w io.Writer, format string, out output) error {
+	switch format {
+	case config.OutputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out.value)
+	case config.OutputCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(out.header); err != nil {
+			return err
+		}
+		if err := cw.WriteAll(out.rows); err != nil {
+			return err
+		}
+		return cw.Error()
+	case config.OutputTable:
+		header := make([]string, len(out.header))
+		for i, h := range out.header {
+			header[i] = strings.ToUpper(h)
+		}
+		return writeTable(w, append([][]string{header}, out.rows...))
+	}
+	return fmt.Errorf("unknown output format %q", format)
+}
+
+// writeTable は列の表示幅を揃えて書き出す
+// text/tabwriter は全角文字の幅を考慮しないため、東アジアの全角文字を 2 桁として数える
+func writeTable(w io.Writer, rows [][]string) error {
+	var widths []int
+	for _, row := range rows {
+		for i, cell := range row {
+			if i >= len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], displayWidth(cell))
+		}
+	}
+
+	for _, row := range rows {
+		var b strings.Builder
+		for i, cell := range row {
+			b.WriteString(cell)
+			if i < len(row)-1 {
+				b.WriteString(strings.Repeat(" ", widths[i]-displayWidth(cell)+2))
+			}
+		}
+		b.WriteString("\n")
+		if _, err := io.WriteString(w, b.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// displayWidth は文字列を端末に表示した場合の桁数を返す
+func displayWidth(s string) int {
+	n := 0
+	for _, r := range s {
+		switch width.LookupRune(r).Kind() {
+		case width.EastAsianWide, width.EastAsianFullwidth:
+			n += 2
+		default:
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/azuki774/mawinter/api"
+	"github.com/azuki774/mawinter/pkg/config"
+)
+
+func TestNewAPIClient(t *testing.T) {
+	tests := []struct {
+		name        string
+		token       string
+		status      int
+		body        string
+		wantAuth    string
+		wantErr     string // 空の場合は成功する
+		wantRecords int
+	}{
+		{
+			name:        "正常系: トークンを Authorization ヘッダーで送信する",
+			token:       "secret",
+			status:      http.StatusOK,
+			body:        `[{"id":1,"category_id":210,"category_name":"食費","datetime":"2025-10-01T00:00:00Z","from":"","type":"","memo":"","price":1280}]`,
+			wantAuth:    "Bearer secret",
+			wantRecords: 1,
+		},
+		{
+			name:   "正常系: トークンが未指定の場合は送信しない",
+			status: http.StatusOK,
+			body:   `[]`,
+		},
+		{
+			name:    "異常系: 検証エラーは項目ごとのエラーを表示する",
+			status:  http.StatusBadRequest,
+			body:    `{"type":"https://mawinter/problems/validation","title":"Validation failed","status":400,"detail":"validation failed: yyyymm: must be YYYYMM","errors":[{"field":"yyyymm","message":"must be YYYYMM"}],"request_id":"req-1"}`,
+			wantErr: "Validation failed (400)\n  yyyymm: must be YYYYMM\n  request_id: req-1",
+		},
+		{
+			name:    "異常系: problem+json 以外のエラー",
+			status:  http.StatusBadGateway,
+			body:    `<html>bad gateway</html>`,
+			wantErr: "unexpected response from server: 502 Bad Gateway",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath, gotAuth string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath, gotAuth = r.URL.Path, r.Header.Get("Authorization")
+				if tt.status == http.StatusBadRequest {
+					w.Header().Set("Content-Type", "application/problem+json")
+				} else {
+					w.Header().Set("Content-Type", "application/json")
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer ts.Close()
+
+			client, err := newAPIClient(config.ClientConfig{URL: ts.URL + "/", Token: tt.token, Timeout: time.Second})
+			if err != nil {
+				t.Fatalf("newAPIClient() error = %v", err)
+			}
+			res, err := client.GetV3RecordWithResponse(context.Background(), &api.GetV3RecordParams{})
+			if err != nil {
+				t.Fatalf("request error = %v", err)
+			}
+			if gotPath != "/api/v3/record" {
+				t.Errorf("expected path /api/v3/record, got %s", gotPath)
+			}
+			if gotAuth != tt.wantAuth {
+				t.Errorf("expected Authorization %q, got %q", tt.wantAuth, gotAuth)
+			}
+
+			if tt.wantErr != "" {
+				if res.JSON200 != nil {
+					t.Fatalf("expected error response, got %+v", res.JSON200)
+				}
+				if err := responseError(res.HTTPResponse, res.Body); err == nil || err.Error() != tt.wantErr {
+					t.Errorf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if res.JSON200 == nil || len(*res.JSON200) != tt.wantRecords {
+				t.Errorf("expected %d records, got %+v", tt.wantRecords, res.JSON200)
+			}
+		})
+	}
+}
+
+func TestWriteOutput(t *testing.T) {
+	out := categoriesOutput([]api.Category{
+		{CategoryId: 100, CategoryName: "月給", CategoryType: "income"},
+		{CategoryId: 210, CategoryName: "食費, 外食", CategoryType: "outgoing"},
+	})
+
+	tests := []struct {
+		name    string
+		format  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "正常系: table 形式は全角文字を 2 桁として列を揃える",
+			format: config.OutputTable,
+			want: "CATEGORY_ID  CATEGORY_NAME  CATEGORY_TYPE\n" +
+				"100          月給           income\n" +
+				"210          食費, 外食     outgoing\n",
+		},
+		{
+			name:   "正常系: csv 形式は値を必要に応じて引用する",
+			format: config.OutputCSV,
+			want:   "category_id,category_name,category_type\n100,月給,income\n210,\"食費, 外食\",outgoing\n",
+		},
+		{
+			name:   "正常系: json 形式は API のレスポンスと同じ形式",
+			format: config.OutputJSON,
+			want:   "[\n  {\n    \"category_id\": 100,\n    \"category_name\": \"月給\",\n    \"category_type\": \"income\"\n  },\n  {\n    \"category_id\": 210,\n    \"category_name\": \"食費, 外食\",\n    \"category_type\": \"outgoing\"\n  }\n]\n",
+		},
+		{
+			name:    "異常系: 不明な形式",
+			format:  "xml",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := writeOutput(&buf, tt.format, out)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("writeOutput() error = %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("unexpected output:\n%s\nwant:\n%s", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestSummaryOutput(t *testing.T) {
+	out := summaryOutput([]api.CategoryYearSummary{
+		{CategoryId: 210, CategoryName: "食費", CategoryType: "outgoing", Price: []int{100, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 200}, Total: 300, Count: 2},
+	})
+
+	if len(out.header) != 17 || out.header[3] != "apr" || out.header[14] != "mar" {
+		t.Errorf("unexpected header %v", out.header)
+	}
+	if got := strings.Join(out.rows[0], ","); got != "210,食費,outgoing,100,0,0,0,0,0,0,0,0,0,0,200,300,2" {
+		t.Errorf("unexpected row %s", got)
+	}
+}
+
+func TestPeriodSummaryOutput(t *testing.T) {
+	out := periodSummaryOutput(api.PeriodSummary{
+		From:    "20250101",
+		To:      "20250630",
+		Bucket:  api.Quarter,
+		Buckets: []api.SummaryBucketRange{{From: "20250101", To: "20250331"}, {From: "20250401", To: "20250630"}},
+		Categories: []api.CategoryPeriodSummary{
+			{CategoryId: 210, CategoryName: "食費", CategoryType: "outgoing", Price: []int{100, 200}, Total: 300, Count: 2},
+		},
+	})
+
+	if got := strings.Join(out.header, ","); got != "category_id,category_name,category_type,20250101,20250401,total,count" {
+		t.Errorf("unexpected header %s", got)
+	}
+	if got := strings.Join(out.rows[0], ","); got != "210,食費,outgoing,100,200,300,2" {
+		t.Errorf("unexpected row %s", got)
+	}
+}
+
+func TestComparisonOutput(t *testing.T) {
+	percentage := float32(8.3)
+	comparison := api.SummaryComparison{
+		Current:    "202510",
+		Comparison: "202410",
+		Categories: []api.CategoryComparison{
+			{CategoryId: 210, CategoryName: "食費", CategoryType: "outgoing", Current: 52000, Comparison: 48000, Difference: 4000, Percentage: &percentage},
+		},
+		CategoryTypes: []api.CategoryTypeComparison{
+			{CategoryType: "income", Current: 1000, Comparison: 0, Difference: 1000},
+		},
+	}
+
+	out := comparisonOutput(comparison, false)
+	if got := strings.Join(out.header, ","); got != "category_id,category_name,category_type,202510,202410,difference,percentage" {
+		t.Errorf("unexpected header %s", got)
+	}
+	if got := strings.Join(out.rows[0], ","); got != "210,食費,outgoing,52000,48000,+4000,+8.3%" {
+		t.Errorf("unexpected row %s", got)
+	}
+
+	// 比較する期間の金額が 0 の場合は割合を空とする
+	out = comparisonOutput(comparison, true)
+	if got := strings.Join(out.rows[0], ","); got != "income,1000,0,+1000," {
+		t.Errorf("unexpected row %s", got)
+	}
+}
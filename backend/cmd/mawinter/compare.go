@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/azuki774/mawinter/api"
+	"github.com/spf13/cobra"
+)
+
+var (
+	compareWith   string
+	compareByType bool
+)
+
+func init() {
+	// compare コマンドを root コマンドに追加
+	rootCmd.AddCommand(compareCmd)
+	addClientFlags(compareCmd)
+
+	compareCmd.Flags().StringVar(&compareWith, "with", "", "比較する月（YYYYMM）または会計年度。省略した場合は前年の同じ月・前年度")
+	compareCmd.Flags().BoolVar(&compareByType, "by-type", false, "カテゴリの代わりにカテゴリの種類（income, outgoing など）ごとに表示する")
+}
+
+var compareCmd = &cobra.Command{
+	Use:   "compare <yyyymm|fy>",
+	Short: "サーバの月・年度のサマリーを比較",
+	Long: `--url（MAWINTER_URL）で指定したサーバに接続し、月（YYYYMM）または会計年度のカテゴリ別の金額を比較する期間と比べて表示します。
+比較する期間を省略した場合は、月は前年の同じ月、会計年度は前年度と比較します。`,
+	Example: `  mawinter compare 202510
+  mawinter compare 202510 --with 202509
+  mawinter compare 2025 --by-type`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newAPIClient(cfg.Client)
+		if err != nil {
+			return err
+		}
+
+		var comparison *api.SummaryComparison
+		var httpRes *http.Response
+		var body []byte
+		switch len(args[0]) {
+		case 6:
+			params := &api.GetV3RecordCompareMonthParams{}
+			if compareWith != "" {
+				params.With = &compareWith
+			}
+			res, err := client.GetV3RecordCompareMonthWithResponse(cmd.Context(), args[0], params)
+			if err != nil {
+				return fmt.Errorf("failed to send request: %w", err)
+			}
+			comparison, httpRes, body = res.JSON200, res.HTTPResponse, res.Body
+		case 4:
+			year, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid fiscal year %q", args[0])
+			}
+			params := &api.GetV3RecordCompareYearParams{}
+			if compareWith != "" {
+				with, err := strconv.Atoi(compareWith)
+				if err != nil {
+					return fmt.Errorf("invalid --with %q", compareWith)
+				}
+				params.With = &with
+			}
+			res, err := client.GetV3RecordCompareYearWithResponse(cmd.Context(), year, params)
+			if err != nil {
+				return fmt.Errorf("failed to send request: %w", err)
+			}
+			comparison, httpRes, body = res.JSON200, res.HTTPResponse, res.Body
+		default:
+			return fmt.Errorf("invalid period %q: must be YYYYMM or a fiscal year", args[0])
+		}
+		if comparison == nil {
+			return responseError(httpRes, body)
+		}
+		return writeOutput(os.Stdout, cfg.Client.Output, comparisonOutput(*comparison, compareByType))
+	},
+}
+
+// comparisonOutput はサマリーの比較の出力内容を返す
+// byType が true の場合はカテゴリの種類ごとの行とする
+func comparisonOutput(comparison api.SummaryComparison, byType bool) output {
+	amounts := []string{comparison.Current, comparison.Comparison, "difference", "percentage"}
+	if byType {
+		out := output{header: append([]string{"category_type"}, amounts...), value: comparison}
+		for _, t := range comparison.CategoryTypes {
+			out.rows = append(out.rows, append([]string{string(t.CategoryType)}, comparisonColumns(t.Current, t.Comparison, t.Difference, t.Percentage)...))
+		}
+		return out
+	}
+
+	out := output{header: append([]string{"category_id", "category_name", "category_type"}, amounts...), value: comparison}
+	for _, c := range comparison.Categories {
+		row := []string{strconv.Itoa(c.CategoryId), c.CategoryName, string(c.CategoryType)}
+		out.rows = append(out.rows, append(row, comparisonColumns(c.Current, c.Comparison, c.Difference, c.Percentage)...))
+	}
+	return out
+}
+
+// comparisonColumns は比較の金額の列を返す。割合は符号付きで、比較する期間の金額が 0 の場合は空とする
+func comparisonColumns(current, comparison, difference int, percentage *float32) []string {
+	p := ""
+	if percentage != nil {
+		p = fmt.Sprintf("%+.1f%%", *percentage)
+	}
+	return []string{strconv.Itoa(current), strconv.Itoa(comparison), fmt.Sprintf("%+d", difference), p}
+}
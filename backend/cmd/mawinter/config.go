@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	// config コマンドを root コマンドに追加
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configCheckCmd)
+}
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "設定を管理",
+	Long:  "設定ファイル（--config または MAWINTER_CONFIG）・環境変数・フラグから読み込んだ設定を扱います。",
+}
+
+var configCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "設定を検証し、有効な設定を表示",
+	Long:  "設定ファイル・環境変数・フラグを反映した設定（自動分類の規則を含む）を検証し、YAML 形式で表示します。パスワードなどの秘匿情報は伏せて表示します。",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// 設定の読み込みと検証は root コマンドで実行済み
+		// 自動分類の規則は serve と同じく、ドメインの規則に変換して検証する
+		if _, err := newRuleSet(cfg.Features.Rules); err != nil {
+			return err
+		}
+		if err := cfg.Redacted().WriteYAML(os.Stdout); err != nil {
+			return err
+		}
+		fmt.Fprintln(os.Stderr, "Config is valid")
+		return nil
+	},
+}
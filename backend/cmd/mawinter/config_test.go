@@ -0,0 +1,54 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestConfigCheckCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   string
+		wantErr []string
+	}{
+		{
+			name:  "正常系: 有効な規則",
+			rules: `[{name: コンビニ, match: {memo_contains: セブン, weekdays: [sat]}, set: {category_id: 210, tags: [コンビニ]}}]`,
+		},
+		{
+			name:    "異常系: ドメインの規則に違反する",
+			rules:   `[{name: コンビニ, match: {memo_contains: セブン}, set: {tags: ["a,b"]}}, {name: コンビニ, match: {memo_regex: "("}, set: {category_id: 210}}]`,
+			wantErr: []string{"invalid features.rules", "rules[0].set.tags[0]", "rules[1].name", "rules[1].match.memo_regex"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			content := "server:\n  storage: memory\nfeatures:\n  rules: " + tt.rules + "\n"
+			if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			t.Setenv(configEnv, path)
+
+			rootCmd.SetArgs([]string{"config", "check"})
+			err := rootCmd.Execute()
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("Execute() error = %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/azuki774/mawinter/internal/adapter/repository"
+	"github.com/azuki774/mawinter/pkg/config"
+	"github.com/glebarez/sqlite"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// openDB は設定ファイル・環境変数のデータベース設定で接続を確立する
+// データベースが起動していない場合は database.retry の設定に従って再試行する
+func openDB(ctx context.Context) (*gorm.DB, *config.DBInfo, error) {
+	dbInfo := &cfg.Database
+
+	dsn, err := dbInfo.DSN()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// データベース接続の初期化
+	var dialector gorm.Dialector
+	switch dbInfo.Driver {
+	case config.DBDriverSQLite:
+		slog.Info("Database configuration loaded",
+			slog.String("driver", dbInfo.Driver),
+			slog.String("path", dbInfo.Path),
+		)
+		dialector = sqlite.Open(dsn)
+	default:
+		slog.Info("Database configuration loaded",
+			slog.String("driver", dbInfo.Driver),
+			slog.String("host", dbInfo.Host),
+			slog.String("port", dbInfo.Port),
+			slog.String("user", dbInfo.User),
+			slog.String("name", dbInfo.Name),
+			slog.String("tls", dbInfo.TLS),
+			slog.String("timezone", dbInfo.Timezone),
+		)
+		if dbInfo.Driver == config.DBDriverPostgres {
+			dialector = postgres.Open(dsn)
+		} else {
+			dialector = mysql.Open(dsn)
+		}
+	}
+
+	db, err := repository.Open(ctx, dialector, dbInfo.Pool, dbInfo.Retry)
+	if err != nil {
+		slog.Error("Failed to connect to database",
+			slog.String("error", err.Error()),
+		)
+		return nil, nil, err
+	}
+	slog.Info("Database connection pool configured",
+		slog.Int("max_open_conns", dbInfo.Pool.MaxOpenConns),
+		slog.Int("max_idle_conns", dbInfo.Pool.MaxIdleConns),
+		slog.String("conn_max_lifetime", dbInfo.Pool.ConnMaxLifetime.String()),
+		slog.String("conn_max_idle_time", dbInfo.Pool.ConnMaxIdleTime.String()),
+	)
+
+	return db, dbInfo, nil
+}
@@ -2,9 +2,13 @@ package main
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
 
+	"github.com/azuki774/mawinter/pkg/config"
+	"github.com/azuki774/mawinter/pkg/logger"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 // ビルド時に埋め込まれるバージョン情報
@@ -14,14 +18,108 @@ var (
 	build    = "dev"
 )
 
+// configEnv は設定ファイルのパスを指定する環境変数
+const configEnv = "MAWINTER_CONFIG"
+
+var (
+	// cfg は実行中の設定
+	// コマンドラインフラグは各項目に直接バインドし、設定ファイル・環境変数より優先する
+	cfg = config.Default()
+	// configPath は設定ファイルのパス
+	configPath string
+)
+
+// logLevel は実行中のログレベル
+// serve コマンドでは管理用エンドポイントから変更できる
+var logLevel = new(slog.LevelVar)
+
 var rootCmd = &cobra.Command{
 	Use:   "mawinter",
 	Short: "Mawinter - 家計簿サーバ",
 	Long:  "Mawinter は Go/Nuxt3 で構築された家計簿サーバです。",
+	// エラーは main で出力する。実行時のエラーでは使い方を表示しない
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	// 全てのサブコマンドの実行前に設定を読み込み、デフォルトロガーを初期化する
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := loadConfig(cmd); err != nil {
+			return err
+		}
+		return initLogger()
+	},
+}
+
+func init() {
+	flags := rootCmd.PersistentFlags()
+	flags.StringVarP(&configPath, "config", "c", "", "設定ファイル（YAML）のパス。未指定の場合は "+configEnv)
+	flags.StringVar(&cfg.Logging.Level, "log-level", cfg.Logging.Level, "ログレベル（debug, info, warn, error）")
+	flags.StringVar(&cfg.Logging.Format, "log-format", cfg.Logging.Format, "ログの出力形式（json, text）")
+	flags.BoolVar(&cfg.Logging.Source, "log-source", cfg.Logging.Source, "ログに呼び出し元のファイル名と行番号を出力する")
+	flags.StringVar(&cfg.Logging.Output, "log-output", cfg.Logging.Output, "ログの出力先（stdout, stderr）")
+}
+
+// loadConfig は設定ファイルと環境変数を読み込み、コマンドラインフラグで上書きした設定を検証する
+func loadConfig(cmd *cobra.Command) error {
+	path := configPath
+	if !cmd.Flags().Changed("config") {
+		path = os.Getenv(configEnv)
+	}
+
+	// フラグは cfg に直接バインドされているため、指定された値を控えておき読み込み後に再度適用する
+	reapply := map[string]func() error{}
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		// スライスのフラグは Set で値が追加されるため、指定された値で置き換える
+		if v, ok := f.Value.(pflag.SliceValue); ok {
+			values := v.GetSlice()
+			reapply[f.Name] = func() error { return v.Replace(values) }
+			return
+		}
+		value := f.Value.String()
+		reapply[f.Name] = func() error { return f.Value.Set(value) }
+	})
+
+	loaded, err := config.Load(path)
+	if err != nil {
+		return err
+	}
+	*cfg = *loaded
+	for name, apply := range reapply {
+		if err := apply(); err != nil {
+			return fmt.Errorf("invalid --%s: %w", name, err)
+		}
+	}
+
+	return cfg.Validate()
+}
+
+// initLogger は設定に従ってデフォルトロガーを初期化する
+func initLogger() error {
+	level, err := logger.ParseLevel(cfg.Logging.Level)
+	if err != nil {
+		return err
+	}
+	logLevel.Set(level)
+
+	output := os.Stdout
+	if cfg.Logging.Output == config.LogOutputStderr {
+		output = os.Stderr
+	}
+	l, err := logger.New(logger.Options{
+		Level:     logLevel,
+		Format:    cfg.Logging.Format,
+		AddSource: cfg.Logging.Source,
+		Output:    output,
+	})
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(l)
+	return nil
 }
 
 func main() {
-	fmt.Printf("Version: %s, Revision: %s, Build: %s\n", version, revision, build)
+	// 標準出力はコマンドの結果に使うため、バージョン情報は標準エラー出力に出力する
+	fmt.Fprintf(os.Stderr, "Version: %s, Revision: %s, Build: %s\n", version, revision, build)
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/adapter/migration"
+	"github.com/spf13/cobra"
+	"gorm.io/gorm"
+)
+
+var downSteps int
+
+func init() {
+	// migrate コマンドを root コマンドに追加
+	rootCmd.AddCommand(migrateCmd)
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd)
+
+	// フラグの定義
+	migrateDownCmd.Flags().IntVar(&downSteps, "steps", 1, "ロールバックするマイグレーションの数")
+}
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "データベースのマイグレーションを管理",
+	Long:  "バイナリに埋め込まれたマイグレーションを適用・ロールバックします。sql-migrate と同じ gorp_migrations テーブルで適用状況を管理します。",
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "未適用のマイグレーションを全て適用",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		migrator, db, err := newMigrator(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer closeDB(db)
+
+		n, err := migrator.Up(cmd.Context())
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Applied %d migration(s)\n", n)
+		return nil
+	},
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down",
+	Short: "適用済みのマイグレーションをロールバック",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		migrator, db, err := newMigrator(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer closeDB(db)
+
+		n, err := migrator.Down(cmd.Context(), downSteps)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Rolled back %d migration(s)\n", n)
+		return nil
+	},
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "マイグレーションの適用状況を表示",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		migrator, db, err := newMigrator(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer closeDB(db)
+
+		statuses, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "MIGRATION\tAPPLIED")
+		for _, s := range statuses {
+			applied := "no"
+			if s.AppliedAt != nil {
+				applied = s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%s\n", s.ID, applied)
+		}
+		return w.Flush()
+	},
+}
+
+// newMigrator はデータベースに接続し、Migratorを生成する
+// 使い終わったら、返した接続を closeDB で閉じること
+func newMigrator(ctx context.Context) (*migration.Migrator, *gorm.DB, error) {
+	db, _, err := openDB(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	migrator, err := migratorFor(db)
+	if err != nil {
+		closeDB(db)
+		return nil, nil, err
+	}
+	return migrator, db, nil
+}
+
+// migratorFor は GORM の接続から Migrator を生成する
+func migratorFor(db *gorm.DB) (*migration.Migrator, error) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database handle: %w", err)
+	}
+	return migration.NewMigrator(sqlDB, db.Dialector.Name())
+}
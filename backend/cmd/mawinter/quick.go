@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/azuki774/mawinter/api"
+	"github.com/spf13/cobra"
+)
+
+var (
+	quickYes    bool
+	quickDryRun bool
+)
+
+func init() {
+	// record quick コマンドを record コマンドに追加
+	recordCmd.AddCommand(recordQuickCmd)
+
+	recordQuickCmd.Flags().BoolVarP(&quickYes, "yes", "y", false, "確認せずに登録する")
+	recordQuickCmd.Flags().BoolVar(&quickDryRun, "dry-run", false, "解釈した内容を表示するのみで登録しない")
+}
+
+var recordQuickCmd = &cobra.Command{
+	Use:   "quick <text>...",
+	Short: "短い文からレコードを登録",
+	Long: `「食費 1280 コンビニ 昨日」「210 1280」のような短い文をサーバで解釈し、確認した上でレコードを登録します。
+カテゴリ名・別名（features.category_aliases）・カテゴリ ID、金額、日付（今日・昨日・先週金曜・10/18 など）、メモを解釈します。`,
+	Example: `  mawinter record quick 食費 1280 コンビニ 昨日
+  mawinter record quick -y 210 1280`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newAPIClient(cfg.Client)
+		if err != nil {
+			return err
+		}
+		parsed, err := client.PostV3RecordParseWithResponse(cmd.Context(), api.QuickEntry{Text: strings.Join(args, " ")})
+		if err != nil {
+			return fmt.Errorf("failed to send request: %w", err)
+		}
+		if parsed.JSON200 == nil {
+			return responseError(parsed.HTTPResponse, parsed.Body)
+		}
+		entry := *parsed.JSON200
+
+		if quickDryRun {
+			return writeOutput(os.Stdout, cfg.Client.Output, parsedEntryOutput(entry))
+		}
+		// 解釈した内容は、標準出力を登録したレコードの出力に使うため標準エラー出力に表示する
+		writeParsedEntry(os.Stderr, entry)
+		if !quickYes && !confirm(os.Stdin, os.Stderr, "Create this record?") {
+			fmt.Fprintln(os.Stderr, "Canceled")
+			return nil
+		}
+
+		res, err := client.PostV3RecordWithResponse(cmd.Context(), entry.Record)
+		if err != nil {
+			return fmt.Errorf("failed to send request: %w", err)
+		}
+		if res.JSON201 == nil {
+			return responseError(res.HTTPResponse, res.Body)
+		}
+		return writeOutput(os.Stdout, cfg.Client.Output, recordsOutput([]api.Record{*res.JSON201}, *res.JSON201))
+	},
+}
+
+// parsedEntryOutput は解釈した内容の出力内容を返す
+func parsedEntryOutput(entry api.ParsedEntry) output {
+	r := entry.Record
+	return output{
+		header: []string{"category_id", "category_name", "price", "datetime", "memo", "tags"},
+		rows:   [][]string{{strconv.Itoa(*r.CategoryId), entry.CategoryName, strconv.Itoa(r.Price), valueOr(r.Datetime, ""), valueOr(r.Memo, ""), tagsOf(r)}},
+		value:  entry,
+	}
+}
+
+// writeParsedEntry は解釈した内容を確認用に書き出す
+func writeParsedEntry(w io.Writer, entry api.ParsedEntry) {
+	r := entry.Record
+	fmt.Fprintf(w, "category: %d %s (%s)\n", *r.CategoryId, entry.CategoryName, entry.CategoryType)
+	fmt.Fprintf(w, "price:    %d\n", r.Price)
+	fmt.Fprintf(w, "date:     %s\n", valueOr(r.Datetime, "(now)"))
+	fmt.Fprintf(w, "memo:     %s\n", valueOr(r.Memo, ""))
+	if r.Type != nil {
+		fmt.Fprintf(w, "type:     %s\n", *r.Type)
+	}
+	if r.Tags != nil {
+		fmt.Fprintf(w, "tags:     %s\n", tagsOf(r))
+	}
+	if len(entry.Rules) > 0 {
+		fmt.Fprintf(w, "rules:    %s\n", strings.Join(entry.Rules, ", "))
+	}
+}
+
+// tagsOf はレコードのタグをカンマ区切りで返す
+func tagsOf(r api.ReqRecord) string {
+	if r.Tags == nil {
+		return ""
+	}
+	return strings.Join(*r.Tags, ",")
+}
+
+// confirm は確認のメッセージを表示し、y または yes が入力された場合に true を返す
+func confirm(r io.Reader, w io.Writer, message string) bool {
+	fmt.Fprintf(w, "%s [y/N]: ", message)
+	line, _ := bufio.NewReader(r).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(line)) {
+	case "y", "yes":
+		return true
+	}
+	return false
+}
+
+// valueOr はポインタが nil の場合に def を返す
+func valueOr(p *string, def string) string {
+	if p == nil {
+		return def
+	}
+	return *p
+}
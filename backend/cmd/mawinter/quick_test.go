@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/azuki774/mawinter/api"
+	"github.com/azuki774/mawinter/pkg/config"
+)
+
+func TestConfirm(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "正常系: y", input: "y\n", want: true},
+		{name: "正常系: 大文字・前後の空白を無視する", input: "  YES \n", want: true},
+		{name: "正常系: 改行のない入力", input: "y", want: true},
+		{name: "異常系: 空の入力は登録しない", input: "\n", want: false},
+		{name: "異常系: 入力がない", input: "", want: false},
+		{name: "異常系: y 以外", input: "no\n", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var w bytes.Buffer
+			if got := confirm(strings.NewReader(tt.input), &w, "Create this record?"); got != tt.want {
+				t.Errorf("confirm() = %v, want %v", got, tt.want)
+			}
+			if w.String() != "Create this record? [y/N]: " {
+				t.Errorf("unexpected prompt %q", w.String())
+			}
+		})
+	}
+}
+
+func TestParsedEntryOutput(t *testing.T) {
+	categoryID, memo := 210, "コンビニ"
+	entry := api.ParsedEntry{
+		Record:       api.ReqRecord{CategoryId: &categoryID, Price: 1280, Memo: &memo, Tags: &[]string{"コンビニ", "朝食"}},
+		CategoryName: "食費",
+		CategoryType: "outgoing",
+		Rules:        []string{"コンビニ"},
+	}
+
+	var buf bytes.Buffer
+	if err := writeOutput(&buf, config.OutputCSV, parsedEntryOutput(entry)); err != nil {
+		t.Fatalf("writeOutput() error = %v", err)
+	}
+	// 日付を省略した場合は空とする
+	want := "category_id,category_name,price,datetime,memo,tags\n210,食費,1280,,コンビニ,\"コンビニ,朝食\"\n"
+	if buf.String() != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	writeParsedEntry(&buf, entry)
+	if !strings.Contains(buf.String(), "category: 210 食費 (outgoing)") || !strings.Contains(buf.String(), "date:     (now)") || !strings.Contains(buf.String(), "rules:    コンビニ") {
+		t.Errorf("unexpected confirmation:\n%s", buf.String())
+	}
+}
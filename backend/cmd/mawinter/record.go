@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/azuki774/mawinter/api"
+	"github.com/spf13/cobra"
+)
+
+// recordAdd は record add コマンドで登録するレコード
+var recordAdd api.ReqRecord
+
+func init() {
+	// record コマンドを root コマンドに追加
+	rootCmd.AddCommand(recordCmd)
+	recordCmd.AddCommand(recordAddCmd, recordListCmd, recordDeleteCmd)
+	addClientFlags(recordCmd)
+
+	// フラグの定義（任意の項目は指定された場合のみ送信する）
+	recordAddCmd.Flags().Int("category-id", 0, "カテゴリ ID（省略した場合はサーバの自動分類の規則で設定する）")
+	recordAddCmd.Flags().IntVar(&recordAdd.Price, "price", 0, "金額")
+	recordAddCmd.Flags().String("datetime", "", "日時（YYYYMMDD または RFC 3339 形式。省略した場合は現在日時）")
+	recordAddCmd.Flags().String("from", "", "登録元")
+	recordAddCmd.Flags().String("type", "", "種別")
+	recordAddCmd.Flags().String("memo", "", "メモ")
+	recordAddCmd.Flags().StringSlice("tag", nil, "タグ（複数指定できる）")
+	_ = recordAddCmd.MarkFlagRequired("price")
+
+	recordListCmd.Flags().Int("num", 20, "取得する件数")
+	recordListCmd.Flags().Int("offset", 0, "読み飛ばす件数")
+	recordListCmd.Flags().String("yyyymm", "", "対象の年月（YYYYMM 形式）")
+	recordListCmd.Flags().Int("category-id", 0, "対象のカテゴリ ID")
+}
+
+var recordCmd = &cobra.Command{
+	Use:   "record",
+	Short: "サーバのレコードを操作",
+	Long:  "--url（MAWINTER_URL）で指定したサーバに接続し、レコードの登録・一覧・削除を行います。",
+}
+
+var recordAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "レコードを登録",
+	Long:  "レコードを登録し、登録したレコードを表示します。",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := recordAdd
+		req.CategoryId = changedInt(cmd, "category-id")
+		req.Datetime = changedString(cmd, "datetime")
+		req.From = changedString(cmd, "from")
+		req.Type = changedString(cmd, "type")
+		req.Memo = changedString(cmd, "memo")
+		if cmd.Flags().Changed("tag") {
+			tags, _ := cmd.Flags().GetStringSlice("tag")
+			req.Tags = &tags
+		}
+
+		client, err := newAPIClient(cfg.Client)
+		if err != nil {
+			return err
+		}
+		res, err := client.PostV3RecordWithResponse(cmd.Context(), req)
+		if err != nil {
+			return fmt.Errorf("failed to send request: %w", err)
+		}
+		if res.JSON201 == nil {
+			return responseError(res.HTTPResponse, res.Body)
+		}
+		return writeOutput(os.Stdout, cfg.Client.Output, recordsOutput([]api.Record{*res.JSON201}, *res.JSON201))
+	},
+}
+
+var recordListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "レコードの一覧を表示",
+	Long:  "レコードを新しい順に表示します。",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var params api.GetV3RecordParams
+		params.Num = changedInt(cmd, "num")
+		params.Offset = changedInt(cmd, "offset")
+		params.Yyyymm = changedString(cmd, "yyyymm")
+		params.CategoryId = changedInt(cmd, "category-id")
+
+		client, err := newAPIClient(cfg.Client)
+		if err != nil {
+			return err
+		}
+		res, err := client.GetV3RecordWithResponse(cmd.Context(), &params)
+		if err != nil {
+			return fmt.Errorf("failed to send request: %w", err)
+		}
+		if res.JSON200 == nil {
+			return responseError(res.HTTPResponse, res.Body)
+		}
+		return writeOutput(os.Stdout, cfg.Client.Output, recordsOutput(*res.JSON200, *res.JSON200))
+	},
+}
+
+var recordDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "レコードを削除",
+	Long:  "レコードをゴミ箱に移動します。",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid record id %q", args[0])
+		}
+
+		client, err := newAPIClient(cfg.Client)
+		if err != nil {
+			return err
+		}
+		res, err := client.DeleteV3RecordIdWithResponse(cmd.Context(), id)
+		if err != nil {
+			return fmt.Errorf("failed to send request: %w", err)
+		}
+		if res.StatusCode() != http.StatusNoContent {
+			return responseError(res.HTTPResponse, res.Body)
+		}
+		fmt.Fprintf(os.Stderr, "Record %d deleted\n", id)
+		return nil
+	},
+}
+
+// recordsOutput はレコードの一覧の出力内容を返す
+// json 形式では value をそのまま出力する
+func recordsOutput(records []api.Record, value any) output {
+	out := output{
+		header: []string{"id", "datetime", "category_id", "category_name", "price", "from", "type", "memo", "tags"},
+		value:  value,
+	}
+	for _, r := range records {
+		out.rows = append(out.rows, []string{
+			strconv.Itoa(r.Id),
+			r.Datetime.Format(time.RFC3339),
+			strconv.Itoa(r.CategoryId),
+			r.CategoryName,
+			strconv.Itoa(r.Price),
+			r.From,
+			r.Type,
+			r.Memo,
+			strings.Join(r.Tags, ","),
+		})
+	}
+	return out
+}
+
+// changedString は指定されたフラグの値を返す。フラグが指定されていない場合は nil を返す
+func changedString(cmd *cobra.Command, name string) *string {
+	if !cmd.Flags().Changed(name) {
+		return nil
+	}
+	v, _ := cmd.Flags().GetString(name)
+	return &v
+}
+
+// changedInt は指定されたフラグの値を返す。フラグが指定されていない場合は nil を返す
+func changedInt(cmd *cobra.Command, name string) *int {
+	if !cmd.Flags().Changed(name) {
+		return nil
+	}
+	v, _ := cmd.Flags().GetInt(name)
+	return &v
+}
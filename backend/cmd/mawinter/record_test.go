@@ -0,0 +1,38 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/azuki774/mawinter/api"
+)
+
+func TestRecordAddCmd(t *testing.T) {
+	t.Setenv(configEnv, "")
+	t.Setenv("MAWINTER_URL", "")
+
+	var got api.ReqRecord
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Errorf("invalid request body %s: %v", body, err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, `{"id":1,"category_id":210,"category_name":"食費","datetime":"2025-10-01T00:00:00Z","from":"","type":"","memo":"","price":100,"tags":["a","b"]}`)
+	}))
+	defer ts.Close()
+
+	// 設定の読み込み後にフラグを再度適用しても、タグは重複しない
+	rootCmd.SetArgs([]string{"record", "add", "--url", ts.URL, "--output", "json", "--price", "100", "--tag", "a", "--tag", "b"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if got.Price != 100 || got.Tags == nil || strings.Join(*got.Tags, ",") != "a,b" {
+		t.Errorf("unexpected request: price %d, tags %v", got.Price, got.Tags)
+	}
+}
@@ -5,33 +5,51 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
+	"github.com/azuki774/mawinter/internal/adapter/backup"
 	"github.com/azuki774/mawinter/internal/adapter/http"
+	"github.com/azuki774/mawinter/internal/adapter/memory"
+	"github.com/azuki774/mawinter/internal/adapter/metrics"
+	"github.com/azuki774/mawinter/internal/adapter/migration"
 	"github.com/azuki774/mawinter/internal/adapter/repository"
 	"github.com/azuki774/mawinter/internal/application"
+	"github.com/azuki774/mawinter/internal/domain"
 	"github.com/azuki774/mawinter/pkg/config"
-	"github.com/azuki774/mawinter/pkg/logger"
 	"github.com/azuki774/mawinter/pkg/telemetry"
 	"github.com/spf13/cobra"
 	"go.opentelemetry.io/otel"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	gormotel "gorm.io/plugin/opentelemetry/tracing"
 )
 
-var (
-	port int
-	host string
-)
-
 func init() {
 	// serve コマンドを root コマンドに追加
 	rootCmd.AddCommand(serveCmd)
 
-	// フラグの定義
-	serveCmd.Flags().IntVarP(&port, "port", "p", 8080, "HTTPサーバのポート番号")
-	serveCmd.Flags().StringVarP(&host, "host", "H", "0.0.0.0", "HTTPサーバのホスト")
+	// フラグの定義（デフォルト値は設定ファイル・環境変数の値で上書きされる）
+	server, features := &cfg.Server, &cfg.Features
+	serveCmd.Flags().IntVarP(&server.Port, "port", "p", server.Port, "HTTPサーバのポート番号")
+	serveCmd.Flags().StringVarP(&server.Host, "host", "H", server.Host, "HTTPサーバのホスト")
+	serveCmd.Flags().DurationVar(&features.TrashRetention, "trash-retention", features.TrashRetention, "ゴミ箱内のレコードを保持する期間")
+	serveCmd.Flags().BoolVar(&features.AllowFutureDate, "allow-future-date", features.AllowFutureDate, "翌日以降の日付のレコードの作成・更新を許可する")
+	serveCmd.Flags().BoolVar(&features.AllowZeroPrice, "allow-zero-price", features.AllowZeroPrice, "金額 0 のレコードの作成・更新を許可する")
+	serveCmd.Flags().StringVar(&server.Storage, "storage", server.Storage, "データの保存先（database: DB_DRIVER で指定したデータベース, memory: メモリ上に保持し終了時に破棄）")
+	serveCmd.Flags().BoolVar(&server.AutoMigrate, "auto-migrate", server.AutoMigrate, "起動時に未適用のマイグレーションを自動で適用する")
+	serveCmd.Flags().BoolVar(&cfg.Telemetry.Metrics, "metrics", cfg.Telemetry.Metrics, "/metrics で Prometheus 形式のメトリクスを公開する（OTLP_SERVER 設定時は OTLP でも送信）")
+	serveCmd.Flags().DurationVar(&server.Timeouts.ReadHeader, "read-header-timeout", server.Timeouts.ReadHeader, "リクエストヘッダーの読み込みのタイムアウト（0: なし）")
+	serveCmd.Flags().DurationVar(&server.Timeouts.Read, "read-timeout", server.Timeouts.Read, "リクエスト全体の読み込みのタイムアウト（0: なし）")
+	serveCmd.Flags().DurationVar(&server.Timeouts.Write, "write-timeout", server.Timeouts.Write, "レスポンスの書き込みのタイムアウト（0: なし）")
+	serveCmd.Flags().DurationVar(&server.Timeouts.Idle, "idle-timeout", server.Timeouts.Idle, "Keep-Alive 接続のアイドルタイムアウト（0: なし）")
+	serveCmd.Flags().DurationVar(&server.Timeouts.Shutdown, "shutdown-timeout", server.Timeouts.Shutdown, "終了時に処理中のリクエストの完了を待つ時間（0: 無制限）")
+	serveCmd.Flags().DurationVar(&server.Timeouts.ShutdownDelay, "shutdown-delay", server.Timeouts.ShutdownDelay, "終了時に readiness を 503 にしてから新しい接続の受け付けを止めるまでの時間（0: 待たない）")
+	serveCmd.Flags().StringVar(&features.Backup.Dir, "backup-dir", features.Backup.Dir, "自動バックアップの保存先ディレクトリ（未指定の場合は自動バックアップを行わない）")
+	serveCmd.Flags().DurationVar(&features.Backup.Interval, "backup-interval", features.Backup.Interval, "自動バックアップの実行間隔")
+	serveCmd.Flags().IntVar(&features.Backup.KeepLast, "backup-keep-last", features.Backup.KeepLast, "自動バックアップを新しい順に保持する件数")
+	serveCmd.Flags().IntVar(&features.Backup.KeepDaily, "backup-keep-daily", features.Backup.KeepDaily, "自動バックアップを日ごとに1件保持する日数")
+	serveCmd.Flags().IntVar(&features.Backup.KeepMonthly, "backup-keep-monthly", features.Backup.KeepMonthly, "自動バックアップを月ごとに1件保持する月数")
 }
 
 var serveCmd = &cobra.Command{
@@ -39,17 +57,21 @@ var serveCmd = &cobra.Command{
 	Short: "HTTPサーバを起動",
 	Long:  "Mawinter の HTTP API サーバを起動します。",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return runServer(host, port)
+		return runServer()
 	},
 }
 
-func runServer(host string, port int) error {
-	// デフォルトロガーの初期化
-	slog.SetDefault(logger.New())
-
-	ctx := context.Background()
+func runServer() error {
+	// SIGINT / SIGTERM を受け取ったらサーバを停止する
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		// 2回目のシグナルでは即座に終了できるよう、シグナルの扱いを元に戻す
+		stop()
+	}()
 
-	otlpEndpoint := os.Getenv("OTLP_SERVER")
+	otlpEndpoint := cfg.Telemetry.OTLPServer
 	shutdownTracer, tracingEnabled, err := telemetry.Init(ctx, otlpEndpoint, telemetry.ServiceNameAPI, version)
 	if err != nil {
 		slog.Error("Failed to initialize tracing",
@@ -58,6 +80,7 @@ func runServer(host string, port int) error {
 		return fmt.Errorf("failed to initialize tracing: %w", err)
 	}
 
+	// 終了処理は登録と逆順に実行される（HTTP サーバ → 自動バックアップ → DB → メトリクス → トレーサー）
 	defer func() {
 		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
@@ -74,67 +97,253 @@ func runServer(host string, port int) error {
 		slog.Info("OpenTelemetry tracing disabled (OTLP_SERVER not set)")
 	}
 
+	// メトリクスの初期化
+	timeouts := http.Timeouts(cfg.Server.Timeouts)
+	serverOpts := []http.ServerOption{http.WithTimeouts(timeouts), http.WithLogLevel(logLevel)}
+	if cfg.Telemetry.Metrics {
+		metricsHandler, shutdownMetrics, otlpMetrics, err := telemetry.InitMetrics(ctx, otlpEndpoint, telemetry.ServiceNameAPI, version)
+		if err != nil {
+			slog.Error("Failed to initialize metrics",
+				slog.String("error", err.Error()),
+			)
+			return fmt.Errorf("failed to initialize metrics: %w", err)
+		}
+		defer func() {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := shutdownMetrics(shutdownCtx); err != nil {
+				slog.Error("Failed to shutdown metrics", slog.String("error", err.Error()))
+			}
+		}()
+		serverOpts = append(serverOpts, http.WithMetricsHandler(metricsHandler))
+		slog.Info("Metrics enabled",
+			slog.String("path", http.MetricsPath),
+			slog.Bool("otlp", otlpMetrics),
+		)
+	}
+
 	slog.Info("Starting Mawinter server",
-		slog.String("host", host),
-		slog.Int("port", port),
+		slog.String("host", cfg.Server.Host),
+		slog.Int("port", cfg.Server.Port),
 		slog.String("version", version),
 		slog.String("revision", revision),
 		slog.String("build", build),
 	)
 
-	// データベース設定の読み込み
-	dbInfo, err := config.LoadDBInfo()
+	// リポジトリの初期化
+	var (
+		categoryRepo domain.CategoryRepository
+		recordRepo   domain.RecordRepository
+		backupRepo   domain.BackupRepository
+		checkers     []domain.HealthChecker
+		dbInfo       *config.DBInfo
+	)
+	backupConfig := cfg.Features.Backup
+	switch cfg.Server.Storage {
+	case config.StorageMemory:
+		slog.Warn("Using in-memory storage; all data will be lost when the server stops")
+		categories := memory.NewCategoryRepository(memory.DefaultCategories())
+		categoryRepo = categories
+		recordRepo = memory.NewRecordRepository(categories)
+	case config.StorageDatabase:
+		var db *gorm.DB
+		db, dbInfo, err = openDatabase(ctx)
+		if err != nil {
+			return err
+		}
+		defer closeDB(db)
+		// ログレベルを debug にするとコネクションプールの統計を定期的に出力する
+		go repository.LogPoolStats(ctx, db, repository.PoolStatsInterval)
+		categoryRepo = repository.NewCategoryRepository(db)
+		recordRepo = repository.NewRecordRepository(db)
+
+		migrator, err := migratorFor(db)
+		if err != nil {
+			return err
+		}
+		checkers = append(checkers,
+			repository.NewDatabaseHealthChecker(db),
+			migration.NewSchemaHealthChecker(migrator),
+		)
+
+		if backupConfig.Dir != "" {
+			backupRepo = backup.NewFileRepository(backup.NewArchiver(db, version), backupConfig.Dir)
+		}
+	}
+
+	rules, err := newRuleSet(cfg.Features.Rules)
 	if err != nil {
-		slog.Error("Failed to load database configuration",
-			slog.String("error", err.Error()),
+		return err
+	}
+	if rules.Len() > 0 {
+		slog.Info("Auto-categorization rules loaded", slog.Int("rules", rules.Len()))
+	}
+
+	// 依存性の注入
+	// カテゴリの推定モデル・レコードのメトリクスは、レコードの作成・更新・削除を RecordService から受け取って差分で更新する
+	categoryService := application.NewCategoryService(categoryRepo)
+	suggestionService := application.NewSuggestionService(recordRepo, categoryRepo)
+	recordOpts := []application.RecordServiceOption{
+		application.WithTrashRetention(cfg.Features.TrashRetention),
+		application.WithRecordPolicy(domain.RecordPolicy{AllowFutureDate: cfg.Features.AllowFutureDate, AllowZeroPrice: cfg.Features.AllowZeroPrice}),
+		application.WithCategoryAliases(cfg.Features.CategoryAliases),
+		application.WithRules(rules),
+		application.WithRecordObserver(suggestionService),
+	}
+	if cfg.Telemetry.Metrics {
+		recordMetrics, err := metrics.RegisterRecordMetrics(recordRepo, categoryRepo)
+		if err != nil {
+			return fmt.Errorf("failed to register record metrics: %w", err)
+		}
+		recordOpts = append(recordOpts, application.WithRecordObserver(recordMetrics))
+	}
+	recordService := application.NewRecordService(recordRepo, categoryRepo, recordOpts...)
+	serverOpts = append(serverOpts, http.WithSuggestionService(suggestionService))
+	healthService := application.NewHealthService(checkers...)
+
+	// 自動バックアップの開始
+	var backupService *application.BackupService
+	if backupRepo != nil {
+		retention := domain.BackupRetention{KeepLast: backupConfig.KeepLast, KeepDaily: backupConfig.KeepDaily, KeepMonthly: backupConfig.KeepMonthly}
+		backupService = application.NewBackupService(backupRepo, backupConfig.Interval, retention)
+		slog.Info("Scheduled backup enabled",
+			slog.String("dir", backupConfig.Dir),
+			slog.String("interval", backupConfig.Interval.String()),
+			slog.Int("keep_last", retention.KeepLast),
+			slog.Int("keep_daily", retention.KeepDaily),
+			slog.Int("keep_monthly", retention.KeepMonthly),
 		)
-		return fmt.Errorf("failed to load database configuration: %w", err)
+		backupDone := make(chan struct{})
+		go func() {
+			defer close(backupDone)
+			backupService.Run(ctx)
+		}()
+		// 実行中のバックアップが中断されるのを待ってから DB を閉じる
+		defer func() { <-backupDone }()
 	}
 
-	slog.Info("Database configuration loaded",
-		slog.String("host", dbInfo.Host),
-		slog.String("port", dbInfo.Port),
-		slog.String("user", dbInfo.User),
-		slog.String("name", dbInfo.Name),
-	)
+	// HTTPサーバの起動
+	server := http.NewServer(cfg.Server.Host, cfg.Server.Port, version, revision, build, dbInfo, categoryService, recordService, healthService, backupService, serverOpts...)
+	return server.Run(ctx)
+}
 
-	// データベース接続の初期化
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
-		dbInfo.User,
-		dbInfo.Pass,
-		dbInfo.Host,
-		dbInfo.Port,
-		dbInfo.Name,
-	)
-	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+// newRuleSet は設定の自動分類の規則をドメインの規則に変換する
+func newRuleSet(configs []config.RuleConfig) (*domain.RuleSet, error) {
+	rules := make([]domain.Rule, len(configs))
+	for i, c := range configs {
+		weekdays := make([]time.Weekday, 0, len(c.Match.Weekdays))
+		for _, name := range c.Match.Weekdays {
+			d, ok := domain.ParseWeekday(name)
+			if !ok {
+				return nil, fmt.Errorf("invalid weekday in features.rules[%d]: %q", i, name)
+			}
+			weekdays = append(weekdays, d)
+		}
+		rules[i] = domain.Rule{
+			Name:     c.Name,
+			Priority: c.Priority,
+			Match: domain.RuleMatch{
+				MemoContains: c.Match.MemoContains,
+				MemoRegex:    c.Match.MemoRegex,
+				From:         c.Match.From,
+				MinPrice:     c.Match.MinPrice,
+				MaxPrice:     c.Match.MaxPrice,
+				Weekdays:     weekdays,
+			},
+			Set: domain.RuleAction{CategoryID: c.Set.CategoryID, Type: c.Set.Type, Tags: c.Set.Tags},
+		}
+	}
+	set, err := domain.NewRuleSet(rules)
 	if err != nil {
-		slog.Error("Failed to connect to database",
-			slog.String("error", err.Error()),
-		)
-		return fmt.Errorf("failed to connect to database: %w", err)
+		return nil, fmt.Errorf("invalid features.rules: %w", err)
+	}
+	return set, nil
+}
+
+// closeDB はデータベースのコネクションプールを閉じる
+func closeDB(db *gorm.DB) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		slog.Error("Failed to get database handle", slog.String("error", err.Error()))
+		return
+	}
+	slog.Info("Database connection pool stats", repository.PoolStatsAttrs(sqlDB.Stats())...)
+	if err := sqlDB.Close(); err != nil {
+		slog.Error("Failed to close database", slog.String("error", err.Error()))
+		return
+	}
+	slog.Info("Database connection closed")
+}
+
+// openDatabase はデータベースに接続し、スキーマが最新であることを確認する
+func openDatabase(ctx context.Context) (*gorm.DB, *config.DBInfo, error) {
+	db, dbInfo, err := openDB(ctx)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	if err := db.Use(gormotel.NewPlugin(
 		gormotel.WithTracerProvider(otel.GetTracerProvider()),
-		gormotel.WithDBSystem("mysql"),
-		gormotel.WithoutMetrics(),
+		gormotel.WithDBSystem(db.Dialector.Name()),
 	)); err != nil {
 		slog.Error("Failed to enable GORM tracing",
 			slog.String("error", err.Error()),
 		)
-		return fmt.Errorf("failed to enable database tracing: %w", err)
+		closeDB(db)
+		return nil, nil, fmt.Errorf("failed to enable database tracing: %w", err)
+	}
+
+	// クエリの所要時間を記録する（コネクションプールの統計は上のプラグインが記録する）
+	metricsPlugin, err := repository.NewMetricsPlugin()
+	if err == nil {
+		err = db.Use(metricsPlugin)
+	}
+	if err != nil {
+		slog.Error("Failed to enable GORM metrics",
+			slog.String("error", err.Error()),
+		)
+		closeDB(db)
+		return nil, nil, fmt.Errorf("failed to enable database metrics: %w", err)
 	}
 
 	slog.Info("Database connection established")
 
-	// 依存性の注入
-	categoryRepo := repository.NewCategoryRepository(db)
-	categoryService := application.NewCategoryService(categoryRepo)
+	// スキーマが最新であることを確認
+	if err := ensureSchema(ctx, db, cfg.Server.AutoMigrate); err != nil {
+		slog.Error("Database schema check failed",
+			slog.String("error", err.Error()),
+		)
+		closeDB(db)
+		return nil, nil, err
+	}
 
-	recordRepo := repository.NewRecordRepository(db)
-	recordService := application.NewRecordService(recordRepo)
+	return db, dbInfo, nil
+}
 
-	// HTTPサーバの起動
-	server := http.NewServer(host, port, version, revision, build, dbInfo, categoryService, recordService)
-	return server.Start()
+// ensureSchema は未適用のマイグレーションがないことを確認する
+// autoMigrate が有効な場合は未適用のマイグレーションを適用し、無効な場合はエラーを返す
+func ensureSchema(ctx context.Context, db *gorm.DB, autoMigrate bool) error {
+	migrator, err := migratorFor(db)
+	if err != nil {
+		return err
+	}
+
+	pending, err := migrator.Pending()
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	if !autoMigrate {
+		return fmt.Errorf("database schema is behind: %d pending migration(s) %v; run `mawinter migrate up` or start with --auto-migrate", len(pending), pending)
+	}
+
+	n, err := migrator.Up(ctx)
+	if err != nil {
+		return err
+	}
+	slog.Info("Database migrations applied", slog.Int("count", n))
+	return nil
 }
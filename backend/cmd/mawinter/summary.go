@@ -0,0 +1,168 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/azuki774/mawinter/api"
+	"github.com/spf13/cobra"
+)
+
+// summaryMonths は年度のサマリーの月の列名（4月〜3月）
+var summaryMonths = []string{"apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec", "jan", "feb", "mar"}
+
+func init() {
+	// summary コマンドを root コマンドに追加
+	rootCmd.AddCommand(summaryCmd)
+	addClientFlags(summaryCmd)
+
+	summaryCmd.Flags().String("as-of", "", "指定した日時（RFC 3339 形式）時点の履歴に基づいて計算する")
+	summaryCmd.Flags().Bool("calendar", false, "会計年度の代わりに暦年（1月〜12月）で集計する")
+	summaryCmd.Flags().String("from", "", "任意の期間の初日（YYYYMMDD）。--to とともに指定し、年は指定しない")
+	summaryCmd.Flags().String("to", "", "任意の期間の最終日（YYYYMMDD、この日を含む）")
+	summaryCmd.Flags().String("bucket", "", "暦年・任意の期間で金額を区切る単位（day, week, month, quarter。デフォルト month）")
+}
+
+var summaryCmd = &cobra.Command{
+	Use:   "summary [<fy>]",
+	Short: "サーバの年度・期間のサマリーを表示",
+	Long: `--url（MAWINTER_URL）で指定したサーバに接続し、会計年度（4月〜翌年3月）のカテゴリ別・月別の合計金額を表示します。
+--calendar で暦年、--from・--to で任意の期間のサマリーを、--bucket の単位で区切って表示します。`,
+	Example: `  mawinter summary 2025
+  mawinter summary 2025 --calendar --bucket quarter
+  mawinter summary --from 20251001 --to 20251031 --bucket week`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		from, to := changedString(cmd, "from"), changedString(cmd, "to")
+		if from != nil || to != nil {
+			if from == nil || to == nil || len(args) > 0 {
+				return fmt.Errorf("--from and --to must be specified together without a year")
+			}
+			return runPeriodSummary(cmd, nil, from, to)
+		}
+		if len(args) == 0 {
+			return fmt.Errorf("a year or --from and --to is required")
+		}
+		if calendar, _ := cmd.Flags().GetBool("calendar"); calendar {
+			year, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid year %q", args[0])
+			}
+			return runPeriodSummary(cmd, &year, nil, nil)
+		}
+		if cmd.Flags().Changed("bucket") {
+			return fmt.Errorf("--bucket requires --calendar or --from and --to")
+		}
+
+		year, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid fiscal year %q", args[0])
+		}
+		var params api.GetV3RecordYearParams
+		if v := changedString(cmd, "as-of"); v != nil {
+			asOf, err := time.Parse(time.RFC3339, *v)
+			if err != nil {
+				return fmt.Errorf("invalid --as-of: %w", err)
+			}
+			params.AsOf = &asOf
+		}
+
+		client, err := newAPIClient(cfg.Client)
+		if err != nil {
+			return err
+		}
+		res, err := client.GetV3RecordYearWithResponse(cmd.Context(), year, &params)
+		if err != nil {
+			return fmt.Errorf("failed to send request: %w", err)
+		}
+		if res.JSON200 == nil {
+			return responseError(res.HTTPResponse, res.Body)
+		}
+		return writeOutput(os.Stdout, cfg.Client.Output, summaryOutput(*res.JSON200))
+	},
+}
+
+// runPeriodSummary は暦年（year を指定した場合）または任意の期間のサマリーを取得して表示する
+func runPeriodSummary(cmd *cobra.Command, year *int, from, to *string) error {
+	if cmd.Flags().Changed("as-of") {
+		return fmt.Errorf("--as-of is only available for fiscal year summaries")
+	}
+	var bucket *api.SummaryBucket
+	if v := changedString(cmd, "bucket"); v != nil {
+		b := api.SummaryBucket(*v)
+		bucket = &b
+	}
+
+	client, err := newAPIClient(cfg.Client)
+	if err != nil {
+		return err
+	}
+	var summary *api.PeriodSummary
+	var httpRes *http.Response
+	var body []byte
+	if year != nil {
+		res, err := client.GetV3RecordSummaryCalendarWithResponse(cmd.Context(), *year, &api.GetV3RecordSummaryCalendarParams{Bucket: bucket})
+		if err != nil {
+			return fmt.Errorf("failed to send request: %w", err)
+		}
+		summary, httpRes, body = res.JSON200, res.HTTPResponse, res.Body
+	} else {
+		res, err := client.GetV3RecordSummaryRangeWithResponse(cmd.Context(), &api.GetV3RecordSummaryRangeParams{From: *from, To: *to, Bucket: bucket})
+		if err != nil {
+			return fmt.Errorf("failed to send request: %w", err)
+		}
+		summary, httpRes, body = res.JSON200, res.HTTPResponse, res.Body
+	}
+	if summary == nil {
+		return responseError(httpRes, body)
+	}
+	return writeOutput(os.Stdout, cfg.Client.Output, periodSummaryOutput(*summary))
+}
+
+// periodSummaryOutput は期間のサマリーの出力内容を返す
+// 区切りの列名は区切りの初日とする
+func periodSummaryOutput(summary api.PeriodSummary) output {
+	out := output{
+		header: []string{"category_id", "category_name", "category_type"},
+		value:  summary,
+	}
+	for _, b := range summary.Buckets {
+		out.header = append(out.header, b.From)
+	}
+	out.header = append(out.header, "total", "count")
+	for _, s := range summary.Categories {
+		row := []string{strconv.Itoa(s.CategoryId), s.CategoryName, string(s.CategoryType)}
+		for i := range summary.Buckets {
+			price := 0
+			if i < len(s.Price) {
+				price = s.Price[i]
+			}
+			row = append(row, strconv.Itoa(price))
+		}
+		out.rows = append(out.rows, append(row, strconv.Itoa(s.Total), strconv.Itoa(s.Count)))
+	}
+	return out
+}
+
+// summaryOutput は年度のサマリーの出力内容を返す
+func summaryOutput(summaries []api.CategoryYearSummary) output {
+	out := output{
+		header: append(append([]string{"category_id", "category_name", "category_type"}, summaryMonths...), "total", "count"),
+		value:  summaries,
+	}
+	for _, s := range summaries {
+		row := []string{strconv.Itoa(s.CategoryId), s.CategoryName, string(s.CategoryType)}
+		for i := range summaryMonths {
+			price := 0
+			if i < len(s.Price) {
+				price = s.Price[i]
+			}
+			row = append(row, strconv.Itoa(price))
+		}
+		out.rows = append(out.rows, append(row, strconv.Itoa(s.Total), strconv.Itoa(s.Count)))
+	}
+	return out
+}
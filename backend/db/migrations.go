@@ -0,0 +1,22 @@
+// Package db はデータベースのスキーマ定義（マイグレーション SQL）を保持する
+package db
+
+import (
+	"embed"
+	"path"
+)
+
+// Migrations は sql-migrate 形式のマイグレーション SQL
+// バイナリに埋め込まれ、mawinter migrate コマンドやサーバ起動時のスキーマ確認で使用される
+// データベースごとにディレクトリを分けており、同じ変更には同じファイル名（マイグレーションID）を付ける
+//
+//go:embed migrations/*/*.sql
+var Migrations embed.FS
+
+// MigrationsRoot は Migrations 内でマイグレーション SQL を配置しているディレクトリ
+const MigrationsRoot = "migrations"
+
+// MigrationsDir は指定されたデータベース（mysql, sqlite, postgres）のマイグレーション SQL を配置しているディレクトリを返す
+func MigrationsDir(driver string) string {
+	return path.Join(MigrationsRoot, driver)
+}
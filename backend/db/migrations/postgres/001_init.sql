@@ -0,0 +1,85 @@
+-- +migrate Up
+
+-- MySQL の ON UPDATE CURRENT_TIMESTAMP に相当する処理をトリガーで行う
+-- updated_at が明示的に更新された場合はその値を優先する
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION mawinter_set_updated_at() RETURNS trigger AS $$
+BEGIN
+  IF NEW."updated_at" IS NOT DISTINCT FROM OLD."updated_at" THEN
+    NEW."updated_at" = CURRENT_TIMESTAMP;
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+CREATE TABLE "Category" (
+  "id" integer GENERATED BY DEFAULT AS IDENTITY,
+  "category_id" int NOT NULL,
+  "name" varchar(255) DEFAULT NULL,
+  "created_at" timestamptz default current_timestamp,
+  "updated_at" timestamptz default current_timestamp,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "category_id" UNIQUE ("category_id")
+);
+
+-- +migrate StatementBegin
+CREATE TRIGGER "trg_Category_updated_at" BEFORE UPDATE ON "Category"
+FOR EACH ROW EXECUTE FUNCTION mawinter_set_updated_at();
+-- +migrate StatementEnd
+
+
+INSERT INTO "Category" ("id", "category_id", "name") VALUES
+(1,100,'月給'),
+(2,101,'ボーナス'),
+(3,110,'雑所得'),
+(4,200,'家賃'),
+(5,210,'食費'),
+(6,220,'電気代'),
+(7,221,'ガス代'),
+(8,222,'水道費'),
+(9,230,'コンピュータリソース'),
+(10,231,'通信費'),
+(11,240,'生活用品'),
+(12,250,'娯楽費'),
+(13,251,'交遊費'),
+(14,260,'書籍・勉強'),
+(15,270,'交通費'),
+(16,280,'衣服等費'),
+(17,300,'保険・税金'),
+(18,400,'医療・衛生'),
+(19,500,'雑費'),
+(20,600,'家賃用貯金'),
+(21,601,'PC用貯金'),
+(22,700,'NISA入出金'),
+(23,701,'NISA変動')
+ON CONFLICT DO NOTHING;
+
+-- id を明示して登録したため、自動採番の開始位置を合わせる
+SELECT setval(pg_get_serial_sequence('"Category"', 'id'), (SELECT MAX("id") FROM "Category"));
+
+create table "Record_YYYYMM" (
+  "id" integer GENERATED BY DEFAULT AS IDENTITY,
+  "category_id" int NOT NULL,
+  "datetime" timestamptz NOT NULL default current_timestamp,
+  "from" varchar(64) NOT NULL,
+  "type" varchar(64) NOT NULL, -- Ignore the check if 'D' is included
+  "price" int NOT NULL,
+  "memo" varchar(255) NOT NULL,
+  "created_at" timestamptz default current_timestamp,
+  "updated_at" timestamptz default current_timestamp,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_cat" ON "Record_YYYYMM" ("category_id");
+CREATE INDEX "idx_date" ON "Record_YYYYMM" ("datetime");
+
+-- +migrate StatementBegin
+CREATE TRIGGER "trg_Record_YYYYMM_updated_at" BEFORE UPDATE ON "Record_YYYYMM"
+FOR EACH ROW EXECUTE FUNCTION mawinter_set_updated_at();
+-- +migrate StatementEnd
+
+
+-- +migrate Down
+DROP TABLE "Category";
+DROP TABLE "Record_YYYYMM";
+DROP FUNCTION mawinter_set_updated_at();
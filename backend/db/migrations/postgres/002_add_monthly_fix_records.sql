@@ -0,0 +1,34 @@
+-- +migrate Up
+CREATE TABLE "Monthly_Fix_Billing" (
+  "id" integer GENERATED BY DEFAULT AS IDENTITY,
+  "category_id" int NOT NULL,
+  "day" int NOT NULL,
+  "price" int NOT NULL,
+  "type" varchar(64) NOT NULL,
+  "memo" varchar(255) NOT NULL,
+  "created_at" timestamptz default current_timestamp,
+  "updated_at" timestamptz default current_timestamp,
+  PRIMARY KEY ("id")
+);
+
+CREATE TABLE "Monthly_Fix_Done" (
+  "yyyymm" varchar(6) NOT NULL,
+  "done" smallint NOT NULL,
+  "created_at" timestamptz default current_timestamp,
+  "updated_at" timestamptz default current_timestamp,
+  PRIMARY KEY ("yyyymm")
+);
+
+-- +migrate StatementBegin
+CREATE TRIGGER "trg_Monthly_Fix_Billing_updated_at" BEFORE UPDATE ON "Monthly_Fix_Billing"
+FOR EACH ROW EXECUTE FUNCTION mawinter_set_updated_at();
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE TRIGGER "trg_Monthly_Fix_Done_updated_at" BEFORE UPDATE ON "Monthly_Fix_Done"
+FOR EACH ROW EXECUTE FUNCTION mawinter_set_updated_at();
+-- +migrate StatementEnd
+
+-- +migrate Down
+DROP TABLE "Monthly_Fix_Billing";
+DROP TABLE "Monthly_Fix_Done";
@@ -0,0 +1,17 @@
+-- +migrate Up
+CREATE TABLE "Monthly_Confirm" (
+  "yyyymm" varchar(6) NOT NULL,
+  "confirm" smallint NOT NULL,
+  "confirm_datetime" timestamptz,
+  "created_at" timestamptz default current_timestamp,
+  "updated_at" timestamptz default current_timestamp,
+  PRIMARY KEY ("yyyymm")
+);
+
+-- +migrate StatementBegin
+CREATE TRIGGER "trg_Monthly_Confirm_updated_at" BEFORE UPDATE ON "Monthly_Confirm"
+FOR EACH ROW EXECUTE FUNCTION mawinter_set_updated_at();
+-- +migrate StatementEnd
+
+-- +migrate Down
+DROP TABLE "Monthly_Confirm";
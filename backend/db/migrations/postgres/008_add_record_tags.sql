@@ -0,0 +1,8 @@
+-- +migrate Up
+-- タグはカンマ区切りで保存する
+ALTER TABLE "Record" ADD COLUMN "tags" varchar(255) NOT NULL DEFAULT '';
+ALTER TABLE "Record_History" ADD COLUMN "tags" varchar(255) NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE "Record_History" DROP COLUMN "tags";
+ALTER TABLE "Record" DROP COLUMN "tags";
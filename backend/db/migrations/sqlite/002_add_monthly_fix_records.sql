@@ -0,0 +1,39 @@
+-- +migrate Up
+CREATE TABLE `Monthly_Fix_Billing` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `category_id` int NOT NULL,
+  `day` int NOT NULL,
+  `price` int NOT NULL,
+  `type` varchar(64) NOT NULL,
+  `memo` varchar(255) NOT NULL,
+  `created_at` datetime default current_timestamp,
+  `updated_at` timestamp default current_timestamp
+);
+
+CREATE TABLE `Monthly_Fix_Done` (
+  `yyyymm` varchar(6) NOT NULL,
+  `done` tinyint(1) NOT NULL,
+  `created_at` datetime default current_timestamp,
+  `updated_at` timestamp default current_timestamp,
+  PRIMARY KEY (`yyyymm`)
+);
+
+-- +migrate StatementBegin
+CREATE TRIGGER `trg_Monthly_Fix_Billing_updated_at` AFTER UPDATE ON `Monthly_Fix_Billing`
+FOR EACH ROW WHEN NEW.`updated_at` = OLD.`updated_at`
+BEGIN
+  UPDATE `Monthly_Fix_Billing` SET `updated_at` = CURRENT_TIMESTAMP WHERE `id` = NEW.`id`;
+END;
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE TRIGGER `trg_Monthly_Fix_Done_updated_at` AFTER UPDATE ON `Monthly_Fix_Done`
+FOR EACH ROW WHEN NEW.`updated_at` = OLD.`updated_at`
+BEGIN
+  UPDATE `Monthly_Fix_Done` SET `updated_at` = CURRENT_TIMESTAMP WHERE `yyyymm` = NEW.`yyyymm`;
+END;
+-- +migrate StatementEnd
+
+-- +migrate Down
+DROP TABLE `Monthly_Fix_Billing`;
+DROP TABLE `Monthly_Fix_Done`;
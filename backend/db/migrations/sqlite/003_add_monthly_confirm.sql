@@ -0,0 +1,20 @@
+-- +migrate Up
+CREATE TABLE `Monthly_Confirm` (
+  `yyyymm` varchar(6) NOT NULL,
+  `confirm` tinyint(1) NOT NULL,
+  `confirm_datetime` datetime,
+  `created_at` datetime default current_timestamp,
+  `updated_at` timestamp default current_timestamp,
+  PRIMARY KEY (`yyyymm`)
+);
+
+-- +migrate StatementBegin
+CREATE TRIGGER `trg_Monthly_Confirm_updated_at` AFTER UPDATE ON `Monthly_Confirm`
+FOR EACH ROW WHEN NEW.`updated_at` = OLD.`updated_at`
+BEGIN
+  UPDATE `Monthly_Confirm` SET `updated_at` = CURRENT_TIMESTAMP WHERE `yyyymm` = NEW.`yyyymm`;
+END;
+-- +migrate StatementEnd
+
+-- +migrate Down
+DROP TABLE `Monthly_Confirm`;
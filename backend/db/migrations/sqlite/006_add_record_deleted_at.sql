@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE `Record` ADD COLUMN `deleted_at` datetime DEFAULT NULL;
+CREATE INDEX `idx_deleted_at` ON `Record` (`deleted_at`);
+
+-- +migrate Down
+DROP INDEX `idx_deleted_at`;
+ALTER TABLE `Record` DROP COLUMN `deleted_at`;
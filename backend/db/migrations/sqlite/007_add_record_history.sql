@@ -0,0 +1,30 @@
+-- +migrate Up
+CREATE TABLE `Record_History` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `record_id` int NOT NULL,
+  `version` int NOT NULL,
+  `operation` varchar(16) NOT NULL, -- create / update / delete / restore
+  `category_id` int NOT NULL,
+  `datetime` datetime NOT NULL,
+  `from` varchar(64) NOT NULL,
+  `type` varchar(64) NOT NULL,
+  `price` int NOT NULL,
+  `memo` varchar(255) NOT NULL,
+  `recorded_at` datetime NOT NULL default current_timestamp,
+  CONSTRAINT `uq_record_version` UNIQUE (`record_id`, `version`)
+);
+CREATE INDEX `idx_recorded_at` ON `Record_History` (`recorded_at`);
+
+-- 既存のレコードを初版として登録する
+INSERT INTO `Record_History` (`record_id`, `version`, `operation`, `category_id`, `datetime`, `from`, `type`, `price`, `memo`, `recorded_at`)
+SELECT `id`, 1, 'create', `category_id`, `datetime`, `from`, `type`, `price`, `memo`, COALESCE(`created_at`, CURRENT_TIMESTAMP)
+FROM `Record`;
+
+-- ゴミ箱にあるレコードは削除された版も登録する
+INSERT INTO `Record_History` (`record_id`, `version`, `operation`, `category_id`, `datetime`, `from`, `type`, `price`, `memo`, `recorded_at`)
+SELECT `id`, 2, 'delete', `category_id`, `datetime`, `from`, `type`, `price`, `memo`, `deleted_at`
+FROM `Record`
+WHERE `deleted_at` IS NOT NULL;
+
+-- +migrate Down
+DROP TABLE `Record_History`;
@@ -0,0 +1,287 @@
+// Package backup はデータベースの内容をアーカイブファイルに書き出し、アーカイブから復元する
+//
+// アーカイブは1行1JSONの JSON Lines 形式で、次の順に並ぶ
+//   - header: 形式名・形式のバージョン・作成日時・作成元のアプリケーションのバージョンなど
+//   - 各テーブルの行: {"type": "<セクション名>", "data": {...}}
+//   - footer: セクションごとの行数と、footer より前の全ての行の SHA-256
+//
+// footer が無い、または行数・チェックサムが一致しないアーカイブは復元しない
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"time"
+)
+
+// FormatName はアーカイブの形式名
+const FormatName = "mawinter-backup"
+
+// FormatVersion はこのバージョンのアプリケーションが書き出すアーカイブの形式のバージョン
+// これより新しい形式のアーカイブは復元できない
+//   - 1: 初版
+//   - 2: Record・Record_History に tags を追加（1 の tags は空として復元する）
+const FormatVersion = 2
+
+// アーカイブのセクション（テーブル）名
+const (
+	SectionCategory          = "category"
+	SectionRecord            = "record"
+	SectionRecordHistory     = "record_history"
+	SectionFixedBilling      = "fixed_billing"
+	SectionMonthConfirmation = "month_confirmation"
+)
+
+// Sections はアーカイブに書き出すセクションの一覧（書き出す順）
+var Sections = []string{
+	SectionCategory,
+	SectionRecord,
+	SectionRecordHistory,
+	SectionFixedBilling,
+	SectionMonthConfirmation,
+}
+
+const (
+	lineTypeHeader = "header"
+	lineTypeFooter = "footer"
+)
+
+// ErrInvalidArchive はアーカイブの形式が不正、または破損している場合のエラー
+var ErrInvalidArchive = errors.New("invalid backup archive")
+
+// Header はアーカイブの先頭行
+type Header struct {
+	Format     string    `json:"format"`
+	Version    int       `json:"version"`
+	CreatedAt  time.Time `json:"created_at"`
+	AppVersion string    `json:"app_version"`
+	Driver     string    `json:"driver"`
+	Sections   []string  `json:"sections"`
+}
+
+// Footer はアーカイブの最終行
+type Footer struct {
+	Counts map[string]int `json:"counts"`
+	SHA256 string         `json:"sha256"`
+}
+
+// Manifest はアーカイブの概要
+type Manifest struct {
+	Header Header
+	Footer Footer
+}
+
+// line はアーカイブの1行
+type line struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// contents はアーカイブに含まれる全ての行
+type contents struct {
+	categories         []categoryRow
+	records            []recordRow
+	recordHistories    []recordHistoryRow
+	fixedBillings      []fixedBillingRow
+	monthConfirmations []monthConfirmationRow
+}
+
+// counts はセクションごとの行数を返す
+func (c *contents) counts() map[string]int {
+	return map[string]int{
+		SectionCategory:          len(c.categories),
+		SectionRecord:            len(c.records),
+		SectionRecordHistory:     len(c.recordHistories),
+		SectionFixedBilling:      len(c.fixedBillings),
+		SectionMonthConfirmation: len(c.monthConfirmations),
+	}
+}
+
+// archiveWriter はアーカイブを書き出す
+type archiveWriter struct {
+	w      *bufio.Writer
+	hash   hash.Hash
+	counts map[string]int
+}
+
+func newArchiveWriter(w io.Writer) *archiveWriter {
+	return &archiveWriter{
+		w:      bufio.NewWriter(w),
+		hash:   sha256.New(),
+		counts: make(map[string]int),
+	}
+}
+
+// writeLine は1行を書き出し、チェックサムに加える
+func (aw *archiveWriter) writeLine(lineType string, data any) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", lineType, err)
+	}
+	encoded, err := json.Marshal(line{Type: lineType, Data: raw})
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", lineType, err)
+	}
+	encoded = append(encoded, '\n')
+
+	if _, err := aw.w.Write(encoded); err != nil {
+		return err
+	}
+	aw.hash.Write(encoded)
+	return nil
+}
+
+// writeSection はセクションの全ての行を書き出す
+func writeSection[T any](aw *archiveWriter, section string, rows []T) error {
+	for _, row := range rows {
+		if err := aw.writeLine(section, row); err != nil {
+			return err
+		}
+	}
+	aw.counts[section] = len(rows)
+	return nil
+}
+
+// close は footer を書き出してバッファを出力する
+func (aw *archiveWriter) close() (Footer, error) {
+	footer := Footer{
+		Counts: aw.counts,
+		SHA256: hex.EncodeToString(aw.hash.Sum(nil)),
+	}
+
+	raw, err := json.Marshal(footer)
+	if err != nil {
+		return Footer{}, err
+	}
+	encoded, err := json.Marshal(line{Type: lineTypeFooter, Data: raw})
+	if err != nil {
+		return Footer{}, err
+	}
+	if _, err := aw.w.Write(append(encoded, '\n')); err != nil {
+		return Footer{}, err
+	}
+	return footer, aw.w.Flush()
+}
+
+// readArchive はアーカイブを全て読み込み、形式・行数・チェックサムを検証する
+func readArchive(r io.Reader) (*Manifest, *contents, error) {
+	br := bufio.NewReader(r)
+	h := sha256.New()
+	c := &contents{}
+
+	var (
+		manifest  Manifest
+		gotHeader bool
+		gotFooter bool
+		lineNo    int
+	)
+
+	for {
+		raw, err := br.ReadBytes('\n')
+		if len(bytes.TrimSpace(raw)) > 0 {
+			lineNo++
+
+			if gotFooter {
+				return nil, nil, fmt.Errorf("%w: unexpected data after footer at line %d", ErrInvalidArchive, lineNo)
+			}
+
+			var l line
+			if err := json.Unmarshal(raw, &l); err != nil {
+				return nil, nil, fmt.Errorf("%w: malformed line %d: %v", ErrInvalidArchive, lineNo, err)
+			}
+
+			switch {
+			case !gotHeader:
+				if l.Type != lineTypeHeader {
+					return nil, nil, fmt.Errorf("%w: missing header", ErrInvalidArchive)
+				}
+				if err := json.Unmarshal(l.Data, &manifest.Header); err != nil {
+					return nil, nil, fmt.Errorf("%w: malformed header: %v", ErrInvalidArchive, err)
+				}
+				if manifest.Header.Format != FormatName {
+					return nil, nil, fmt.Errorf("%w: unknown format %q", ErrInvalidArchive, manifest.Header.Format)
+				}
+				if manifest.Header.Version < 1 || manifest.Header.Version > FormatVersion {
+					return nil, nil, fmt.Errorf("%w: unsupported format version %d (supported up to %d)", ErrInvalidArchive, manifest.Header.Version, FormatVersion)
+				}
+				gotHeader = true
+			case l.Type == lineTypeFooter:
+				if err := json.Unmarshal(l.Data, &manifest.Footer); err != nil {
+					return nil, nil, fmt.Errorf("%w: malformed footer: %v", ErrInvalidArchive, err)
+				}
+				gotFooter = true
+			default:
+				if err := c.add(l); err != nil {
+					return nil, nil, fmt.Errorf("%w: line %d: %v", ErrInvalidArchive, lineNo, err)
+				}
+			}
+
+			// footer 自身はチェックサムの対象外
+			if !gotFooter {
+				h.Write(raw)
+			}
+		}
+
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read backup archive: %w", err)
+		}
+	}
+
+	if !gotHeader {
+		return nil, nil, fmt.Errorf("%w: empty archive", ErrInvalidArchive)
+	}
+	if !gotFooter {
+		return nil, nil, fmt.Errorf("%w: missing footer (the archive may be truncated)", ErrInvalidArchive)
+	}
+
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != manifest.Footer.SHA256 {
+		return nil, nil, fmt.Errorf("%w: checksum mismatch (expected %s, got %s)", ErrInvalidArchive, manifest.Footer.SHA256, sum)
+	}
+	for section, got := range c.counts() {
+		if want := manifest.Footer.Counts[section]; want != got {
+			return nil, nil, fmt.Errorf("%w: %s has %d rows, footer says %d", ErrInvalidArchive, section, got, want)
+		}
+	}
+
+	return &manifest, c, nil
+}
+
+// add はセクションの1行を読み込む
+func (c *contents) add(l line) error {
+	switch l.Type {
+	case SectionCategory:
+		return appendRow(&c.categories, l)
+	case SectionRecord:
+		return appendRow(&c.records, l)
+	case SectionRecordHistory:
+		return appendRow(&c.recordHistories, l)
+	case SectionFixedBilling:
+		return appendRow(&c.fixedBillings, l)
+	case SectionMonthConfirmation:
+		return appendRow(&c.monthConfirmations, l)
+	case lineTypeHeader:
+		return fmt.Errorf("duplicate header")
+	default:
+		return fmt.Errorf("unknown section %q", l.Type)
+	}
+}
+
+// appendRow は行をデコードしてスライスに追加する
+func appendRow[T any](rows *[]T, l line) error {
+	var row T
+	if err := json.Unmarshal(l.Data, &row); err != nil {
+		return fmt.Errorf("malformed %s: %v", l.Type, err)
+	}
+	*rows = append(*rows, row)
+	return nil
+}
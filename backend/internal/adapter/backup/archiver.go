@@ -0,0 +1,207 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrNotEmpty は復元先のデータベースに既にデータがある場合のエラー
+var ErrNotEmpty = errors.New("database is not empty")
+
+// insertBatchSize は復元時に1回の INSERT で登録する行数
+const insertBatchSize = 500
+
+// RestoreOptions は復元時のオプション
+type RestoreOptions struct {
+	// Force が true の場合、復元先の既存のデータを全て削除してから復元する
+	Force bool
+}
+
+// Archiver はデータベースのバックアップと復元を行う
+type Archiver struct {
+	db         *gorm.DB
+	appVersion string
+	now        func() time.Time
+}
+
+// NewArchiver はArchiverを生成する
+// appVersion はアーカイブの header に記録する
+func NewArchiver(db *gorm.DB, appVersion string) *Archiver {
+	return &Archiver{
+		db:         db,
+		appVersion: appVersion,
+		now:        time.Now,
+	}
+}
+
+// Backup はデータベースの内容をアーカイブとして w に書き出す
+// 全てのテーブルを同一トランザクション内で読み込む
+func (a *Archiver) Backup(ctx context.Context, w io.Writer) (*Manifest, error) {
+	c := &contents{}
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Order("id").Find(&c.categories).Error; err != nil {
+			return fmt.Errorf("failed to read categories: %w", err)
+		}
+		if err := tx.Order("id").Find(&c.records).Error; err != nil {
+			return fmt.Errorf("failed to read records: %w", err)
+		}
+		if err := tx.Order("id").Find(&c.recordHistories).Error; err != nil {
+			return fmt.Errorf("failed to read record history: %w", err)
+		}
+		if err := tx.Order("id").Find(&c.fixedBillings).Error; err != nil {
+			return fmt.Errorf("failed to read fixed billings: %w", err)
+		}
+		if err := tx.Order("yyyymm").Find(&c.monthConfirmations).Error; err != nil {
+			return fmt.Errorf("failed to read month confirmations: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	header := Header{
+		Format:     FormatName,
+		Version:    FormatVersion,
+		CreatedAt:  a.now(),
+		AppVersion: a.appVersion,
+		Driver:     a.db.Dialector.Name(),
+		Sections:   Sections,
+	}
+
+	aw := newArchiveWriter(w)
+	if err := aw.writeLine(lineTypeHeader, header); err != nil {
+		return nil, err
+	}
+	if err := writeSection(aw, SectionCategory, c.categories); err != nil {
+		return nil, err
+	}
+	if err := writeSection(aw, SectionRecord, c.records); err != nil {
+		return nil, err
+	}
+	if err := writeSection(aw, SectionRecordHistory, c.recordHistories); err != nil {
+		return nil, err
+	}
+	if err := writeSection(aw, SectionFixedBilling, c.fixedBillings); err != nil {
+		return nil, err
+	}
+	if err := writeSection(aw, SectionMonthConfirmation, c.monthConfirmations); err != nil {
+		return nil, err
+	}
+
+	footer, err := aw.close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to write backup archive: %w", err)
+	}
+
+	return &Manifest{Header: header, Footer: footer}, nil
+}
+
+// Restore はアーカイブの内容をデータベースに復元する
+// アーカイブ全体を検証してから、同一トランザクション内で書き込む
+// カテゴリはマイグレーションで初期データが登録されるため、既存のカテゴリは常にアーカイブの内容で置き換える
+// それ以外のテーブルにデータがある場合は、opts.Force が指定されない限り ErrNotEmpty を返す
+func (a *Archiver) Restore(ctx context.Context, r io.Reader, opts RestoreOptions) (*Manifest, error) {
+	manifest, c, err := readArchive(r)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if !opts.Force {
+			if err := ensureEmpty(tx); err != nil {
+				return err
+			}
+		}
+
+		// 既存のデータを全て削除する
+		all := tx.Session(&gorm.Session{AllowGlobalUpdate: true})
+		for _, model := range []any{&recordHistoryRow{}, &recordRow{}, &fixedBillingRow{}, &monthConfirmationRow{}, &categoryRow{}} {
+			if err := all.Delete(model).Error; err != nil {
+				return fmt.Errorf("failed to clear existing data: %w", err)
+			}
+		}
+
+		if err := insertRows(tx, c.categories); err != nil {
+			return fmt.Errorf("failed to restore categories: %w", err)
+		}
+		if err := insertRows(tx, c.records); err != nil {
+			return fmt.Errorf("failed to restore records: %w", err)
+		}
+		if err := insertRows(tx, c.recordHistories); err != nil {
+			return fmt.Errorf("failed to restore record history: %w", err)
+		}
+		if err := insertRows(tx, c.fixedBillings); err != nil {
+			return fmt.Errorf("failed to restore fixed billings: %w", err)
+		}
+		if err := insertRows(tx, c.monthConfirmations); err != nil {
+			return fmt.Errorf("failed to restore month confirmations: %w", err)
+		}
+
+		return resetSequences(tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// ensureEmpty はカテゴリ以外のテーブルにデータがないことを確認する
+func ensureEmpty(tx *gorm.DB) error {
+	tables := []struct {
+		name  string
+		model any
+	}{
+		{name: SectionRecord, model: &recordRow{}},
+		{name: SectionRecordHistory, model: &recordHistoryRow{}},
+		{name: SectionFixedBilling, model: &fixedBillingRow{}},
+		{name: SectionMonthConfirmation, model: &monthConfirmationRow{}},
+	}
+
+	var nonEmpty []string
+	for _, table := range tables {
+		var count int64
+		if err := tx.Model(table.model).Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to count %s: %w", table.name, err)
+		}
+		if count > 0 {
+			nonEmpty = append(nonEmpty, fmt.Sprintf("%s=%d", table.name, count))
+		}
+	}
+
+	if len(nonEmpty) > 0 {
+		return fmt.Errorf("%w: %s", ErrNotEmpty, strings.Join(nonEmpty, ", "))
+	}
+	return nil
+}
+
+// insertRows は行をまとめて登録する
+func insertRows[T any](tx *gorm.DB, rows []T) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	return tx.CreateInBatches(rows, insertBatchSize).Error
+}
+
+// resetSequences は ID を明示して登録したテーブルの自動採番の開始位置を合わせる
+// MySQL と SQLite は自動で調整されるため、PostgreSQL のみ実行する
+func resetSequences(tx *gorm.DB) error {
+	if tx.Dialector.Name() != "postgres" {
+		return nil
+	}
+
+	for _, table := range []string{"Category", "Record", "Record_History", "Monthly_Fix_Billing"} {
+		query := fmt.Sprintf(`SELECT setval(pg_get_serial_sequence('"%s"', 'id'), COALESCE(MAX("id"), 0) + 1, false) FROM "%s"`, table, table)
+		if err := tx.Exec(query).Error; err != nil {
+			return fmt.Errorf("failed to reset sequence of %s: %w", table, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/adapter/migration"
+	"github.com/azuki774/mawinter/internal/adapter/repository"
+	"github.com/azuki774/mawinter/internal/domain"
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupDB はマイグレーション適用済みの SQLite データベースを作成する
+func setupDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	gormDB, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "mawinter.db")), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+	sqlDB, err := gormDB.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrator, err := migration.NewMigrator(sqlDB, gormDB.Dialector.Name())
+	if err != nil {
+		t.Fatalf("failed to create migrator: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to apply migrations: %v", err)
+	}
+	return gormDB
+}
+
+// seed はバックアップ対象の全てのテーブルにデータを登録する
+func seed(t *testing.T, db *gorm.DB) {
+	t.Helper()
+	ctx := context.Background()
+
+	repo := repository.NewRecordRepository(db)
+	kept, err := repo.Create(ctx, &domain.Record{CategoryID: 210, Datetime: time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local), From: "web", Price: 1280, Memo: "コンビニ"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := repo.Update(ctx, &domain.Record{ID: kept.ID, CategoryID: 210, From: "web", Price: 1300, Memo: "コンビニ", Tags: []string{"旅行", "出張"}}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	trashed, err := repo.Create(ctx, &domain.Record{CategoryID: 220, Datetime: time.Date(2024, 5, 2, 0, 0, 0, 0, time.Local), From: "web", Price: 5000})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err := repo.Delete(ctx, trashed.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	confirmed := time.Date(2024, 6, 1, 9, 0, 0, 0, time.Local)
+	if err := db.Create(&fixedBillingRow{CategoryID: 200, Day: 27, Price: 80000, Type: "", Memo: "家賃"}).Error; err != nil {
+		t.Fatalf("failed to create fixed billing: %v", err)
+	}
+	if err := db.Create(&monthConfirmationRow{YYYYMM: "202405", Confirm: 1, ConfirmDatetime: &confirmed}).Error; err != nil {
+		t.Fatalf("failed to create month confirmation: %v", err)
+	}
+}
+
+func TestArchiver_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	src := setupDB(t)
+	seed(t, src)
+
+	var buf bytes.Buffer
+	manifest, err := NewArchiver(src, "v1.2.3").Backup(ctx, &buf)
+	if err != nil {
+		t.Fatalf("Backup() error = %v", err)
+	}
+
+	wantCounts := map[string]int{
+		SectionCategory:          23,
+		SectionRecord:            2,
+		SectionRecordHistory:     4,
+		SectionFixedBilling:      1,
+		SectionMonthConfirmation: 1,
+	}
+	for section, want := range wantCounts {
+		if got := manifest.Footer.Counts[section]; got != want {
+			t.Errorf("counts[%s] = %d, want %d", section, got, want)
+		}
+	}
+	if manifest.Header.AppVersion != "v1.2.3" || manifest.Header.Driver != "sqlite" || manifest.Header.Version != FormatVersion {
+		t.Errorf("unexpected header: %+v", manifest.Header)
+	}
+
+	dst := setupDB(t)
+	restored, err := NewArchiver(dst, "v1.2.3").Restore(ctx, bytes.NewReader(buf.Bytes()), RestoreOptions{})
+	if err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	if restored.Footer.SHA256 != manifest.Footer.SHA256 {
+		t.Errorf("checksum mismatch: %s != %s", restored.Footer.SHA256, manifest.Footer.SHA256)
+	}
+
+	// 復元先から取り直したバックアップが、復元元のものと同じ内容であること
+	var again bytes.Buffer
+	if _, err := NewArchiver(dst, "v1.2.3").Backup(ctx, &again); err != nil {
+		t.Fatalf("Backup() error = %v", err)
+	}
+	if body(t, buf.String()) != body(t, again.String()) {
+		t.Errorf("restored data differs from the source\nsource:\n%s\nrestored:\n%s", body(t, buf.String()), body(t, again.String()))
+	}
+
+	// ゴミ箱・履歴・採番が引き継がれていること
+	repo := repository.NewRecordRepository(dst)
+	trash, err := repo.FindDeleted(ctx, 10, 0)
+	if err != nil || len(trash) != 1 {
+		t.Fatalf("FindDeleted() = %v, %v", trash, err)
+	}
+	history, err := repo.FindHistory(ctx, 1)
+	if err != nil || len(history) != 2 {
+		t.Fatalf("FindHistory() = %v, %v", history, err)
+	}
+	if tags := strings.Join(history[1].Record.Tags, ","); tags != "旅行,出張" {
+		t.Errorf("expected tags to be restored, got %q", tags)
+	}
+	created, err := repo.Create(ctx, &domain.Record{CategoryID: 210, Datetime: time.Now(), Price: 1})
+	if err != nil {
+		t.Fatalf("Create() after restore error = %v", err)
+	}
+	if created.ID != 3 {
+		t.Errorf("expected next id 3, got %d", created.ID)
+	}
+}
+
+// body は header と footer を除いたアーカイブの行を返す
+func body(t *testing.T, archive string) string {
+	t.Helper()
+	lines := strings.Split(strings.TrimSpace(archive), "\n")
+	if len(lines) < 2 {
+		t.Fatalf("archive too short: %q", archive)
+	}
+	return strings.Join(lines[1:len(lines)-1], "\n")
+}
+
+func TestArchiver_RestoreIntoNonEmpty(t *testing.T) {
+	ctx := context.Background()
+	src := setupDB(t)
+	seed(t, src)
+
+	var buf bytes.Buffer
+	if _, err := NewArchiver(src, "dev").Backup(ctx, &buf); err != nil {
+		t.Fatalf("Backup() error = %v", err)
+	}
+
+	dst := setupDB(t)
+	if _, err := repository.NewRecordRepository(dst).Create(ctx, &domain.Record{CategoryID: 210, Datetime: time.Now(), Price: 1}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	_, err := NewArchiver(dst, "dev").Restore(ctx, bytes.NewReader(buf.Bytes()), RestoreOptions{})
+	if !errors.Is(err, ErrNotEmpty) {
+		t.Fatalf("expected ErrNotEmpty, got %v", err)
+	}
+
+	if _, err := NewArchiver(dst, "dev").Restore(ctx, bytes.NewReader(buf.Bytes()), RestoreOptions{Force: true}); err != nil {
+		t.Fatalf("Restore(force) error = %v", err)
+	}
+	count, err := repository.NewRecordRepository(dst).Count(ctx, "", 0)
+	if err != nil {
+		t.Fatalf("Count() error = %v", err)
+	}
+	if count != 1 {
+		t.Errorf("expected only the restored record to remain, got %d records", count)
+	}
+}
+
+func TestReadArchive_Invalid(t *testing.T) {
+	src := setupDB(t)
+	seed(t, src)
+
+	var buf bytes.Buffer
+	if _, err := NewArchiver(src, "dev").Backup(context.Background(), &buf); err != nil {
+		t.Fatalf("Backup() error = %v", err)
+	}
+	valid := buf.String()
+	lines := strings.SplitAfter(valid, "\n")
+
+	tests := []struct {
+		name    string
+		archive string
+	}{
+		{
+			name:    "異常系: 空",
+			archive: "",
+		},
+		{
+			name:    "異常系: footer がない",
+			archive: strings.Join(lines[:len(lines)-2], ""),
+		},
+		{
+			name:    "異常系: データの改ざん",
+			archive: strings.Replace(valid, "コンビニ", "スーパー", 1),
+		},
+		{
+			name:    "異常系: 行の欠落",
+			archive: lines[0] + strings.Join(lines[2:], ""),
+		},
+		{
+			name:    "異常系: 未対応の形式バージョン",
+			archive: strings.Replace(valid, fmt.Sprintf(`"version":%d`, FormatVersion), `"version":99`, 1),
+		},
+		{
+			name:    "異常系: 形式名が異なる",
+			archive: strings.Replace(valid, FormatName, "other", 1),
+		},
+		{
+			name:    "異常系: footer の後にデータがある",
+			archive: valid + lines[1],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := readArchive(strings.NewReader(tt.archive)); !errors.Is(err, ErrInvalidArchive) {
+				t.Errorf("expected ErrInvalidArchive, got %v", err)
+			}
+		})
+	}
+
+	if _, _, err := readArchive(strings.NewReader(valid)); err != nil {
+		t.Errorf("expected valid archive, got %v", err)
+	}
+}
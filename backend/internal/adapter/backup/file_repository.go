@@ -0,0 +1,139 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/domain"
+)
+
+// バックアップファイル名の形式（mawinter-YYYYMMDD-HHMMSS.jsonl）
+const (
+	fileNamePrefix = "mawinter-"
+	fileNameSuffix = ".jsonl"
+	fileNameLayout = "20060102-150405"
+)
+
+// FileName は指定された日時に作成するバックアップファイルの名前を返す
+func FileName(t time.Time) string {
+	return fileNamePrefix + t.Format(fileNameLayout) + fileNameSuffix
+}
+
+// parseFileName はバックアップファイルの名前から作成日時を取得する
+func parseFileName(name string) (time.Time, bool) {
+	if !strings.HasPrefix(name, fileNamePrefix) || !strings.HasSuffix(name, fileNameSuffix) {
+		return time.Time{}, false
+	}
+	stamp := strings.TrimSuffix(strings.TrimPrefix(name, fileNamePrefix), fileNameSuffix)
+	t, err := time.ParseInLocation(fileNameLayout, stamp, time.Local)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// FileRepository はローカルディレクトリにバックアップファイルを保存するリポジトリ
+// domain.BackupRepository を実装する
+type FileRepository struct {
+	archiver *Archiver
+	dir      string
+	now      func() time.Time
+}
+
+// NewFileRepository は新しい FileRepository を作成する
+func NewFileRepository(archiver *Archiver, dir string) *FileRepository {
+	return &FileRepository{
+		archiver: archiver,
+		dir:      dir,
+		now:      time.Now,
+	}
+}
+
+// Create は現在のデータのバックアップファイルを作成する
+func (r *FileRepository) Create(ctx context.Context) (*domain.BackupFile, error) {
+	if err := os.MkdirAll(r.dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	createdAt := r.now().Truncate(time.Second)
+	name := FileName(createdAt)
+	path := filepath.Join(r.dir, name)
+
+	// 書き出しに失敗した場合に不完全なファイルを残さないよう、一時ファイルに書き出してから置き換える
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create backup file: %w", err)
+	}
+	defer os.Remove(tmp)
+
+	if _, err := r.archiver.Backup(ctx, f); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write backup file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return nil, fmt.Errorf("failed to write backup file: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat backup file: %w", err)
+	}
+	return &domain.BackupFile{Name: name, CreatedAt: createdAt, Size: info.Size()}, nil
+}
+
+// List は保存済みのバックアップファイルを作成日時の新しい順に取得する
+// ファイル名の形式に一致しないファイルは無視する
+func (r *FileRepository) List(ctx context.Context) ([]*domain.BackupFile, error) {
+	entries, err := os.ReadDir(r.dir)
+	if os.IsNotExist(err) {
+		return []*domain.BackupFile{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup directory: %w", err)
+	}
+
+	files := make([]*domain.BackupFile, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		createdAt, ok := parseFileName(entry.Name())
+		if !ok {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat backup file: %w", err)
+		}
+		files = append(files, &domain.BackupFile{Name: entry.Name(), CreatedAt: createdAt, Size: info.Size()})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].CreatedAt.After(files[j].CreatedAt)
+	})
+	return files, nil
+}
+
+// Remove は指定された名前のバックアップファイルを削除する
+// バックアップディレクトリ外のファイルや形式に一致しないファイルは削除しない
+func (r *FileRepository) Remove(ctx context.Context, name string) error {
+	if filepath.Base(name) != name {
+		return fmt.Errorf("invalid backup file name: %s", name)
+	}
+	if _, ok := parseFileName(name); !ok {
+		return fmt.Errorf("invalid backup file name: %s", name)
+	}
+	if err := os.Remove(filepath.Join(r.dir, name)); err != nil {
+		return fmt.Errorf("failed to remove backup file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileRepository(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	seed(t, db)
+
+	dir := filepath.Join(t.TempDir(), "backups")
+	repo := NewFileRepository(NewArchiver(db, "dev"), dir)
+
+	// ディレクトリが存在しない場合は空
+	files, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(files) != 0 {
+		t.Fatalf("expected no files, got %d", len(files))
+	}
+
+	times := []time.Time{
+		time.Date(2025, 1, 1, 3, 0, 0, 0, time.Local),
+		time.Date(2025, 1, 2, 3, 0, 0, 0, time.Local),
+	}
+	for _, now := range times {
+		repo.now = func() time.Time { return now }
+		created, err := repo.Create(ctx)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		if created.Name != FileName(now) || !created.CreatedAt.Equal(now) || created.Size == 0 {
+			t.Errorf("unexpected backup file: %+v", created)
+		}
+	}
+
+	// 形式に一致しないファイル・書き出し途中の一時ファイルは無視する
+	for _, name := range []string{"notes.txt", FileName(time.Now()) + ".tmp", "mawinter-invalid.jsonl"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("x"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err = repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(files) != 2 || files[0].Name != FileName(times[1]) || files[1].Name != FileName(times[0]) {
+		t.Fatalf("List() = %+v, want newest first", files)
+	}
+
+	// 作成したファイルは復元可能なアーカイブであること
+	f, err := os.Open(filepath.Join(dir, files[0].Name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, _, err := readArchive(f); err != nil {
+		t.Errorf("readArchive() error = %v", err)
+	}
+
+	if err := repo.Remove(ctx, files[1].Name); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	for _, name := range []string{"notes.txt", "../" + files[0].Name} {
+		if err := repo.Remove(ctx, name); err == nil {
+			t.Errorf("Remove(%q) expected error", name)
+		}
+	}
+
+	files, err = repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(files) != 1 || files[0].Name != FileName(times[1]) {
+		t.Errorf("List() after Remove() = %+v", files)
+	}
+}
@@ -0,0 +1,94 @@
+package backup
+
+import "time"
+
+// バックアップ対象のテーブルの行
+// アーカイブの形式をアプリケーションの GORM モデルから独立させるため、バックアップ専用に定義する
+// created_at / updated_at は元の値をそのまま復元するため、GORM による自動設定を無効にしている
+
+// categoryRow はCategoryテーブルの行
+type categoryRow struct {
+	ID           int        `gorm:"column:id;primaryKey" json:"id"`
+	CategoryID   int        `gorm:"column:category_id" json:"category_id"`
+	Name         *string    `gorm:"column:name" json:"name"`
+	CategoryType int        `gorm:"column:category_type" json:"category_type"`
+	CreatedAt    *time.Time `gorm:"column:created_at;autoCreateTime:false" json:"created_at"`
+	UpdatedAt    *time.Time `gorm:"column:updated_at;autoUpdateTime:false" json:"updated_at"`
+}
+
+// TableName はテーブル名を指定する
+func (categoryRow) TableName() string {
+	return "Category"
+}
+
+// recordRow はRecordテーブルの行（ゴミ箱内のレコードを含む）
+type recordRow struct {
+	ID         int        `gorm:"column:id;primaryKey" json:"id"`
+	CategoryID int        `gorm:"column:category_id" json:"category_id"`
+	Datetime   time.Time  `gorm:"column:datetime" json:"datetime"`
+	From       string     `gorm:"column:from" json:"from"`
+	Type       string     `gorm:"column:type" json:"type"`
+	Price      int        `gorm:"column:price" json:"price"`
+	Memo       string     `gorm:"column:memo" json:"memo"`
+	Tags       string     `gorm:"column:tags" json:"tags"`
+	CreatedAt  *time.Time `gorm:"column:created_at;autoCreateTime:false" json:"created_at"`
+	UpdatedAt  *time.Time `gorm:"column:updated_at;autoUpdateTime:false" json:"updated_at"`
+	DeletedAt  *time.Time `gorm:"column:deleted_at" json:"deleted_at"`
+}
+
+// TableName はテーブル名を指定する
+func (recordRow) TableName() string {
+	return "Record"
+}
+
+// recordHistoryRow はRecord_Historyテーブルの行
+type recordHistoryRow struct {
+	ID         int       `gorm:"column:id;primaryKey" json:"id"`
+	RecordID   int       `gorm:"column:record_id" json:"record_id"`
+	Version    int       `gorm:"column:version" json:"version"`
+	Operation  string    `gorm:"column:operation" json:"operation"`
+	CategoryID int       `gorm:"column:category_id" json:"category_id"`
+	Datetime   time.Time `gorm:"column:datetime" json:"datetime"`
+	From       string    `gorm:"column:from" json:"from"`
+	Type       string    `gorm:"column:type" json:"type"`
+	Price      int       `gorm:"column:price" json:"price"`
+	Memo       string    `gorm:"column:memo" json:"memo"`
+	Tags       string    `gorm:"column:tags" json:"tags"`
+	RecordedAt time.Time `gorm:"column:recorded_at" json:"recorded_at"`
+}
+
+// TableName はテーブル名を指定する
+func (recordHistoryRow) TableName() string {
+	return "Record_History"
+}
+
+// fixedBillingRow はMonthly_Fix_Billingテーブル（毎月の固定費）の行
+type fixedBillingRow struct {
+	ID         int        `gorm:"column:id;primaryKey" json:"id"`
+	CategoryID int        `gorm:"column:category_id" json:"category_id"`
+	Day        int        `gorm:"column:day" json:"day"`
+	Price      int        `gorm:"column:price" json:"price"`
+	Type       string     `gorm:"column:type" json:"type"`
+	Memo       string     `gorm:"column:memo" json:"memo"`
+	CreatedAt  *time.Time `gorm:"column:created_at;autoCreateTime:false" json:"created_at"`
+	UpdatedAt  *time.Time `gorm:"column:updated_at;autoUpdateTime:false" json:"updated_at"`
+}
+
+// TableName はテーブル名を指定する
+func (fixedBillingRow) TableName() string {
+	return "Monthly_Fix_Billing"
+}
+
+// monthConfirmationRow はMonthly_Confirmテーブル（月次の確定状態）の行
+type monthConfirmationRow struct {
+	YYYYMM          string     `gorm:"column:yyyymm;primaryKey" json:"yyyymm"`
+	Confirm         int        `gorm:"column:confirm" json:"confirm"`
+	ConfirmDatetime *time.Time `gorm:"column:confirm_datetime" json:"confirm_datetime"`
+	CreatedAt       *time.Time `gorm:"column:created_at;autoCreateTime:false" json:"created_at"`
+	UpdatedAt       *time.Time `gorm:"column:updated_at;autoUpdateTime:false" json:"updated_at"`
+}
+
+// TableName はテーブル名を指定する
+func (monthConfirmationRow) TableName() string {
+	return "Monthly_Confirm"
+}
@@ -0,0 +1,77 @@
+package http
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"net/http"
+
+	"github.com/azuki774/mawinter/api"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"gopkg.in/yaml.v3"
+)
+
+// API の仕様とドキュメントを公開するパス
+const (
+	SpecJSONPath = "/api/openapi.json"
+	SpecYAMLPath = "/api/openapi.yaml"
+	DocsPath     = "/api/docs/"
+)
+
+// docsHTML は仕様を読み込んで表示・リクエストの送信を行うページ
+// 外部のライブラリを使わないため、オフラインの環境でも動作する
+//
+//go:embed docs/index.html
+var docsHTML []byte
+
+// specDocument は公開用に変換した仕様
+type specDocument struct {
+	json []byte
+	yaml []byte
+}
+
+// newSpecDocument は埋め込みの仕様を公開用に変換する
+// リクエスト先は開発用のサーバ（http://localhost:8080）ではなく、仕様を公開しているサーバの /api とする
+func newSpecDocument() (*specDocument, error) {
+	spec, err := api.GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	spec.Servers = openapi3.Servers{{URL: "/api", Description: "このサーバ"}}
+	for _, pathItem := range spec.Paths.Map() {
+		pathItem.Servers = nil
+		for _, operation := range pathItem.Operations() {
+			operation.Servers = nil
+		}
+	}
+
+	jsonData, err := spec.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal spec to JSON: %w", err)
+	}
+	var yamlData bytes.Buffer
+	enc := yaml.NewEncoder(&yamlData)
+	enc.SetIndent(2)
+	if err := enc.Encode(spec); err != nil {
+		return nil, fmt.Errorf("failed to marshal spec to YAML: %w", err)
+	}
+	return &specDocument{json: jsonData, yaml: yamlData.Bytes()}, nil
+}
+
+// registerDocs は仕様（JSON / YAML）とドキュメントのページのルーティングを設定する
+// DocsPath の末尾のスラッシュを省略した場合は Gin がリダイレクトする
+func (s *Server) registerDocs(doc *specDocument) {
+	s.router.GET(SpecJSONPath, func(c *gin.Context) {
+		c.Header("Cache-Control", "no-cache")
+		c.Data(http.StatusOK, "application/json", doc.json)
+	})
+	s.router.GET(SpecYAMLPath, func(c *gin.Context) {
+		c.Header("Cache-Control", "no-cache")
+		c.Data(http.StatusOK, "application/yaml", doc.yaml)
+	})
+	s.router.GET(DocsPath, func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", docsHTML)
+	})
+}
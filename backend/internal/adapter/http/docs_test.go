@@ -0,0 +1,113 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/azuki774/mawinter/internal/application"
+	"github.com/azuki774/mawinter/pkg/config"
+	"github.com/gin-gonic/gin"
+	"gopkg.in/yaml.v3"
+)
+
+func TestDocs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// spec は仕様のうち検証に使う項目
+	type spec struct {
+		OpenAPI string `json:"openapi" yaml:"openapi"`
+		Servers []struct {
+			URL string `json:"url" yaml:"url"`
+		} `json:"servers" yaml:"servers"`
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId" yaml:"operationId"`
+			Servers     []any  `json:"servers" yaml:"servers"`
+		} `json:"paths" yaml:"paths"`
+	}
+
+	tests := []struct {
+		name            string
+		path            string
+		wantStatus      int
+		wantContentType string
+		unmarshal       func(data []byte, v any) error // 仕様の場合のみ指定
+		wantBody        string
+	}{
+		{
+			name:            "正常系: JSON 形式の仕様",
+			path:            "/api/openapi.json",
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/json",
+			unmarshal:       json.Unmarshal,
+		},
+		{
+			name:            "正常系: YAML 形式の仕様",
+			path:            "/api/openapi.yaml",
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/yaml",
+			unmarshal:       yaml.Unmarshal,
+		},
+		{
+			name:            "正常系: ドキュメントのページ",
+			path:            "/api/docs/",
+			wantStatus:      http.StatusOK,
+			wantContentType: "text/html",
+			wantBody:        "../openapi.json",
+		},
+		{
+			name:       "正常系: 末尾のスラッシュがない場合はリダイレクトする",
+			path:       "/api/docs",
+			wantStatus: http.StatusMovedPermanently,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categoryService := application.NewCategoryService(&mockCategoryRepository{})
+			recordService := application.NewRecordService(&mockRecordRepository{}, &mockCategoryRepository{categories: testCategories})
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", &config.DBInfo{}, categoryService, recordService, nil, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			server.router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status code %d, got %d", tt.wantStatus, w.Code)
+			}
+			if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, tt.wantContentType) {
+				t.Errorf("expected Content-Type %s, got %s", tt.wantContentType, ct)
+			}
+			if !strings.Contains(w.Body.String(), tt.wantBody) {
+				t.Errorf("expected body to contain %q", tt.wantBody)
+			}
+			if tt.unmarshal == nil {
+				return
+			}
+
+			var got spec
+			if err := tt.unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatalf("failed to unmarshal spec: %v", err)
+			}
+			if !strings.HasPrefix(got.OpenAPI, "3.") {
+				t.Errorf("unexpected openapi version %q", got.OpenAPI)
+			}
+			// リクエスト先は公開しているサーバの /api のみとする
+			if len(got.Servers) != 1 || got.Servers[0].URL != "/api" {
+				t.Errorf("expected servers [/api], got %+v", got.Servers)
+			}
+			if op, ok := got.Paths["/v3/record/{id}"]["get"]; !ok || op.OperationID != "GetV3RecordId" {
+				t.Errorf("expected operation GetV3RecordId, got %+v", got.Paths["/v3/record/{id}"])
+			}
+			for path, ops := range got.Paths {
+				for method, op := range ops {
+					if len(op.Servers) > 0 {
+						t.Errorf("%s %s must not override servers: %v", method, path, op.Servers)
+					}
+				}
+			}
+		})
+	}
+}
@@ -1,13 +1,17 @@
 package http
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"time"
 
 	"github.com/azuki774/mawinter/api"
+	"github.com/azuki774/mawinter/internal/application"
 	"github.com/azuki774/mawinter/internal/domain"
+	"github.com/azuki774/mawinter/pkg/logger"
 	"github.com/gin-gonic/gin"
 )
 
@@ -18,12 +22,72 @@ func (s *Server) Get(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"status": "ok"})
 }
 
+// GetV3AdminLogLevel - get log level (GET /v3/admin/log-level)
+func (s *Server) GetV3AdminLogLevel(c *gin.Context) {
+	if s.logLevel == nil {
+		writeStatusProblem(c, http.StatusNotFound, "log level is not configurable")
+		return
+	}
+
+	c.JSON(http.StatusOK, api.LogSetting{Level: api.LogLevel(logger.LevelName(s.logLevel.Level()))})
+}
+
+// PutV3AdminLogLevel - update log level (PUT /v3/admin/log-level)
+func (s *Server) PutV3AdminLogLevel(c *gin.Context) {
+	if s.logLevel == nil {
+		writeStatusProblem(c, http.StatusNotFound, "log level is not configurable")
+		return
+	}
+
+	var req api.PutV3AdminLogLevelJSONRequestBody
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	switch req.Level {
+	case api.Debug, api.Info, api.Warn, api.Error:
+	default:
+		writeError(c, "Invalid log level", domain.NewValidationError("level", "must be one of debug, info, warn, error"), "")
+		return
+	}
+	level, err := logger.ParseLevel(string(req.Level))
+	if err != nil {
+		writeError(c, "Invalid log level", domain.NewValidationError("level", err.Error()), "")
+		return
+	}
+
+	previous := s.logLevel.Level()
+	s.logLevel.Set(level)
+	// 変更後のレベルに関わらず記録されるよう Warn で出力する
+	slog.WarnContext(c.Request.Context(), "Log level changed",
+		slog.String("from", logger.LevelName(previous)),
+		slog.String("to", logger.LevelName(level)),
+	)
+
+	c.JSON(http.StatusOK, api.LogSetting{Level: req.Level})
+}
+
+// GetV3BackupStatus - get backup status (GET /v3/backup/status)
+func (s *Server) GetV3BackupStatus(c *gin.Context) {
+	if s.backupService == nil {
+		c.JSON(http.StatusOK, api.BackupStatus{Enabled: false})
+		return
+	}
+
+	status, err := s.backupService.Status(c.Request.Context())
+	if err != nil {
+		writeError(c, "Failed to get backup status", err, "failed to get backup status")
+		return
+	}
+
+	c.JSON(http.StatusOK, toAPIBackupStatus(status))
+}
+
 // GetV3Categories - get categories (GET /v3/categories)
 func (s *Server) GetV3Categories(c *gin.Context) {
 	categories, err := s.categoryService.GetAllCategories(c.Request.Context())
 	if err != nil {
-		slog.Error("Failed to get categories", slog.String("error", err.Error()))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get categories"})
+		writeError(c, "Failed to get categories", err, "failed to get categories")
 		return
 	}
 
@@ -40,6 +104,89 @@ func (s *Server) GetV3Categories(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// GetV3CategoriesSuggest - 過去のレコードからカテゴリの候補を返す (GET /v3/categories/suggest)
+func (s *Server) GetV3CategoriesSuggest(c *gin.Context, params api.GetV3CategoriesSuggestParams) {
+	response := api.CategorySuggestions{Suggestions: []api.CategorySuggestion{}}
+	if s.suggestionService == nil {
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	record := &domain.Record{Memo: params.Memo}
+	if params.From != nil {
+		record.From = *params.From
+	}
+	if params.Price != nil {
+		record.Price = *params.Price
+	}
+	limit := 3
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	suggestions, trained, err := s.suggestionService.SuggestCategories(c.Request.Context(), record, limit)
+	if err != nil {
+		writeError(c, "Failed to suggest categories", err, "failed to suggest categories")
+		return
+	}
+	response.Records = trained
+	for _, sg := range suggestions {
+		response.Suggestions = append(response.Suggestions, api.CategorySuggestion{
+			CategoryId:   sg.CategoryID,
+			CategoryName: sg.CategoryName,
+			CategoryType: api.CategoryType(sg.CategoryType.String()),
+			Confidence:   float32(sg.Confidence),
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// GetV3HealthLive - liveness probe (GET /v3/health/live)
+func (s *Server) GetV3HealthLive(c *gin.Context) {
+	c.JSON(http.StatusOK, api.Health{Status: api.Ok})
+}
+
+// GetV3HealthReady - readiness probe (GET /v3/health/ready)
+func (s *Server) GetV3HealthReady(c *gin.Context) {
+	// シャットダウン中は新しいリクエストを振り分けられないようにする
+	server := api.ComponentHealth{Name: "server", Status: api.Ok, LatencyMs: new(float64)}
+	if s.shuttingDown.Load() {
+		server.Status = api.Unavailable
+		server.Error = stringPtr("shutting down")
+	}
+	components := []api.ComponentHealth{server}
+
+	if s.healthService != nil {
+		readiness := s.healthService.CheckReadiness(c.Request.Context())
+		for _, comp := range readiness.Components {
+			latency := float64(comp.Latency.Microseconds()) / 1000
+			component := api.ComponentHealth{Name: comp.Name, Status: api.Ok, LatencyMs: &latency}
+			if comp.Detail != "" {
+				component.Detail = stringPtr(comp.Detail)
+			}
+			if !comp.Healthy() {
+				slog.WarnContext(c.Request.Context(), "Readiness check failed", slog.String("component", comp.Name), slog.String("error", comp.Err.Error()))
+				component.Status = api.Unavailable
+				component.Error = stringPtr(comp.Err.Error())
+			}
+			components = append(components, component)
+		}
+	}
+
+	response := api.Health{Status: api.Ok, Components: &components}
+	for _, comp := range components {
+		if comp.Status != api.Ok {
+			response.Status = api.Unavailable
+		}
+	}
+
+	if response.Status != api.Ok {
+		c.JSON(http.StatusServiceUnavailable, response)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
 // GetV3Record - get records (GET /v3/record)
 func (s *Server) GetV3Record(c *gin.Context, params api.GetV3RecordParams) {
 	// デフォルト値の設定
@@ -66,24 +213,14 @@ func (s *Server) GetV3Record(c *gin.Context, params api.GetV3RecordParams) {
 	// レコードを取得
 	records, err := s.recordService.GetRecords(c.Request.Context(), num, offset, yyyymm, categoryID)
 	if err != nil {
-		slog.Error("Failed to get records", slog.String("error", err.Error()))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get records"})
+		writeError(c, "Failed to get records", err, "failed to get records")
 		return
 	}
 
 	// APIレスポンス型に変換
 	response := make([]api.Record, len(records))
 	for i, rec := range records {
-		response[i] = api.Record{
-			Id:           rec.ID,
-			CategoryId:   rec.CategoryID,
-			CategoryName: rec.CategoryName,
-			Datetime:     rec.Datetime,
-			From:         rec.From,
-			Type:         rec.Type,
-			Price:        rec.Price,
-			Memo:         rec.Memo,
-		}
+		response[i] = toAPIRecord(rec)
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -92,18 +229,11 @@ func (s *Server) GetV3Record(c *gin.Context, params api.GetV3RecordParams) {
 // PostV3Record - create record (POST /v3/record)
 func (s *Server) PostV3Record(c *gin.Context) {
 	var req api.ReqRecord
-	if err := c.ShouldBindJSON(&req); err != nil {
-		slog.Error("Failed to bind request body", slog.String("error", err.Error()))
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+	if !bindJSON(c, &req) {
 		return
 	}
 
 	// デフォルト値の設定
-	datetime := "20060102" // デフォルトの日付フォーマット
-	if req.Datetime != nil {
-		datetime = *req.Datetime
-	}
-
 	from := ""
 	if req.From != nil {
 		from = *req.From
@@ -119,45 +249,38 @@ func (s *Server) PostV3Record(c *gin.Context) {
 		memo = *req.Memo
 	}
 
-	// datetimeをtime.Timeに変換（YYYYMMDD形式）
-	parsedTime, err := parseDateTime(datetime)
-	if err != nil {
-		slog.Error("Failed to parse datetime", slog.String("datetime", datetime), slog.String("error", err.Error()))
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid datetime format"})
-		return
-	}
-
 	// ドメインエンティティを作成
+	// datetime が省略された場合はゼロ値のままとし、作成時の日時とする
+	// category_id が省略された場合は 0 とし、自動分類の規則で設定する
 	record := &domain.Record{
-		CategoryID: req.CategoryId,
-		Datetime:   parsedTime,
-		From:       from,
-		Type:       recordType,
-		Price:      req.Price,
-		Memo:       memo,
+		From:  from,
+		Type:  recordType,
+		Price: req.Price,
+		Memo:  memo,
+	}
+	if req.CategoryId != nil {
+		record.CategoryID = *req.CategoryId
+	}
+	if req.Tags != nil {
+		record.Tags = *req.Tags
+	}
+	if req.Datetime != nil {
+		parsedTime, err := parseDateTime(*req.Datetime)
+		if err != nil {
+			writeError(c, "Failed to parse datetime", invalidDatetime(), "", slog.String("datetime", *req.Datetime))
+			return
+		}
+		record.Datetime = parsedTime
 	}
 
 	// レコードを作成
 	createdRecord, err := s.recordService.CreateRecord(c.Request.Context(), record)
 	if err != nil {
-		slog.Error("Failed to create record", slog.String("error", err.Error()))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create record"})
+		writeError(c, "Failed to create record", err, "failed to create record")
 		return
 	}
 
-	// APIレスポンス型に変換
-	response := api.Record{
-		Id:           createdRecord.ID,
-		CategoryId:   createdRecord.CategoryID,
-		CategoryName: createdRecord.CategoryName,
-		Datetime:     createdRecord.Datetime,
-		From:         createdRecord.From,
-		Type:         createdRecord.Type,
-		Price:        createdRecord.Price,
-		Memo:         createdRecord.Memo,
-	}
-
-	c.JSON(http.StatusCreated, response)
+	c.JSON(http.StatusCreated, toAPIRecord(createdRecord))
 }
 
 // GetV3RecordAvailable - record available (GET /v3/record/available)
@@ -165,8 +288,7 @@ func (s *Server) GetV3RecordAvailable(c *gin.Context) {
 	// レコードの利用可能期間を取得
 	yyyymm, fy, err := s.recordService.GetAvailablePeriods(c.Request.Context())
 	if err != nil {
-		slog.Error("Failed to get available periods", slog.String("error", err.Error()))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get available periods"})
+		writeError(c, "Failed to get available periods", err, "failed to get available periods")
 		return
 	}
 
@@ -180,23 +302,21 @@ func (s *Server) GetV3RecordAvailable(c *gin.Context) {
 }
 
 // GetV3RecordCount - record count (GET /v3/record/count)
-func (s *Server) GetV3RecordCount(c *gin.Context) {
+func (s *Server) GetV3RecordCount(c *gin.Context, params api.GetV3RecordCountParams) {
 	// クエリパラメータから条件を取得（オプション）
-	yyyymm := c.Query("yyyymm")
+	yyyymm := ""
+	if params.Yyyymm != nil {
+		yyyymm = *params.Yyyymm
+	}
 	categoryID := 0
-	if catIDStr := c.Query("category_id"); catIDStr != "" {
-		// category_idが指定されている場合は変換
-		var catID int
-		if _, err := fmt.Sscanf(catIDStr, "%d", &catID); err == nil {
-			categoryID = catID
-		}
+	if params.CategoryId != nil {
+		categoryID = *params.CategoryId
 	}
 
 	// レコード数を取得
 	count, err := s.recordService.CountRecords(c.Request.Context(), yyyymm, categoryID)
 	if err != nil {
-		slog.Error("Failed to count records", slog.String("error", err.Error()))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count records"})
+		writeError(c, "Failed to count records", err, "failed to count records")
 		return
 	}
 
@@ -208,13 +328,68 @@ func (s *Server) GetV3RecordCount(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// PostV3RecordParse - parse quick entry (POST /v3/record/parse)
+func (s *Server) PostV3RecordParse(c *gin.Context) {
+	var req api.QuickEntry
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	// 解釈のみ行い、レコードは作成しない
+	entry, err := s.recordService.ParseQuickEntry(c.Request.Context(), req.Text)
+	if err != nil {
+		writeError(c, "Failed to parse quick entry", err, "failed to parse quick entry")
+		return
+	}
+
+	// 返した record をそのまま POST /v3/record に送信できる形式にする
+	record := api.ReqRecord{
+		CategoryId: &entry.Record.CategoryID,
+		Price:      entry.Record.Price,
+	}
+	if !entry.Record.Datetime.IsZero() {
+		record.Datetime = stringPtr(entry.Record.Datetime.Format("20060102"))
+	}
+	if entry.Record.Type != "" {
+		record.Type = stringPtr(entry.Record.Type)
+	}
+	if entry.Record.Memo != "" {
+		record.Memo = stringPtr(entry.Record.Memo)
+	}
+	if len(entry.Record.Tags) > 0 {
+		record.Tags = &entry.Record.Tags
+	}
+	rules := entry.Rules
+	if rules == nil {
+		rules = []string{}
+	}
+	tokens := make([]api.ParsedToken, len(entry.Tokens))
+	for i, t := range entry.Tokens {
+		tokens[i] = api.ParsedToken{Text: t.Text, Kind: api.ParsedTokenKind(t.Kind)}
+	}
+
+	c.JSON(http.StatusOK, api.ParsedEntry{
+		Record:       record,
+		CategoryName: entry.Category.Name,
+		CategoryType: api.CategoryType(entry.Category.CategoryType.String()),
+		Tokens:       tokens,
+		Rules:        rules,
+	})
+}
+
 // GetV3RecordYear - get year summary (GET /v3/record/summary/{year})
-func (s *Server) GetV3RecordYear(c *gin.Context, year int) {
+func (s *Server) GetV3RecordYear(c *gin.Context, year int, params api.GetV3RecordYearParams) {
 	// year パラメータは自動的にパースされて渡される
-	summaries, err := s.recordService.GetYearSummary(c.Request.Context(), year)
+	// as_of が指定された場合はその時点の履歴から計算する
+	var summaries []*domain.CategoryYearSummary
+	var err error
+	if params.AsOf != nil {
+		summaries, err = s.recordService.GetYearSummaryAsOf(c.Request.Context(), year, *params.AsOf)
+	} else {
+		summaries, err = s.recordService.GetYearSummary(c.Request.Context(), year)
+	}
 	if err != nil {
-		slog.Error("Failed to get year summary", slog.Int("year", year), slog.String("error", err.Error()))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get year summary"})
+		writeError(c, "Failed to get year summary", err, "failed to get year summary", slog.Int("year", year))
 		return
 	}
 
@@ -240,13 +415,225 @@ func (s *Server) GetV3RecordYear(c *gin.Context, year int) {
 	c.JSON(http.StatusOK, response)
 }
 
+// GetV3RecordCompareMonth - 月のサマリーを比較 (GET /v3/record/compare/month/{yyyymm})
+func (s *Server) GetV3RecordCompareMonth(c *gin.Context, yyyymm string, params api.GetV3RecordCompareMonthParams) {
+	with := ""
+	if params.With != nil {
+		with = *params.With
+	}
+	comparison, err := s.recordService.CompareMonths(c.Request.Context(), yyyymm, with)
+	if err != nil {
+		writeError(c, "Failed to compare months", err, "failed to compare months", slog.String("yyyymm", yyyymm), slog.String("with", with))
+		return
+	}
+	c.JSON(http.StatusOK, toAPIComparison(comparison))
+}
+
+// GetV3RecordCompareYear - 会計年度のサマリーを比較 (GET /v3/record/compare/year/{year})
+func (s *Server) GetV3RecordCompareYear(c *gin.Context, year int, params api.GetV3RecordCompareYearParams) {
+	with := 0
+	if params.With != nil {
+		with = *params.With
+	}
+	comparison, err := s.recordService.CompareYears(c.Request.Context(), year, with)
+	if err != nil {
+		writeError(c, "Failed to compare years", err, "failed to compare years", slog.Int("year", year), slog.Int("with", with))
+		return
+	}
+	c.JSON(http.StatusOK, toAPIComparison(comparison))
+}
+
+// toAPIComparison はサマリーの比較をAPIレスポンス型に変換する
+func toAPIComparison(comparison *domain.SummaryComparison) api.SummaryComparison {
+	percentage := func(p *float64) *float32 {
+		if p == nil {
+			return nil
+		}
+		v := float32(*p)
+		return &v
+	}
+
+	response := api.SummaryComparison{
+		Current:       comparison.Current,
+		Comparison:    comparison.Comparison,
+		Categories:    make([]api.CategoryComparison, len(comparison.Categories)),
+		CategoryTypes: make([]api.CategoryTypeComparison, len(comparison.CategoryTypes)),
+	}
+	for i, c := range comparison.Categories {
+		response.Categories[i] = api.CategoryComparison{
+			CategoryId:   c.CategoryID,
+			CategoryName: c.CategoryName,
+			CategoryType: api.CategoryType(c.CategoryType.String()),
+			Current:      c.Current,
+			Comparison:   c.Comparison,
+			Difference:   c.Difference,
+			Percentage:   percentage(c.Percentage),
+		}
+	}
+	for i, t := range comparison.CategoryTypes {
+		response.CategoryTypes[i] = api.CategoryTypeComparison{
+			CategoryType: api.CategoryType(t.CategoryType.String()),
+			Current:      t.Current,
+			Comparison:   t.Comparison,
+			Difference:   t.Difference,
+			Percentage:   percentage(t.Percentage),
+		}
+	}
+	return response
+}
+
+// GetV3RecordSummaryCalendar - 暦年のサマリーを取得 (GET /v3/record/summary/calendar/{year})
+func (s *Server) GetV3RecordSummaryCalendar(c *gin.Context, year int, params api.GetV3RecordSummaryCalendarParams) {
+	period, err := domain.CalendarYearPeriod(year, summaryBucket(params.Bucket))
+	if err != nil {
+		writeError(c, "Invalid summary period", err, "invalid summary period", slog.Int("year", year))
+		return
+	}
+	s.writePeriodSummary(c, period)
+}
+
+// GetV3RecordSummaryRange - 任意の期間のサマリーを取得 (GET /v3/record/summary/range)
+func (s *Server) GetV3RecordSummaryRange(c *gin.Context, params api.GetV3RecordSummaryRangeParams) {
+	period, err := summaryRange(params)
+	if err != nil {
+		writeError(c, "Invalid summary period", err, "invalid summary period", slog.String("from", params.From), slog.String("to", params.To))
+		return
+	}
+	s.writePeriodSummary(c, period)
+}
+
+// summaryRange は期間のクエリパラメータ（YYYYMMDD）から SummaryPeriod を作成する
+// to はその日を含むため、翌日の 0 時までを期間とする
+func summaryRange(params api.GetV3RecordSummaryRangeParams) (domain.SummaryPeriod, error) {
+	verr := &domain.ValidationError{}
+	from, err := time.ParseInLocation("20060102", params.From, time.Local)
+	if err != nil {
+		verr.Add("from", fmt.Sprintf("must be a date in YYYYMMDD format: %q", params.From))
+	}
+	to, err := time.ParseInLocation("20060102", params.To, time.Local)
+	if err != nil {
+		verr.Add("to", fmt.Sprintf("must be a date in YYYYMMDD format: %q", params.To))
+	}
+	if err := verr.Err(); err != nil {
+		return domain.SummaryPeriod{}, err
+	}
+	return domain.NewSummaryPeriod(from, to.AddDate(0, 0, 1), summaryBucket(params.Bucket))
+}
+
+// writePeriodSummary は期間のカテゴリ別サマリーを取得してレスポンスに書き込む
+func (s *Server) writePeriodSummary(c *gin.Context, period domain.SummaryPeriod) {
+	summaries, err := s.recordService.GetPeriodSummary(c.Request.Context(), period)
+	if err != nil {
+		writeError(c, "Failed to get period summary", err, "failed to get period summary")
+		return
+	}
+
+	// 日付は期間・区切りの最終日（終了日時の前日）を返す
+	lastDay := func(t time.Time) string { return t.AddDate(0, 0, -1).Format("20060102") }
+	response := api.PeriodSummary{
+		From:       period.From.Format("20060102"),
+		To:         lastDay(period.To),
+		Bucket:     api.SummaryBucket(period.Bucket),
+		Buckets:    []api.SummaryBucketRange{},
+		Categories: make([]api.CategoryPeriodSummary, len(summaries)),
+	}
+	for _, b := range period.Buckets() {
+		response.Buckets = append(response.Buckets, api.SummaryBucketRange{From: b.From.Format("20060102"), To: lastDay(b.To)})
+	}
+	for i, summary := range summaries {
+		response.Categories[i] = api.CategoryPeriodSummary{
+			CategoryId:   summary.CategoryID,
+			CategoryName: summary.CategoryName,
+			CategoryType: api.CategoryType(summary.CategoryType.String()),
+			Count:        summary.Count,
+			Price:        summary.Price,
+			Total:        summary.Total,
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// summaryBucket は区切る単位のクエリパラメータをドメインの値に変換する（デフォルトは月）
+func summaryBucket(bucket *api.SummaryBucket) domain.SummaryBucket {
+	if bucket == nil {
+		return domain.SummaryBucketMonth
+	}
+	return domain.SummaryBucket(*bucket)
+}
+
+// DeleteV3RecordTrash - purge trash (DELETE /v3/record/trash)
+func (s *Server) DeleteV3RecordTrash(c *gin.Context) {
+	// 保持期間を過ぎたレコードを物理削除
+	num, err := s.recordService.PurgeTrash(c.Request.Context())
+	if err != nil {
+		writeError(c, "Failed to purge trash", err, "failed to purge trash")
+		return
+	}
+
+	c.JSON(http.StatusOK, api.PurgeResult{Num: num})
+}
+
+// GetV3RecordTrash - get trashed records (GET /v3/record/trash)
+func (s *Server) GetV3RecordTrash(c *gin.Context, params api.GetV3RecordTrashParams) {
+	// デフォルト値の設定
+	num := 20
+	if params.Num != nil {
+		num = *params.Num
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	// ゴミ箱内のレコードを取得
+	records, err := s.recordService.GetTrashedRecords(c.Request.Context(), num, offset)
+	if err != nil {
+		writeError(c, "Failed to get trashed records", err, "failed to get trashed records")
+		return
+	}
+
+	// APIレスポンス型に変換
+	response := make([]api.TrashedRecord, len(records))
+	for i, rec := range records {
+		response[i] = api.TrashedRecord{
+			Id:           rec.ID,
+			CategoryId:   rec.CategoryID,
+			CategoryName: rec.CategoryName,
+			Datetime:     rec.Datetime,
+			From:         rec.From,
+			Type:         rec.Type,
+			Price:        rec.Price,
+			Memo:         rec.Memo,
+			Tags:         apiTags(rec.Tags),
+		}
+		if rec.DeletedAt != nil {
+			response[i].DeletedAt = *rec.DeletedAt
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PostV3RecordTrashIdRestore - restore record from trash (POST /v3/record/trash/{id}/restore)
+func (s *Server) PostV3RecordTrashIdRestore(c *gin.Context, id int) {
+	// id パラメータは自動的にパースされて渡される
+	record, err := s.recordService.RestoreRecord(c.Request.Context(), id)
+	if err != nil {
+		writeError(c, "Failed to restore record", err, "failed to restore record", slog.Int("id", id))
+		return
+	}
+
+	c.JSON(http.StatusOK, toAPIRecord(record))
+}
+
 // DeleteV3RecordId - delete record from id (DELETE /v3/record/{id})
 func (s *Server) DeleteV3RecordId(c *gin.Context, id int) {
 	// id パラメータは自動的にパースされて渡される
 	err := s.recordService.DeleteRecord(c.Request.Context(), id)
 	if err != nil {
-		slog.Error("Failed to delete record", slog.Int("id", id), slog.String("error", err.Error()))
-		c.JSON(http.StatusNotFound, gin.H{"error": "record not found"})
+		writeError(c, "Failed to delete record", err, "failed to delete record", slog.Int("id", id))
 		return
 	}
 
@@ -259,21 +646,155 @@ func (s *Server) GetV3RecordId(c *gin.Context, id int) {
 	// id パラメータは自動的にパースされて渡される
 	record, err := s.recordService.GetRecordByID(c.Request.Context(), id)
 	if err != nil {
-		slog.Error("Failed to get record", slog.Int("id", id), slog.String("error", err.Error()))
-		c.JSON(http.StatusNotFound, gin.H{"error": "record not found"})
+		writeError(c, "Failed to get record", err, "failed to get record", slog.Int("id", id))
+		return
+	}
+
+	c.JSON(http.StatusOK, toAPIRecord(record))
+}
+
+// PutV3RecordId - update record from id (PUT /v3/record/{id})
+func (s *Server) PutV3RecordId(c *gin.Context, id int) {
+	var req api.ReqRecord
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	// 更新時は自動分類の規則を使わないため、category_id は必須とする
+	if req.CategoryId == nil {
+		writeError(c, "Failed to update record", domain.NewValidationError("category_id", "is required"), "", slog.Int("id", id))
+		return
+	}
+
+	// ドメインエンティティを作成
+	// datetime が省略された場合はゼロ値のままとし、日時を変更しない
+	// tags は指定したもので置き換える（省略した場合はタグを削除する）
+	record := &domain.Record{
+		ID:         id,
+		CategoryID: *req.CategoryId,
+		Price:      req.Price,
+	}
+	if req.Datetime != nil {
+		parsedTime, err := parseDateTime(*req.Datetime)
+		if err != nil {
+			writeError(c, "Failed to parse datetime", invalidDatetime(), "", slog.String("datetime", *req.Datetime))
+			return
+		}
+		record.Datetime = parsedTime
+	}
+	if req.From != nil {
+		record.From = *req.From
+	}
+	if req.Type != nil {
+		record.Type = *req.Type
+	}
+	if req.Memo != nil {
+		record.Memo = *req.Memo
+	}
+	if req.Tags != nil {
+		record.Tags = *req.Tags
+	}
+
+	// レコードを更新
+	updatedRecord, err := s.recordService.UpdateRecord(c.Request.Context(), record)
+	if err != nil {
+		writeError(c, "Failed to update record", err, "failed to update record", slog.Int("id", id))
+		return
+	}
+
+	c.JSON(http.StatusOK, toAPIRecord(updatedRecord))
+}
+
+// GetV3RecordIdHistory - get record history (GET /v3/record/{id}/history)
+func (s *Server) GetV3RecordIdHistory(c *gin.Context, id int) {
+	versions, err := s.recordService.GetRecordHistory(c.Request.Context(), id)
+	if err != nil {
+		writeError(c, "Failed to get record history", err, "failed to get record history", slog.Int("id", id))
 		return
 	}
 
 	// APIレスポンス型に変換
-	response := api.Record{
-		Id:           record.ID,
-		CategoryId:   record.CategoryID,
-		CategoryName: record.CategoryName,
-		Datetime:     record.Datetime,
-		From:         record.From,
-		Type:         record.Type,
-		Price:        record.Price,
-		Memo:         record.Memo,
+	response := make([]api.RecordVersion, len(versions))
+	for i, v := range versions {
+		response[i] = api.RecordVersion{
+			Version:    v.Version,
+			Operation:  api.RecordVersionOperation(v.Operation),
+			RecordedAt: v.RecordedAt,
+			Record:     toAPIRecord(&v.Record),
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetV3Rules - get auto-categorization rules (GET /v3/rules)
+func (s *Server) GetV3Rules(c *gin.Context) {
+	rules := s.recordService.GetRules()
+
+	// APIレスポンス型に変換
+	response := make([]api.Rule, len(rules))
+	for i, rule := range rules {
+		response[i] = toAPIRule(rule)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PostV3RulesApply - apply auto-categorization rules (POST /v3/rules/apply)
+func (s *Server) PostV3RulesApply(c *gin.Context) {
+	s.applyRules(c, false)
+}
+
+// PostV3RulesDryRun - dry-run auto-categorization rules (POST /v3/rules/dry-run)
+func (s *Server) PostV3RulesDryRun(c *gin.Context) {
+	s.applyRules(c, true)
+}
+
+// applyRules は自動分類の規則を既存のレコードに適用し、変更されるレコードを返す
+// リクエストボディは省略でき、省略した場合は設定されている規則を全てのレコードに適用する
+func (s *Server) applyRules(c *gin.Context, dryRun bool) {
+	var req api.RuleApplyRequest
+	if c.Request.ContentLength != 0 && !bindJSON(c, &req) {
+		return
+	}
+
+	// rules が指定された場合は、設定されている規則の代わりに使う
+	var rules *domain.RuleSet
+	if req.Rules != nil {
+		var err error
+		if rules, err = toDomainRuleSet(*req.Rules); err != nil {
+			writeError(c, "Invalid rules", err, "")
+			return
+		}
+	}
+	yyyymm := ""
+	if req.Yyyymm != nil {
+		yyyymm = *req.Yyyymm
+	}
+	categoryID := 0
+	if req.CategoryId != nil {
+		categoryID = *req.CategoryId
+	}
+
+	changes, err := s.recordService.ApplyRules(c.Request.Context(), rules, yyyymm, categoryID, dryRun)
+	if err != nil {
+		writeError(c, "Failed to apply rules", err, "failed to apply rules", slog.Bool("dry_run", dryRun))
+		return
+	}
+
+	// APIレスポンス型に変換
+	response := api.RuleApplyResult{Changes: make([]api.RuleChange, len(changes))}
+	for i, change := range changes {
+		response.Changes[i] = api.RuleChange{
+			Before: toAPIRecord(change.Before),
+			After:  toAPIRecord(change.After),
+			Rules:  change.Rules,
+		}
+		if change.Skipped != "" {
+			response.Changes[i].Skipped = stringPtr(change.Skipped)
+			continue
+		}
+		response.Num++
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -288,6 +809,178 @@ func (s *Server) GetV3Version(c *gin.Context) {
 	})
 }
 
+// toAPIRecord はドメインエンティティをAPIレスポンス型に変換する
+func toAPIRecord(record *domain.Record) api.Record {
+	return api.Record{
+		Id:           record.ID,
+		CategoryId:   record.CategoryID,
+		CategoryName: record.CategoryName,
+		Datetime:     record.Datetime,
+		From:         record.From,
+		Type:         record.Type,
+		Price:        record.Price,
+		Memo:         record.Memo,
+		Tags:         apiTags(record.Tags),
+	}
+}
+
+// apiTags はタグがない場合に null ではなく空の配列を返す
+func apiTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+// toAPIRule は自動分類の規則をAPIレスポンス型に変換する
+func toAPIRule(rule domain.Rule) api.Rule {
+	m := rule.Match
+	match := api.RuleMatch{MinPrice: m.MinPrice, MaxPrice: m.MaxPrice}
+	if m.MemoContains != "" {
+		match.MemoContains = stringPtr(m.MemoContains)
+	}
+	if m.MemoRegex != "" {
+		match.MemoRegex = stringPtr(m.MemoRegex)
+	}
+	if m.From != "" {
+		match.From = stringPtr(m.From)
+	}
+	if len(m.Weekdays) > 0 {
+		weekdays := make([]api.RuleMatchWeekdays, len(m.Weekdays))
+		for i, d := range m.Weekdays {
+			weekdays[i] = api.RuleMatchWeekdays(domain.WeekdayName(d))
+		}
+		match.Weekdays = &weekdays
+	}
+
+	action := api.RuleAction{}
+	if rule.Set.CategoryID != 0 {
+		action.CategoryId = &rule.Set.CategoryID
+	}
+	if rule.Set.Type != "" {
+		action.Type = stringPtr(rule.Set.Type)
+	}
+	if len(rule.Set.Tags) > 0 {
+		action.Tags = &rule.Set.Tags
+	}
+
+	return api.Rule{Name: rule.Name, Priority: &rule.Priority, Match: match, Set: action}
+}
+
+// toDomainRuleSet はリクエストの規則を検証し、ドメインの規則に変換する
+func toDomainRuleSet(rules []api.Rule) (*domain.RuleSet, error) {
+	converted := make([]domain.Rule, len(rules))
+	for i, r := range rules {
+		rule := domain.Rule{
+			Name:  r.Name,
+			Match: domain.RuleMatch{MinPrice: r.Match.MinPrice, MaxPrice: r.Match.MaxPrice},
+		}
+		if r.Priority != nil {
+			rule.Priority = *r.Priority
+		}
+		if r.Match.MemoContains != nil {
+			rule.Match.MemoContains = *r.Match.MemoContains
+		}
+		if r.Match.MemoRegex != nil {
+			rule.Match.MemoRegex = *r.Match.MemoRegex
+		}
+		if r.Match.From != nil {
+			rule.Match.From = *r.Match.From
+		}
+		if r.Match.Weekdays != nil {
+			for _, name := range *r.Match.Weekdays {
+				d, ok := domain.ParseWeekday(string(name))
+				if !ok {
+					return nil, domain.NewValidationError(fmt.Sprintf("rules[%d].match.weekdays", i), fmt.Sprintf("invalid weekday: %q", name))
+				}
+				rule.Match.Weekdays = append(rule.Match.Weekdays, d)
+			}
+		}
+		if r.Set.CategoryId != nil {
+			rule.Set.CategoryID = *r.Set.CategoryId
+		}
+		if r.Set.Type != nil {
+			rule.Set.Type = *r.Set.Type
+		}
+		if r.Set.Tags != nil {
+			rule.Set.Tags = *r.Set.Tags
+		}
+		converted[i] = rule
+	}
+	return domain.NewRuleSet(converted)
+}
+
+// toAPIBackupStatus は自動バックアップの実行状況をAPIレスポンス型に変換する
+func toAPIBackupStatus(status *application.BackupStatus) api.BackupStatus {
+	interval := status.Interval.String()
+	files := make([]api.BackupFile, len(status.Files))
+	for i, f := range status.Files {
+		files[i] = api.BackupFile{Name: f.Name, CreatedAt: f.CreatedAt, Size: f.Size}
+	}
+
+	response := api.BackupStatus{
+		Enabled:        true,
+		Interval:       &interval,
+		KeepLast:       &status.Retention.KeepLast,
+		KeepDaily:      &status.Retention.KeepDaily,
+		KeepMonthly:    &status.Retention.KeepMonthly,
+		LastStartedAt:  optionalTime(status.LastStartedAt),
+		LastFinishedAt: optionalTime(status.LastFinishedAt),
+		LastSuccessAt:  optionalTime(status.LastSuccessAt),
+		NextAt:         optionalTime(status.NextAt),
+		Files:          &files,
+	}
+
+	if !status.LastFinishedAt.IsZero() {
+		result := api.Success
+		if status.LastErr != nil {
+			result = api.Failure
+			response.LastError = stringPtr(status.LastErr.Error())
+		}
+		response.LastResult = &result
+		if status.LastFile != "" {
+			response.LastFile = &status.LastFile
+		}
+	}
+	return response
+}
+
+// stringPtr は文字列のポインタを返す
+func stringPtr(s string) *string {
+	return &s
+}
+
+// optionalTime はゼロ値の場合に nil を返す
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// bindJSON はリクエストボディを obj に変換し、失敗した場合は 400 を返して false を返す
+// 型が一致しない項目は項目ごとの検証エラーとして返す
+func bindJSON(c *gin.Context, obj any) bool {
+	err := c.ShouldBindJSON(obj)
+	if err == nil {
+		return true
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		writeError(c, "Failed to bind request body", domain.NewValidationError(typeErr.Field, "must be of type "+typeErr.Type.String()), "")
+		return false
+	}
+	slog.WarnContext(c.Request.Context(), "Failed to bind request body", slog.String("error", err.Error()))
+	writeStatusProblem(c, http.StatusBadRequest, "invalid request body")
+	return false
+}
+
+// invalidDatetime は datetime の形式が不正な場合の検証エラーを返す
+func invalidDatetime() error {
+	return domain.NewValidationError("datetime", "must be YYYYMMDD or RFC 3339")
+}
+
 // parseDateTime はYYYYMMDD形式の文字列をtime.Timeに変換する
 func parseDateTime(datetime string) (time.Time, error) {
 	// YYYYMMDD形式をパース
@@ -3,9 +3,12 @@ package http
 import (
 	"context"
 	"encoding/json"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/azuki774/mawinter/api"
 	"github.com/azuki774/mawinter/internal/application"
@@ -27,18 +30,46 @@ func (m *mockCategoryRepository) FindAll(ctx context.Context) ([]*domain.Categor
 	return m.categories, nil
 }
 
+// testCategories はレコードの作成・更新の検証で登録済みとして扱うカテゴリ
+var testCategories = []*domain.Category{
+	{ID: 1, CategoryID: 100, Name: "月給", CategoryType: domain.CategoryTypeIncome},
+	{ID: 2, CategoryID: 210, Name: "食費", CategoryType: domain.CategoryTypeOutgoing},
+}
+
 // mockRecordRepository はテスト用のモックリポジトリ
 type mockRecordRepository struct {
 	records      []*domain.Record
 	err          error
 	findByIDFunc func(ctx context.Context, id int) (*domain.Record, error)
+	findAllFunc  func(ctx context.Context, num, offset int, yyyymm string, categoryID int) ([]*domain.Record, error)
 	deleteFunc   func(ctx context.Context, id int) error
+	restoreFunc  func(ctx context.Context, id int) (*domain.Record, error)
+	purgeFunc    func(ctx context.Context, before time.Time) (int, error)
+	updateFunc   func(ctx context.Context, record *domain.Record) (*domain.Record, error)
+	history      []*domain.RecordVersion
+	summaries    []*domain.CategoryYearSummary
+	asOfFunc     func(ctx context.Context, year int, asOf time.Time) ([]*domain.CategoryYearSummary, error)
+	periodFunc   func(ctx context.Context, period domain.SummaryPeriod) ([]*domain.CategoryPeriodSummary, error)
 }
 
 func (m *mockRecordRepository) Create(ctx context.Context, record *domain.Record) (*domain.Record, error) {
 	return nil, nil
 }
 
+func (m *mockRecordRepository) Update(ctx context.Context, record *domain.Record) (*domain.Record, error) {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, record)
+	}
+	return nil, nil
+}
+
+func (m *mockRecordRepository) FindHistory(ctx context.Context, id int) ([]*domain.RecordVersion, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.history, nil
+}
+
 func (m *mockRecordRepository) FindByID(ctx context.Context, id int) (*domain.Record, error) {
 	if m.findByIDFunc != nil {
 		return m.findByIDFunc(ctx, id)
@@ -47,6 +78,9 @@ func (m *mockRecordRepository) FindByID(ctx context.Context, id int) (*domain.Re
 }
 
 func (m *mockRecordRepository) FindAll(ctx context.Context, num, offset int, yyyymm string, categoryID int) ([]*domain.Record, error) {
+	if m.findAllFunc != nil {
+		return m.findAllFunc(ctx, num, offset, yyyymm, categoryID)
+	}
 	return nil, nil
 }
 
@@ -61,11 +95,46 @@ func (m *mockRecordRepository) Delete(ctx context.Context, id int) error {
 	return nil
 }
 
+func (m *mockRecordRepository) FindDeleted(ctx context.Context, num, offset int) ([]*domain.Record, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.records, nil
+}
+
+func (m *mockRecordRepository) Restore(ctx context.Context, id int) (*domain.Record, error) {
+	if m.restoreFunc != nil {
+		return m.restoreFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *mockRecordRepository) Purge(ctx context.Context, before time.Time) (int, error) {
+	if m.purgeFunc != nil {
+		return m.purgeFunc(ctx, before)
+	}
+	return 0, nil
+}
+
 func (m *mockRecordRepository) GetAvailablePeriods(ctx context.Context) ([]string, []string, error) {
 	return nil, nil, nil
 }
 
 func (m *mockRecordRepository) GetYearSummary(ctx context.Context, year int) ([]*domain.CategoryYearSummary, error) {
+	return m.summaries, nil
+}
+
+func (m *mockRecordRepository) GetPeriodSummary(ctx context.Context, period domain.SummaryPeriod) ([]*domain.CategoryPeriodSummary, error) {
+	if m.periodFunc != nil {
+		return m.periodFunc(ctx, period)
+	}
+	return nil, nil
+}
+
+func (m *mockRecordRepository) GetYearSummaryAsOf(ctx context.Context, year int, asOf time.Time) ([]*domain.CategoryYearSummary, error) {
+	if m.asOfFunc != nil {
+		return m.asOfFunc(ctx, year, asOf)
+	}
 	return nil, nil
 }
 
@@ -122,9 +191,9 @@ func TestGetV3Categories(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			categoryService := application.NewCategoryService(tt.mockRepo)
-			recordService := application.NewRecordService(&mockRecordRepository{})
+			recordService := application.NewRecordService(&mockRecordRepository{}, &mockCategoryRepository{categories: testCategories})
 			dbInfo := &config.DBInfo{}
-			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", dbInfo, categoryService, recordService)
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", dbInfo, categoryService, recordService, nil, nil)
 
 			w := httptest.NewRecorder()
 			req, _ := http.NewRequest("GET", "/api/v3/categories", nil)
@@ -196,20 +265,31 @@ func TestGetV3RecordId(t *testing.T) {
 			recordID: 999,
 			mockRepo: &mockRecordRepository{
 				findByIDFunc: func(ctx context.Context, id int) (*domain.Record, error) {
-					return nil, context.DeadlineExceeded
+					return nil, domain.NewNotFoundError("record", id)
 				},
 			},
 			wantStatusCode:    http.StatusNotFound,
 			checkResponseFunc: nil,
 		},
+		{
+			name:     "異常系: DBエラーは404にしない",
+			recordID: 1,
+			mockRepo: &mockRecordRepository{
+				findByIDFunc: func(ctx context.Context, id int) (*domain.Record, error) {
+					return nil, context.DeadlineExceeded
+				},
+			},
+			wantStatusCode:    http.StatusInternalServerError,
+			checkResponseFunc: nil,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			categoryService := application.NewCategoryService(&mockCategoryRepository{})
-			recordService := application.NewRecordService(tt.mockRepo)
+			recordService := application.NewRecordService(tt.mockRepo, &mockCategoryRepository{categories: testCategories})
 			dbInfo := &config.DBInfo{}
-			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", dbInfo, categoryService, recordService)
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", dbInfo, categoryService, recordService, nil, nil)
 
 			w := httptest.NewRecorder()
 			req, _ := http.NewRequest("GET", "/api/v3/record/1", nil)
@@ -254,19 +334,49 @@ func TestDeleteV3RecordId(t *testing.T) {
 			recordID: 999,
 			mockRepo: &mockRecordRepository{
 				deleteFunc: func(ctx context.Context, id int) error {
-					return context.DeadlineExceeded
+					return domain.NewNotFoundError("record", id)
 				},
 			},
 			wantStatusCode: http.StatusNotFound,
 		},
+		{
+			name:     "異常系: 既にゴミ箱にある",
+			recordID: 1,
+			mockRepo: &mockRecordRepository{
+				deleteFunc: func(ctx context.Context, id int) error {
+					return domain.NewConflictError("record %d is already in trash", id)
+				},
+			},
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:     "異常系: 確定済みの月のレコード",
+			recordID: 1,
+			mockRepo: &mockRecordRepository{
+				deleteFunc: func(ctx context.Context, id int) error {
+					return &domain.MonthLockedError{YYYYMM: "202501"}
+				},
+			},
+			wantStatusCode: http.StatusLocked,
+		},
+		{
+			name:     "異常系: DBエラーは404にしない",
+			recordID: 1,
+			mockRepo: &mockRecordRepository{
+				deleteFunc: func(ctx context.Context, id int) error {
+					return context.DeadlineExceeded
+				},
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			categoryService := application.NewCategoryService(&mockCategoryRepository{})
-			recordService := application.NewRecordService(tt.mockRepo)
+			recordService := application.NewRecordService(tt.mockRepo, &mockCategoryRepository{categories: testCategories})
 			dbInfo := &config.DBInfo{}
-			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", dbInfo, categoryService, recordService)
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", dbInfo, categoryService, recordService, nil, nil)
 
 			w := httptest.NewRecorder()
 			req, _ := http.NewRequest("DELETE", "/api/v3/record/1", nil)
@@ -278,3 +388,1168 @@ func TestDeleteV3RecordId(t *testing.T) {
 		})
 	}
 }
+
+func TestGetV3RecordTrash(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	deletedAt := time.Date(2025, 10, 21, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		mockRepo       *mockRecordRepository
+		wantStatusCode int
+		wantCount      int
+	}{
+		{
+			name: "正常系: ゴミ箱内のレコードを取得できる",
+			mockRepo: &mockRecordRepository{
+				records: []*domain.Record{
+					{ID: 2, CategoryID: 210, CategoryName: "食費", Price: 1280, DeletedAt: &deletedAt},
+					{ID: 1, CategoryID: 100, CategoryName: "月給", Price: 300000, DeletedAt: &deletedAt},
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantCount:      2,
+		},
+		{
+			name: "異常系: リポジトリエラー",
+			mockRepo: &mockRecordRepository{
+				err: context.DeadlineExceeded,
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categoryService := application.NewCategoryService(&mockCategoryRepository{})
+			recordService := application.NewRecordService(tt.mockRepo, &mockCategoryRepository{categories: testCategories})
+			dbInfo := &config.DBInfo{}
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", dbInfo, categoryService, recordService, nil, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/api/v3/record/trash", nil)
+			server.router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status code %d, got %d", tt.wantStatusCode, w.Code)
+			}
+
+			if tt.wantStatusCode == http.StatusOK {
+				var response []api.TrashedRecord
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Fatalf("failed to unmarshal response: %v", err)
+				}
+				if len(response) != tt.wantCount {
+					t.Errorf("expected %d records, got %d", tt.wantCount, len(response))
+				}
+				if !response[0].DeletedAt.Equal(deletedAt) {
+					t.Errorf("expected deleted_at %v, got %v", deletedAt, response[0].DeletedAt)
+				}
+			}
+		})
+	}
+}
+
+func TestPostV3RecordTrashIdRestore(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		mockRepo       *mockRecordRepository
+		wantStatusCode int
+	}{
+		{
+			name: "正常系: ゴミ箱からレコードを復元できる",
+			mockRepo: &mockRecordRepository{
+				restoreFunc: func(ctx context.Context, id int) (*domain.Record, error) {
+					return &domain.Record{ID: id, CategoryID: 210, CategoryName: "食費", Price: 1280}, nil
+				},
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "異常系: レコードが存在しない",
+			mockRepo: &mockRecordRepository{
+				restoreFunc: func(ctx context.Context, id int) (*domain.Record, error) {
+					return nil, domain.NewNotFoundError("record", id)
+				},
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "異常系: ゴミ箱にレコードが存在しない",
+			mockRepo: &mockRecordRepository{
+				restoreFunc: func(ctx context.Context, id int) (*domain.Record, error) {
+					return nil, domain.NewConflictError("record %d is not in trash", id)
+				},
+			},
+			wantStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categoryService := application.NewCategoryService(&mockCategoryRepository{})
+			recordService := application.NewRecordService(tt.mockRepo, &mockCategoryRepository{categories: testCategories})
+			dbInfo := &config.DBInfo{}
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", dbInfo, categoryService, recordService, nil, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/api/v3/record/trash/1/restore", nil)
+			server.router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status code %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestDeleteV3RecordTrash(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var gotBefore time.Time
+	mockRepo := &mockRecordRepository{
+		purgeFunc: func(ctx context.Context, before time.Time) (int, error) {
+			gotBefore = before
+			return 3, nil
+		},
+	}
+
+	categoryService := application.NewCategoryService(&mockCategoryRepository{})
+	recordService := application.NewRecordService(mockRepo, &mockCategoryRepository{categories: testCategories}, application.WithTrashRetention(7*24*time.Hour))
+	dbInfo := &config.DBInfo{}
+	server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", dbInfo, categoryService, recordService, nil, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/api/v3/record/trash", nil)
+	server.router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response api.PurgeResult
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if response.Num != 3 {
+		t.Errorf("expected num 3, got %d", response.Num)
+	}
+
+	// 保持期間（7日）より前の削除分が対象になっていることを確認
+	wantBefore := time.Now().Add(-7 * 24 * time.Hour)
+	if diff := wantBefore.Sub(gotBefore); diff < 0 || diff > time.Minute {
+		t.Errorf("expected purge threshold around %v, got %v", wantBefore, gotBefore)
+	}
+}
+
+func TestPutV3RecordId(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		body           string
+		mockRepo       *mockRecordRepository
+		wantStatusCode int
+		wantDatetime   time.Time
+	}{
+		{
+			name: "正常系: レコードを更新できる",
+			body: `{"category_id": 210, "price": 1500, "datetime": "20251020", "memo": "更新後"}`,
+			mockRepo: &mockRecordRepository{
+				updateFunc: func(ctx context.Context, record *domain.Record) (*domain.Record, error) {
+					record.CategoryName = "食費"
+					return record, nil
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantDatetime:   time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "正常系: datetime 省略時はゼロ値のまま渡される",
+			body: `{"category_id": 210, "price": 1500}`,
+			mockRepo: &mockRecordRepository{
+				updateFunc: func(ctx context.Context, record *domain.Record) (*domain.Record, error) {
+					if !record.Datetime.IsZero() {
+						t.Errorf("expected zero datetime, got %v", record.Datetime)
+					}
+					return record, nil
+				},
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "異常系: 不正なリクエストボディ",
+			body:           `{"category_id": "abc"}`,
+			mockRepo:       &mockRecordRepository{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: 更新時はカテゴリを省略できない",
+			body:           `{"price": 1500}`,
+			mockRepo:       &mockRecordRepository{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: 不正な日時",
+			body:           `{"category_id": 210, "price": 1500, "datetime": "2025-10-20"}`,
+			mockRepo:       &mockRecordRepository{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "異常系: レコードが見つからない",
+			body: `{"category_id": 210, "price": 1500}`,
+			mockRepo: &mockRecordRepository{
+				updateFunc: func(ctx context.Context, record *domain.Record) (*domain.Record, error) {
+					return nil, domain.NewNotFoundError("record", record.ID)
+				},
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "異常系: 確定済みの月のレコード",
+			body: `{"category_id": 210, "price": 1500}`,
+			mockRepo: &mockRecordRepository{
+				updateFunc: func(ctx context.Context, record *domain.Record) (*domain.Record, error) {
+					return nil, &domain.MonthLockedError{YYYYMM: "202501"}
+				},
+			},
+			wantStatusCode: http.StatusLocked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categoryService := application.NewCategoryService(&mockCategoryRepository{})
+			recordService := application.NewRecordService(tt.mockRepo, &mockCategoryRepository{categories: testCategories})
+			dbInfo := &config.DBInfo{}
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", dbInfo, categoryService, recordService, nil, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("PUT", "/api/v3/record/1", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			server.router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status code %d, got %d", tt.wantStatusCode, w.Code)
+			}
+
+			if tt.wantStatusCode == http.StatusOK {
+				var response api.Record
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Fatalf("failed to unmarshal response: %v", err)
+				}
+				if response.Id != 1 {
+					t.Errorf("expected id 1, got %d", response.Id)
+				}
+				if !tt.wantDatetime.IsZero() && !response.Datetime.Equal(tt.wantDatetime) {
+					t.Errorf("expected datetime %v, got %v", tt.wantDatetime, response.Datetime)
+				}
+			}
+		})
+	}
+}
+
+func TestGetV3RecordIdHistory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	recordedAt := time.Date(2025, 10, 21, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		mockRepo       *mockRecordRepository
+		wantStatusCode int
+		wantVersions   int
+	}{
+		{
+			name: "正常系: 履歴を取得できる",
+			mockRepo: &mockRecordRepository{
+				history: []*domain.RecordVersion{
+					{Version: 1, Operation: domain.RecordOperationCreate, RecordedAt: recordedAt, Record: domain.Record{ID: 1, Price: 1000}},
+					{Version: 2, Operation: domain.RecordOperationUpdate, RecordedAt: recordedAt.Add(time.Hour), Record: domain.Record{ID: 1, Price: 1200}},
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantVersions:   2,
+		},
+		{
+			name: "異常系: 履歴が存在しない",
+			mockRepo: &mockRecordRepository{
+				err: domain.NewNotFoundError("record", 1),
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categoryService := application.NewCategoryService(&mockCategoryRepository{})
+			recordService := application.NewRecordService(tt.mockRepo, &mockCategoryRepository{categories: testCategories})
+			dbInfo := &config.DBInfo{}
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", dbInfo, categoryService, recordService, nil, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/api/v3/record/1/history", nil)
+			server.router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status code %d, got %d", tt.wantStatusCode, w.Code)
+			}
+
+			if tt.wantStatusCode == http.StatusOK {
+				var response []api.RecordVersion
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Fatalf("failed to unmarshal response: %v", err)
+				}
+				if len(response) != tt.wantVersions {
+					t.Fatalf("expected %d versions, got %d", tt.wantVersions, len(response))
+				}
+				if response[1].Operation != api.Update || response[1].Record.Price != 1200 {
+					t.Errorf("unexpected second version: %+v", response[1])
+				}
+			}
+		})
+	}
+}
+
+func TestPostV3RecordParse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		body           string
+		wantStatusCode int
+		wantRecord     api.ReqRecord
+		wantKinds      []api.ParsedTokenKind
+	}{
+		{
+			name:           "正常系: 短い文を解釈する",
+			body:           `{"text": "食費 1,280 コンビニ 2025/10/01"}`,
+			wantStatusCode: http.StatusOK,
+			wantRecord:     api.ReqRecord{CategoryId: intPtr(210), Price: 1280, Datetime: stringPtr("20251001"), Memo: stringPtr("コンビニ")},
+			wantKinds:      []api.ParsedTokenKind{api.ParsedTokenKindCategory, api.ParsedTokenKindPrice, api.ParsedTokenKindMemo, api.ParsedTokenKindDate},
+		},
+		{
+			name:           "正常系: カテゴリ ID と金額のみ",
+			body:           `{"text": "100 300000"}`,
+			wantStatusCode: http.StatusOK,
+			wantRecord:     api.ReqRecord{CategoryId: intPtr(100), Price: 300000},
+			wantKinds:      []api.ParsedTokenKind{api.ParsedTokenKindCategory, api.ParsedTokenKindPrice},
+		},
+		{
+			name:           "異常系: カテゴリを解釈できない",
+			body:           `{"text": "交通費 1280"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: 空の文",
+			body:           `{"text": ""}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categoryService := application.NewCategoryService(&mockCategoryRepository{})
+			recordService := application.NewRecordService(&mockRecordRepository{}, &mockCategoryRepository{categories: testCategories})
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", &config.DBInfo{}, categoryService, recordService, nil, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/api/v3/record/parse", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			server.router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Fatalf("expected status code %d, got %d: %s", tt.wantStatusCode, w.Code, w.Body.String())
+			}
+			if tt.wantStatusCode != http.StatusOK {
+				return
+			}
+
+			var response api.ParsedEntry
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			got, _ := json.Marshal(response.Record)
+			want, _ := json.Marshal(tt.wantRecord)
+			if string(got) != string(want) {
+				t.Errorf("expected record %s, got %s", want, got)
+			}
+			if len(response.Tokens) != len(tt.wantKinds) {
+				t.Fatalf("expected %d tokens, got %+v", len(tt.wantKinds), response.Tokens)
+			}
+			for i, tok := range response.Tokens {
+				if tok.Kind != tt.wantKinds[i] {
+					t.Errorf("token %q: expected %s, got %s", tok.Text, tt.wantKinds[i], tok.Kind)
+				}
+			}
+		})
+	}
+}
+
+func TestGetV3Rules(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	rules, err := domain.NewRuleSet([]domain.Rule{
+		{Name: "低", Match: domain.RuleMatch{From: "bank"}, Set: domain.RuleAction{Type: "bank"}},
+		{Name: "高", Priority: 10, Match: domain.RuleMatch{MemoContains: "電気", Weekdays: []time.Weekday{time.Monday}}, Set: domain.RuleAction{CategoryID: 220, Tags: []string{"光熱費"}}},
+	})
+	if err != nil {
+		t.Fatalf("NewRuleSet() error = %v", err)
+	}
+	categoryService := application.NewCategoryService(&mockCategoryRepository{})
+	recordService := application.NewRecordService(&mockRecordRepository{}, &mockCategoryRepository{categories: testCategories}, application.WithRules(rules))
+	server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", &config.DBInfo{}, categoryService, recordService, nil, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v3/rules", nil)
+	server.router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status code 200, got %d: %s", w.Code, w.Body.String())
+	}
+	// 優先度の順に返し、設定していない項目は省略する
+	want := `[{"match":{"memo_contains":"電気","weekdays":["mon"]},"name":"高","priority":10,"set":{"category_id":220,"tags":["光熱費"]}},` +
+		`{"match":{"from":"bank"},"name":"低","priority":0,"set":{"type":"bank"}}]`
+	if w.Body.String() != want {
+		t.Errorf("unexpected response:\n%s\nwant:\n%s", w.Body.String(), want)
+	}
+}
+
+func TestPostV3RulesDryRun(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	records := []*domain.Record{
+		{ID: 2, CategoryID: 210, CategoryName: "食費", Datetime: time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), Price: 5000, Memo: "電気代"},
+		{ID: 1, CategoryID: 210, CategoryName: "食費", Datetime: time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), Price: 800, Memo: "ランチ"},
+	}
+
+	tests := []struct {
+		name           string
+		body           string
+		wantStatusCode int
+		wantNum        int
+	}{
+		{
+			name:           "正常系: リクエストの規則で変更されるレコードを返す",
+			body:           `{"rules": [{"name": "電気", "match": {"memo_contains": "電気"}, "set": {"category_id": 100, "tags": ["光熱費"]}}], "yyyymm": "202510"}`,
+			wantStatusCode: http.StatusOK,
+			wantNum:        1,
+		},
+		{
+			name:           "正常系: ボディを省略した場合は設定されている規則（なし）",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "異常系: 不正な規則",
+			body:           `{"rules": [{"name": "電気", "match": {"memo_regex": "("}, "set": {"category_id": 220}}]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: 存在しないカテゴリを設定する規則",
+			body:           `{"rules": [{"name": "電気", "match": {"memo_contains": "電気"}, "set": {"category_id": 999}}]}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockRecordRepository{
+				findAllFunc: func(ctx context.Context, num, offset int, yyyymm string, categoryID int) ([]*domain.Record, error) {
+					return records, nil
+				},
+				updateFunc: func(ctx context.Context, record *domain.Record) (*domain.Record, error) {
+					t.Errorf("dry-run must not update records: %+v", record)
+					return record, nil
+				},
+			}
+			categoryService := application.NewCategoryService(&mockCategoryRepository{})
+			recordService := application.NewRecordService(mockRepo, &mockCategoryRepository{categories: testCategories})
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", &config.DBInfo{}, categoryService, recordService, nil, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/api/v3/rules/dry-run", strings.NewReader(tt.body))
+			if tt.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			server.router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Fatalf("expected status code %d, got %d: %s", tt.wantStatusCode, w.Code, w.Body.String())
+			}
+			if tt.wantStatusCode != http.StatusOK {
+				return
+			}
+
+			var response api.RuleApplyResult
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			if response.Num != tt.wantNum || len(response.Changes) != tt.wantNum {
+				t.Fatalf("expected %d changes, got %+v", tt.wantNum, response)
+			}
+			if tt.wantNum > 0 {
+				c := response.Changes[0]
+				if c.Before.Id != 2 || c.Before.CategoryId != 210 || c.After.CategoryId != 100 || c.After.CategoryName != "月給" || len(c.After.Tags) != 1 || c.Skipped != nil {
+					t.Errorf("unexpected change: %+v", c)
+				}
+			}
+		})
+	}
+}
+
+func TestGetV3RecordYear_AsOf(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var gotAsOf time.Time
+	mockRepo := &mockRecordRepository{
+		summaries: []*domain.CategoryYearSummary{
+			{CategoryID: 210, CategoryName: "食費", CategoryType: domain.CategoryTypeOutgoing, Count: 2, Total: 3000},
+		},
+		asOfFunc: func(ctx context.Context, year int, asOf time.Time) ([]*domain.CategoryYearSummary, error) {
+			gotAsOf = asOf
+			return []*domain.CategoryYearSummary{
+				{CategoryID: 210, CategoryName: "食費", CategoryType: domain.CategoryTypeOutgoing, Count: 1, Total: 1000},
+			}, nil
+		},
+	}
+
+	categoryService := application.NewCategoryService(&mockCategoryRepository{})
+	recordService := application.NewRecordService(mockRepo, &mockCategoryRepository{categories: testCategories})
+	dbInfo := &config.DBInfo{}
+	server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", dbInfo, categoryService, recordService, nil, nil)
+
+	tests := []struct {
+		name      string
+		url       string
+		wantTotal int
+	}{
+		{name: "as_of 指定なしは現在のサマリー", url: "/api/v3/record/summary/2025", wantTotal: 3000},
+		{name: "as_of 指定時は履歴から計算", url: "/api/v3/record/summary/2025?as_of=2025-10-01T00:00:00Z", wantTotal: 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tt.url, nil)
+			server.router.ServeHTTP(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+			}
+
+			var response []api.CategoryYearSummary
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			if len(response) != 1 || response[0].Total != tt.wantTotal {
+				t.Errorf("expected total %d, got %+v", tt.wantTotal, response)
+			}
+		})
+	}
+
+	if want := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC); !gotAsOf.Equal(want) {
+		t.Errorf("expected as_of %v, got %v", want, gotAsOf)
+	}
+}
+
+// mockBackupRepository はテスト用のモックリポジトリ
+type mockBackupRepository struct {
+	files     []*domain.BackupFile
+	createErr error
+}
+
+func (m *mockBackupRepository) Create(ctx context.Context) (*domain.BackupFile, error) {
+	if m.createErr != nil {
+		return nil, m.createErr
+	}
+	f := &domain.BackupFile{Name: "mawinter-20250301-030000.jsonl", CreatedAt: time.Now(), Size: 1024}
+	m.files = append([]*domain.BackupFile{f}, m.files...)
+	return f, nil
+}
+
+func (m *mockBackupRepository) List(ctx context.Context) ([]*domain.BackupFile, error) {
+	return m.files, nil
+}
+
+func (m *mockBackupRepository) Remove(ctx context.Context, name string) error {
+	return nil
+}
+
+func TestGetV3BackupStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		repo      *mockBackupRepository
+		run       bool
+		checkFunc func(t *testing.T, response api.BackupStatus)
+	}{
+		{
+			name: "自動バックアップが無効",
+			checkFunc: func(t *testing.T, response api.BackupStatus) {
+				if response.Enabled || response.LastResult != nil || response.Files != nil {
+					t.Errorf("expected disabled status only, got %+v", response)
+				}
+			},
+		},
+		{
+			name: "未実行",
+			repo: &mockBackupRepository{},
+			checkFunc: func(t *testing.T, response api.BackupStatus) {
+				if !response.Enabled || response.Interval == nil || *response.Interval != "24h0m0s" {
+					t.Errorf("unexpected settings: %+v", response)
+				}
+				if response.KeepLast == nil || *response.KeepLast != 7 {
+					t.Errorf("expected keep_last 7, got %v", response.KeepLast)
+				}
+				if response.LastResult != nil || response.LastStartedAt != nil {
+					t.Errorf("expected no last result, got %+v", response)
+				}
+				if response.Files == nil || len(*response.Files) != 0 {
+					t.Errorf("expected empty files, got %v", response.Files)
+				}
+			},
+		},
+		{
+			name: "成功",
+			repo: &mockBackupRepository{},
+			run:  true,
+			checkFunc: func(t *testing.T, response api.BackupStatus) {
+				if response.LastResult == nil || *response.LastResult != api.Success {
+					t.Fatalf("expected success, got %v", response.LastResult)
+				}
+				if response.LastFile == nil || *response.LastFile != "mawinter-20250301-030000.jsonl" {
+					t.Errorf("unexpected last_file: %v", response.LastFile)
+				}
+				if response.LastSuccessAt == nil || response.LastError != nil {
+					t.Errorf("unexpected result: %+v", response)
+				}
+				if response.Files == nil || len(*response.Files) != 1 || (*response.Files)[0].Size != 1024 {
+					t.Errorf("unexpected files: %v", response.Files)
+				}
+			},
+		},
+		{
+			name: "失敗",
+			repo: &mockBackupRepository{createErr: context.DeadlineExceeded},
+			run:  true,
+			checkFunc: func(t *testing.T, response api.BackupStatus) {
+				if response.LastResult == nil || *response.LastResult != api.Failure {
+					t.Fatalf("expected failure, got %v", response.LastResult)
+				}
+				if response.LastError == nil || *response.LastError != context.DeadlineExceeded.Error() {
+					t.Errorf("unexpected last_error: %v", response.LastError)
+				}
+				if response.LastSuccessAt != nil || response.LastFile != nil {
+					t.Errorf("unexpected result: %+v", response)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var backupService *application.BackupService
+			if tt.repo != nil {
+				backupService = application.NewBackupService(tt.repo, application.DefaultBackupInterval, application.DefaultBackupRetention)
+				if tt.run {
+					backupService.RunBackup(context.Background())
+				}
+			}
+
+			categoryService := application.NewCategoryService(&mockCategoryRepository{})
+			recordService := application.NewRecordService(&mockRecordRepository{}, &mockCategoryRepository{categories: testCategories})
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", &config.DBInfo{}, categoryService, recordService, nil, backupService)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/api/v3/backup/status", nil)
+			server.router.ServeHTTP(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+			}
+
+			var response api.BackupStatus
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.checkFunc(t, response)
+		})
+	}
+}
+
+// mockHealthChecker はテスト用のモックチェッカー
+type mockHealthChecker struct {
+	name   string
+	detail string
+	err    error
+}
+
+func (m *mockHealthChecker) Name() string {
+	return m.name
+}
+
+func (m *mockHealthChecker) Check(ctx context.Context) (string, error) {
+	return m.detail, m.err
+}
+
+func TestGetV3HealthLive(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// 依存コンポーネントが利用できなくても応答する
+	healthService := application.NewHealthService(&mockHealthChecker{name: "database", err: context.DeadlineExceeded})
+	categoryService := application.NewCategoryService(&mockCategoryRepository{})
+	recordService := application.NewRecordService(&mockRecordRepository{}, &mockCategoryRepository{categories: testCategories})
+	server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", &config.DBInfo{}, categoryService, recordService, healthService, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v3/health/live", nil)
+	server.router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	var response api.Health
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if response.Status != api.Ok {
+		t.Errorf("expected status ok, got %s", response.Status)
+	}
+}
+
+func TestGetV3HealthReady(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		checkers       []*mockHealthChecker
+		shuttingDown   bool
+		expectedStatus int
+		checkFunc      func(t *testing.T, response api.Health)
+	}{
+		{
+			name: "正常系: 全てのコンポーネントが利用可能",
+			checkers: []*mockHealthChecker{
+				{name: "database", detail: "mysql"},
+				{name: "schema", detail: "007_add_record_history.sql"},
+			},
+			expectedStatus: http.StatusOK,
+			checkFunc: func(t *testing.T, response api.Health) {
+				if response.Status != api.Ok || response.Components == nil || len(*response.Components) != 3 {
+					t.Fatalf("unexpected response: %+v", response)
+				}
+				components := *response.Components
+				if components[0].Name != "server" || components[1].Name != "database" || components[2].Name != "schema" {
+					t.Errorf("unexpected components: %+v", components)
+				}
+				if components[2].Detail == nil || *components[2].Detail != "007_add_record_history.sql" {
+					t.Errorf("expected schema version in detail, got %v", components[2].Detail)
+				}
+				if components[1].LatencyMs == nil || components[1].Error != nil {
+					t.Errorf("unexpected database component: %+v", components[1])
+				}
+			},
+		},
+		{
+			name: "異常系: データベースに接続できない",
+			checkers: []*mockHealthChecker{
+				{name: "database", err: context.DeadlineExceeded},
+				{name: "schema", detail: "007_add_record_history.sql"},
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			checkFunc: func(t *testing.T, response api.Health) {
+				if response.Status != api.Unavailable {
+					t.Errorf("expected status unavailable, got %s", response.Status)
+				}
+				database := (*response.Components)[1]
+				if database.Status != api.Unavailable || database.Error == nil || *database.Error != context.DeadlineExceeded.Error() {
+					t.Errorf("unexpected database component: %+v", database)
+				}
+				if (*response.Components)[2].Status != api.Ok {
+					t.Errorf("expected schema ok, got %+v", (*response.Components)[2])
+				}
+			},
+		},
+		{
+			name:           "異常系: シャットダウン中",
+			checkers:       []*mockHealthChecker{{name: "database", detail: "mysql"}},
+			shuttingDown:   true,
+			expectedStatus: http.StatusServiceUnavailable,
+			checkFunc: func(t *testing.T, response api.Health) {
+				server := (*response.Components)[0]
+				if server.Status != api.Unavailable || server.Error == nil || *server.Error != "shutting down" {
+					t.Errorf("unexpected server component: %+v", server)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var checkers []domain.HealthChecker
+			for _, c := range tt.checkers {
+				checkers = append(checkers, c)
+			}
+			healthService := application.NewHealthService(checkers...)
+			categoryService := application.NewCategoryService(&mockCategoryRepository{})
+			recordService := application.NewRecordService(&mockRecordRepository{}, &mockCategoryRepository{categories: testCategories})
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", &config.DBInfo{}, categoryService, recordService, healthService, nil)
+			server.shuttingDown.Store(tt.shuttingDown)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/api/v3/health/ready", nil)
+			server.router.ServeHTTP(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status code %d, got %d", tt.expectedStatus, w.Code)
+			}
+			var response api.Health
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.checkFunc(t, response)
+		})
+	}
+}
+
+func TestPutV3AdminLogLevel(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		body           string
+		noLogLevel     bool
+		expectedStatus int
+		expectedLevel  slog.Level
+	}{
+		{
+			name:           "正常系: ログレベルを変更できる",
+			body:           `{"level": "debug"}`,
+			expectedStatus: http.StatusOK,
+			expectedLevel:  slog.LevelDebug,
+		},
+		{
+			name:           "異常系: 不正なログレベル",
+			body:           `{"level": "verbose"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedLevel:  slog.LevelWarn,
+		},
+		{
+			name:           "異常系: 不正なリクエストボディ",
+			body:           `{"level": 1}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedLevel:  slog.LevelWarn,
+		},
+		{
+			name:           "異常系: ログレベルが設定されていない",
+			body:           `{"level": "debug"}`,
+			noLogLevel:     true,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			level := new(slog.LevelVar)
+			level.Set(slog.LevelWarn)
+			var opts []ServerOption
+			if !tt.noLogLevel {
+				opts = append(opts, WithLogLevel(level))
+			}
+			categoryService := application.NewCategoryService(&mockCategoryRepository{})
+			recordService := application.NewRecordService(&mockRecordRepository{}, &mockCategoryRepository{categories: testCategories})
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", &config.DBInfo{}, categoryService, recordService, nil, nil, opts...)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("PUT", "/api/v3/admin/log-level", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			server.router.ServeHTTP(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status code %d, got %d", tt.expectedStatus, w.Code)
+			}
+			if tt.noLogLevel {
+				return
+			}
+			if level.Level() != tt.expectedLevel {
+				t.Errorf("expected level %v, got %v", tt.expectedLevel, level.Level())
+			}
+
+			// 変更後のレベルを取得できる
+			w = httptest.NewRecorder()
+			req, _ = http.NewRequest("GET", "/api/v3/admin/log-level", nil)
+			server.router.ServeHTTP(w, req)
+
+			var response api.LogSetting
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			if want := api.LogLevel(strings.ToLower(tt.expectedLevel.String())); response.Level != want {
+				t.Errorf("expected level %q, got %q", want, response.Level)
+			}
+		})
+	}
+}
+
+func TestGetV3RecordSummaryPeriod(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		path           string
+		wantStatusCode int
+		wantPeriod     string // 期間の初日〜最終日
+		wantBuckets    int
+	}{
+		{
+			name:           "正常系: 暦年を四半期で区切る",
+			path:           "/api/v3/record/summary/calendar/2025?bucket=quarter",
+			wantStatusCode: http.StatusOK,
+			wantPeriod:     "20250101-20251231",
+			wantBuckets:    4,
+		},
+		{
+			name:           "正常系: 暦年の区切りを省略した場合は月",
+			path:           "/api/v3/record/summary/calendar/2025",
+			wantStatusCode: http.StatusOK,
+			wantPeriod:     "20250101-20251231",
+			wantBuckets:    12,
+		},
+		{
+			name:           "正常系: 最終日を含む任意の期間",
+			path:           "/api/v3/record/summary/range?from=20251015&to=20251031&bucket=week",
+			wantStatusCode: http.StatusOK,
+			wantPeriod:     "20251015-20251031",
+			wantBuckets:    3,
+		},
+		{
+			name:           "異常系: 存在しない日付",
+			path:           "/api/v3/record/summary/range?from=20250231&to=20250331",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: 最終日が初日より前",
+			path:           "/api/v3/record/summary/range?from=20250331&to=20250301",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: 区切りの数が上限を超える",
+			path:           "/api/v3/record/summary/range?from=20240101&to=20251231&bucket=day",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: 未定義の区切る単位",
+			path:           "/api/v3/record/summary/calendar/2025?bucket=year",
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recordRepo := &mockRecordRepository{
+				periodFunc: func(ctx context.Context, period domain.SummaryPeriod) ([]*domain.CategoryPeriodSummary, error) {
+					price := make([]int, len(period.Buckets()))
+					price[0] = 1000
+					return []*domain.CategoryPeriodSummary{
+						{CategoryID: 210, CategoryName: "食費", CategoryType: domain.CategoryTypeOutgoing, Count: 1, Price: price, Total: 1000},
+					}, nil
+				},
+			}
+			categoryService := application.NewCategoryService(&mockCategoryRepository{})
+			recordService := application.NewRecordService(recordRepo, &mockCategoryRepository{})
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", &config.DBInfo{}, categoryService, recordService, nil, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			server.router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Fatalf("expected status code %d, got %d: %s", tt.wantStatusCode, w.Code, w.Body.String())
+			}
+			if tt.wantStatusCode != http.StatusOK {
+				return
+			}
+			var got api.PeriodSummary
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			if got.From+"-"+got.To != tt.wantPeriod || len(got.Buckets) != tt.wantBuckets {
+				t.Errorf("unexpected period: %s", w.Body.String())
+			}
+			if got.Buckets[0].From != got.From || got.Buckets[len(got.Buckets)-1].To != got.To {
+				t.Errorf("buckets must cover the period: %+v", got.Buckets)
+			}
+			if len(got.Categories) != 1 || len(got.Categories[0].Price) != tt.wantBuckets || got.Categories[0].Total != 1000 {
+				t.Errorf("unexpected categories: %+v", got.Categories)
+			}
+		})
+	}
+}
+
+func TestGetV3RecordCompare(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// モックは年度によらず同じサマリーを返すため、同じ月どうしの比較は差額 0 になる
+	summaries := []*domain.CategoryYearSummary{
+		{CategoryID: 210, CategoryName: "食費", CategoryType: domain.CategoryTypeOutgoing, Count: 2, Price: [12]int{6: 48000, 7: 52000}, Total: 100000},
+	}
+
+	tests := []struct {
+		name           string
+		path           string
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name:           "正常系: 月を比較する",
+			path:           "/api/v3/record/compare/month/202511?with=202510",
+			wantStatusCode: http.StatusOK,
+			wantBody: `{"categories":[{"category_id":210,"category_name":"食費","category_type":"outgoing","comparison":48000,"current":52000,"difference":4000,"percentage":8.3}],` +
+				`"category_types":[{"category_type":"income","comparison":0,"current":0,"difference":0},` +
+				`{"category_type":"outgoing","comparison":48000,"current":52000,"difference":4000,"percentage":8.3},` +
+				`{"category_type":"saving","comparison":0,"current":0,"difference":0},` +
+				`{"category_type":"investing","comparison":0,"current":0,"difference":0}],"comparison":"202510","current":"202511"}`,
+		},
+		{
+			name:           "正常系: 省略した場合は前年度と比較する",
+			path:           "/api/v3/record/compare/year/2025",
+			wantStatusCode: http.StatusOK,
+			wantBody: `{"categories":[{"category_id":210,"category_name":"食費","category_type":"outgoing","comparison":100000,"current":100000,"difference":0,"percentage":0}],` +
+				`"category_types":[{"category_type":"income","comparison":0,"current":0,"difference":0},` +
+				`{"category_type":"outgoing","comparison":100000,"current":100000,"difference":0,"percentage":0},` +
+				`{"category_type":"saving","comparison":0,"current":0,"difference":0},` +
+				`{"category_type":"investing","comparison":0,"current":0,"difference":0}],"comparison":"2024","current":"2025"}`,
+		},
+		{
+			name:           "異常系: 存在しない月",
+			path:           "/api/v3/record/compare/month/202513",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: 比較する月の形式が不正",
+			path:           "/api/v3/record/compare/month/202510?with=2024-10",
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recordRepo := &mockRecordRepository{summaries: summaries}
+			categoryService := application.NewCategoryService(&mockCategoryRepository{})
+			recordService := application.NewRecordService(recordRepo, &mockCategoryRepository{})
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", &config.DBInfo{}, categoryService, recordService, nil, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			server.router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Fatalf("expected status code %d, got %d: %s", tt.wantStatusCode, w.Code, w.Body.String())
+			}
+			if tt.wantBody != "" && w.Body.String() != tt.wantBody {
+				t.Errorf("unexpected response:\n%s\nwant:\n%s", w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestGetV3CategoriesSuggest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	records := []*domain.Record{
+		{ID: 3, CategoryID: 210, Memo: "コンビニ", From: "card", Price: 480},
+		{ID: 2, CategoryID: 210, Memo: "スーパー", From: "card", Price: 3200},
+		{ID: 1, CategoryID: 100, Memo: "給与", From: "bank", Price: 250000},
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		withService    bool
+		wantStatusCode int
+		wantCategories []int
+	}{
+		{
+			name:           "正常系: 確からしい順に候補を返す",
+			query:          "memo=コンビニ&from=card&price=500",
+			withService:    true,
+			wantStatusCode: http.StatusOK,
+			wantCategories: []int{210, 100},
+		},
+		{
+			name:           "正常系: 件数を制限する",
+			query:          "memo=給与&limit=1",
+			withService:    true,
+			wantStatusCode: http.StatusOK,
+			wantCategories: []int{100},
+		},
+		{
+			name:           "正常系: サービスを指定しない場合は候補を返さない",
+			query:          "memo=コンビニ",
+			wantStatusCode: http.StatusOK,
+			wantCategories: []int{},
+		},
+		{
+			name:           "異常系: メモがない",
+			query:          "from=card",
+			withService:    true,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "異常系: 件数が上限を超える",
+			query:          "memo=コンビニ&limit=11",
+			withService:    true,
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recordRepo := &mockRecordRepository{
+				findAllFunc: func(ctx context.Context, num, offset int, yyyymm string, categoryID int) ([]*domain.Record, error) {
+					return records, nil
+				},
+			}
+			categoryRepo := &mockCategoryRepository{categories: testCategories}
+			var opts []ServerOption
+			if tt.withService {
+				opts = append(opts, WithSuggestionService(application.NewSuggestionService(recordRepo, categoryRepo)))
+			}
+			categoryService := application.NewCategoryService(categoryRepo)
+			recordService := application.NewRecordService(recordRepo, categoryRepo)
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", &config.DBInfo{}, categoryService, recordService, nil, nil, opts...)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/api/v3/categories/suggest?"+tt.query, nil)
+			server.router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Fatalf("expected status code %d, got %d: %s", tt.wantStatusCode, w.Code, w.Body.String())
+			}
+			if tt.wantStatusCode != http.StatusOK {
+				return
+			}
+			var got api.CategorySuggestions
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			if len(got.Suggestions) != len(tt.wantCategories) {
+				t.Fatalf("unexpected suggestions: %s", w.Body.String())
+			}
+			for i, sg := range got.Suggestions {
+				if sg.CategoryId != tt.wantCategories[i] {
+					t.Errorf("suggestions[%d] = %+v, want category %d", i, sg, tt.wantCategories[i])
+				}
+			}
+		})
+	}
+}
+
+// intPtr は int のポインタを返す
+func intPtr(v int) *int {
+	return &v
+}
@@ -0,0 +1,197 @@
+package middleware
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/azuki774/mawinter/internal/domain"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gin-gonic/gin"
+)
+
+// OpenAPIValidatorOptions は OpenAPIValidator の設定
+type OpenAPIValidatorOptions struct {
+	// BaseURL は仕様のパスの前に付くプレフィックス（api.GinServerOptions.BaseURL と同じ値）
+	BaseURL string
+	// ValidateResponses はレスポンスも仕様に従っているかを検証する
+	// レスポンスをバッファするため、テストでの使用を想定している
+	ValidateResponses bool
+	// ErrorHandler は検証に失敗した場合に呼び出される
+	// リクエストの違反は *domain.ValidationError、レスポンスの違反はそれ以外のエラーとして渡す
+	ErrorHandler func(c *gin.Context, err error)
+}
+
+// specRoute は Gin のルートに対応する仕様上のオペレーション
+type specRoute struct {
+	path      string
+	pathItem  *openapi3.PathItem
+	operation *openapi3.Operation
+}
+
+// pathParamPattern は仕様のパスパラメータ（{id}）
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// OpenAPIValidator は、リクエストを OpenAPI の仕様で検証する Gin ミドルウェアです。
+// ルーティング済みのパス（c.FullPath）から仕様のオペレーションを特定するため、
+// 仕様に定義されていないルート（/metrics など）と存在しないパスは検証しません。
+// 違反は項目ごとにまとめて ErrorHandler に渡し、以降のハンドラは実行しません。
+func OpenAPIValidator(spec *openapi3.T, opts OpenAPIValidatorOptions) gin.HandlerFunc {
+	// Gin のルート（GET /api/v3/record/:id）とオペレーションの対応を作成
+	specRoutes := make(map[string]specRoute)
+	for path, pathItem := range spec.Paths.Map() {
+		ginPath := opts.BaseURL + pathParamPattern.ReplaceAllString(path, ":$1")
+		for method, operation := range pathItem.Operations() {
+			specRoutes[method+" "+ginPath] = specRoute{path: path, pathItem: pathItem, operation: operation}
+		}
+	}
+
+	return func(c *gin.Context) {
+		sr, ok := specRoutes[c.Request.Method+" "+c.FullPath()]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		pathParams := make(map[string]string, len(c.Params))
+		for _, p := range c.Params {
+			pathParams[p.Key] = p.Value
+		}
+
+		// Content-Type を省略したボディは JSON として扱う（ShouldBindJSON と同じ）
+		if c.Request.ContentLength != 0 && c.Request.Header.Get("Content-Type") == "" {
+			c.Request.Header.Set("Content-Type", "application/json")
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    c.Request,
+			PathParams: pathParams,
+			Route: &routers.Route{
+				Spec:      spec,
+				Path:      sr.path,
+				PathItem:  sr.pathItem,
+				Method:    c.Request.Method,
+				Operation: sr.operation,
+			},
+			Options: &openapi3filter.Options{MultiError: true},
+		}
+		if err := openapi3filter.ValidateRequest(c.Request.Context(), input); err != nil {
+			opts.ErrorHandler(c, toValidationError(err))
+			c.Abort()
+			return
+		}
+
+		if !opts.ValidateResponses {
+			c.Next()
+			return
+		}
+
+		// ハンドラのレスポンスをバッファし、検証してから書き込む
+		original := c.Writer
+		buffered := &bufferedWriter{ResponseWriter: original, status: http.StatusOK}
+		c.Writer = buffered
+		c.Next()
+		c.Writer = original
+
+		err := openapi3filter.ValidateResponse(c.Request.Context(), &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: input,
+			Status:                 buffered.status,
+			Header:                 original.Header(),
+			Body:                   io.NopCloser(bytes.NewReader(buffered.body.Bytes())),
+			Options:                &openapi3filter.Options{MultiError: true},
+		})
+		if err != nil {
+			opts.ErrorHandler(c, fmt.Errorf("response does not match the API specification: %w", err))
+			return
+		}
+
+		original.WriteHeader(buffered.status)
+		original.Write(buffered.body.Bytes())
+	}
+}
+
+// toValidationError は kin-openapi の検証エラーを項目ごとの違反に変換する
+// 項目名はパラメータ名、またはボディ内の位置（例: price）とする
+func toValidationError(err error) *domain.ValidationError {
+	verr := &domain.ValidationError{}
+	addViolations(verr, "", err)
+	if len(verr.Fields) == 0 {
+		verr.Add("body", err.Error())
+	}
+	return verr
+}
+
+func addViolations(verr *domain.ValidationError, field string, err error) {
+	// MultiError は errors.As で内部のエラーにも一致するため、型で判定する
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, inner := range e {
+			addViolations(verr, field, inner)
+		}
+	case *openapi3filter.RequestError:
+		switch {
+		case e.Parameter != nil:
+			field = e.Parameter.Name
+		case e.RequestBody != nil:
+			field = "body"
+		}
+		if e.Err == nil {
+			verr.Add(field, e.Reason)
+			return
+		}
+		addViolations(verr, field, e.Err)
+	case *openapi3.SchemaError:
+		if ptr := e.JSONPointer(); len(ptr) > 0 && field == "body" {
+			field = strings.Join(ptr, ".")
+		}
+		verr.Add(field, e.Reason)
+	case *openapi3filter.ParseError:
+		if field == "body" {
+			verr.Add(field, "must be valid JSON")
+			return
+		}
+		verr.Add(field, e.Reason)
+	default:
+		verr.Add(field, err.Error())
+	}
+}
+
+// bufferedWriter はレスポンスを下位の ResponseWriter に書き込まずに保持する
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	if code > 0 {
+		w.status = code
+	}
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedWriter) Written() bool {
+	return w.body.Len() > 0
+}
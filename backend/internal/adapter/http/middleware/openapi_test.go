@@ -0,0 +1,208 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/azuki774/mawinter/internal/domain"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+)
+
+const testSpec = `
+openapi: 3.0.3
+info:
+  title: test
+  version: 1.0.0
+paths:
+  /items/{id}:
+    get:
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: integer
+        - name: q
+          in: query
+          schema:
+            type: string
+            pattern: '^[0-9]{6}$'
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  name:
+                    type: string
+                required:
+                  - name
+  /items:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                price:
+                  type: integer
+                  minimum: 0
+                memo:
+                  type: string
+              required:
+                - price
+      responses:
+        '201':
+          description: Created
+`
+
+func TestOpenAPIValidator(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	spec, err := openapi3.NewLoader().LoadFromData([]byte(testSpec))
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	tests := []struct {
+		name              string
+		method            string
+		path              string
+		body              string
+		contentType       string
+		validateResponses bool
+		response          gin.H // ハンドラが返すレスポンス
+		wantStatus        int
+		wantFields        []string // リクエストの違反として渡される項目
+		wantResponseErr   bool     // レスポンスの違反として ErrorHandler が呼ばれるか
+	}{
+		{
+			name:       "正常系: 仕様に従ったリクエスト",
+			method:     "GET",
+			path:       "/api/items/1?q=202501",
+			response:   gin.H{"name": "a"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "異常系: パスパラメータとクエリパラメータの違反",
+			method:     "GET",
+			path:       "/api/items/abc?q=2025",
+			wantStatus: http.StatusBadRequest,
+			wantFields: []string{"id", "q"},
+		},
+		{
+			name:       "異常系: ボディの違反は項目ごとに返す",
+			method:     "POST",
+			path:       "/api/items",
+			body:       `{"price": -1, "memo": 1}`,
+			wantStatus: http.StatusBadRequest,
+			wantFields: []string{"memo", "price"},
+		},
+		{
+			name:       "異常系: 必須の項目がない",
+			method:     "POST",
+			path:       "/api/items",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantFields: []string{"price"},
+		},
+		{
+			name:        "正常系: Content-Type を省略したボディは JSON として扱う",
+			method:      "POST",
+			path:        "/api/items",
+			body:        `{"price": 0}`,
+			contentType: "-",
+			wantStatus:  http.StatusCreated,
+		},
+		{
+			name:       "正常系: 仕様にないルートは検証しない",
+			method:     "GET",
+			path:       "/metrics?q=abc",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:              "正常系: 仕様に従ったレスポンス",
+			method:            "GET",
+			path:              "/api/items/1",
+			validateResponses: true,
+			response:          gin.H{"name": "a"},
+			wantStatus:        http.StatusOK,
+		},
+		{
+			name:              "異常系: 仕様に違反するレスポンス",
+			method:            "GET",
+			path:              "/api/items/1",
+			validateResponses: true,
+			response:          gin.H{"name": 1},
+			wantStatus:        http.StatusInternalServerError,
+			wantResponseErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handled error
+			router := gin.New()
+			router.Use(OpenAPIValidator(spec, OpenAPIValidatorOptions{
+				BaseURL:           "/api",
+				ValidateResponses: tt.validateResponses,
+				ErrorHandler: func(c *gin.Context, err error) {
+					handled = err
+					status := http.StatusInternalServerError
+					if errors.Is(err, domain.ErrValidation) {
+						status = http.StatusBadRequest
+					}
+					c.AbortWithStatus(status)
+				},
+			}))
+			router.GET("/api/items/:id", func(c *gin.Context) {
+				c.JSON(http.StatusOK, tt.response)
+			})
+			router.POST("/api/items", func(c *gin.Context) {
+				c.Status(http.StatusCreated)
+			})
+			router.GET("/metrics", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			switch tt.contentType {
+			case "":
+				req.Header.Set("Content-Type", "application/json")
+			case "-":
+			default:
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status code %d, got %d: %v", tt.wantStatus, w.Code, handled)
+			}
+
+			var validationErr *domain.ValidationError
+			var fields []string
+			if errors.As(handled, &validationErr) {
+				for _, f := range validationErr.Fields {
+					fields = append(fields, f.Field)
+				}
+			}
+			if strings.Join(fields, ",") != strings.Join(tt.wantFields, ",") {
+				t.Errorf("expected field errors %v, got %v (%v)", tt.wantFields, fields, handled)
+			}
+			if gotResponseErr := handled != nil && validationErr == nil; gotResponseErr != tt.wantResponseErr {
+				t.Errorf("expected response error %v, got %v", tt.wantResponseErr, handled)
+			}
+			if tt.wantStatus == http.StatusOK && tt.response != nil && !strings.Contains(w.Body.String(), `"name"`) {
+				t.Errorf("expected response body to be written, got %q", w.Body.String())
+			}
+		})
+	}
+}
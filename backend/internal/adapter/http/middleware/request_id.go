@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"github.com/azuki774/mawinter/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIDHeader はリクエスト ID を受け渡す HTTP ヘッダー
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength はクライアントから受け付けるリクエスト ID の最大長
+const maxRequestIDLength = 128
+
+// RequestID は、リクエストごとに ID を割り当てる Gin ミドルウェアです。
+// クライアントが X-Request-ID を指定した場合はその値を引き継ぎ、指定がない（または不正な）場合は UUID を生成します。
+// ID はレスポンスヘッダーで返却し、ログに出力できるようリクエストのコンテキストと実行中のスパンにも設定します。
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !validRequestID(id) {
+			id = uuid.NewString()
+		}
+
+		ctx := logger.WithRequestID(c.Request.Context(), id)
+		c.Request = c.Request.WithContext(ctx)
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("http.request.id", id))
+		c.Header(RequestIDHeader, id)
+
+		c.Next()
+	}
+}
+
+// validRequestID は、クライアントから受け取ったリクエスト ID をそのまま使えるか判定します。
+// ログやヘッダーへの混入を防ぐため、表示可能な ASCII 文字のみを許可します。
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/azuki774/mawinter/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func TestRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		header   string
+		wantSame bool // 受け取った ID をそのまま使うか
+	}{
+		{name: "指定された ID を引き継ぐ", header: "abc-123", wantSame: true},
+		{name: "指定がなければ生成する", header: ""},
+		{name: "長すぎる ID は生成し直す", header: strings.Repeat("a", maxRequestIDLength+1)},
+		{name: "制御文字を含む ID は生成し直す", header: "abc\tdef"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ctxID string
+			router := gin.New()
+			router.Use(RequestID())
+			router.GET("/", func(c *gin.Context) {
+				ctxID = logger.RequestIDFromContext(c.Request.Context())
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/", nil)
+			if tt.header != "" {
+				req.Header.Set(RequestIDHeader, tt.header)
+			}
+			router.ServeHTTP(w, req)
+
+			got := w.Header().Get(RequestIDHeader)
+			if got != ctxID {
+				t.Errorf("response header %q does not match context %q", got, ctxID)
+			}
+			if tt.wantSame {
+				if got != tt.header {
+					t.Errorf("request ID = %q, want %q", got, tt.header)
+				}
+				return
+			}
+			if _, err := uuid.Parse(got); err != nil {
+				t.Errorf("expected generated UUID, got %q", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/azuki774/mawinter/api"
+	"github.com/azuki774/mawinter/internal/domain"
+	"github.com/azuki774/mawinter/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ProblemContentType はエラーレスポンス（RFC 7807）の Content-Type
+const ProblemContentType = "application/problem+json"
+
+// エラーの種類を表す URI（problem の type）
+// 種類を持たない HTTP のエラーは ProblemTypeBlank とし、title に HTTP のステータスを使用する
+const (
+	ProblemTypeBlank       = "about:blank"
+	ProblemTypeNotFound    = "urn:mawinter:problem:not-found"
+	ProblemTypeValidation  = "urn:mawinter:problem:validation"
+	ProblemTypeConflict    = "urn:mawinter:problem:conflict"
+	ProblemTypeMonthLocked = "urn:mawinter:problem:month-locked"
+)
+
+// problemKind はドメインエラーの種類ごとのレスポンスの内容
+type problemKind struct {
+	err    error // 対応するドメインのセンチネルエラー
+	status int
+	typ    string
+	title  string
+}
+
+// problemKinds はドメインエラーと HTTP ステータスの対応
+// 確定済みの月は他の競合と区別できるよう 423 Locked を返す
+var problemKinds = []problemKind{
+	{err: domain.ErrValidation, status: http.StatusBadRequest, typ: ProblemTypeValidation, title: "Validation failed"},
+	{err: domain.ErrNotFound, status: http.StatusNotFound, typ: ProblemTypeNotFound, title: "Resource not found"},
+	{err: domain.ErrConflict, status: http.StatusConflict, typ: ProblemTypeConflict, title: "Conflict with the current state"},
+	{err: domain.ErrMonthLocked, status: http.StatusLocked, typ: ProblemTypeMonthLocked, title: "Month is locked"},
+}
+
+// writeError は err の種類に応じたステータスコードの problem+json を返し、ログに記録する
+// ドメインエラー以外はサーバ側のエラーとして 500 を返す。内部の詳細は返さず、detail には fallback を使用する
+// クライアント側のエラーは Warn、サーバ側のエラーは Error で記録する
+func writeError(c *gin.Context, msg string, err error, fallback string, attrs ...any) {
+	ctx := c.Request.Context()
+	attrs = append(attrs, slog.String("error", err.Error()))
+
+	for _, kind := range problemKinds {
+		if !errors.Is(err, kind.err) {
+			continue
+		}
+		slog.WarnContext(ctx, msg, attrs...)
+
+		problem := newProblem(c, kind.status, err.Error())
+		problem.Type = kind.typ
+		problem.Title = kind.title
+		var validationErr *domain.ValidationError
+		if errors.As(err, &validationErr) {
+			problem.Errors = toAPIFieldErrors(validationErr.Fields)
+		}
+		writeProblem(c, problem)
+		return
+	}
+
+	slog.ErrorContext(ctx, msg, attrs...)
+	writeProblem(c, newProblem(c, http.StatusInternalServerError, fallback))
+}
+
+// writeStatusProblem は種類を持たない HTTP のエラー（about:blank）を返す
+func writeStatusProblem(c *gin.Context, status int, detail string) {
+	writeProblem(c, newProblem(c, status, detail))
+}
+
+// newProblem は about:blank の problem を生成する
+func newProblem(c *gin.Context, status int, detail string) api.Problem {
+	problem := api.Problem{
+		Type:     ProblemTypeBlank,
+		Title:    http.StatusText(status),
+		Status:   status,
+		Instance: stringPtr(c.Request.URL.Path),
+	}
+	if detail != "" {
+		problem.Detail = stringPtr(detail)
+	}
+	if id := logger.RequestIDFromContext(c.Request.Context()); id != "" {
+		problem.RequestId = stringPtr(id)
+	}
+	return problem
+}
+
+// writeProblem は problem を application/problem+json で返し、以降のハンドラを実行しない
+func writeProblem(c *gin.Context, problem api.Problem) {
+	c.Header("Content-Type", ProblemContentType)
+	c.AbortWithStatusJSON(problem.Status, problem)
+}
+
+// toAPIFieldErrors は項目ごとの検証エラーをAPIレスポンス型に変換する
+func toAPIFieldErrors(fields []domain.FieldError) *[]api.FieldError {
+	errs := make([]api.FieldError, len(fields))
+	for i, f := range fields {
+		errs[i] = api.FieldError{Field: f.Field, Message: f.Message}
+	}
+	return &errs
+}
+
+// handleParamError は OpenAPI の定義に従ったパラメータの変換に失敗した場合のエラーを返す
+// api.GinServerOptions.ErrorHandler に指定する
+func handleParamError(c *gin.Context, err error, status int) {
+	slog.WarnContext(c.Request.Context(), "Invalid request parameter", slog.String("error", err.Error()))
+	writeStatusProblem(c, status, err.Error())
+}
+
+// handleSpecError は OpenAPI の仕様による検証に失敗した場合のエラーを返す
+// リクエストの違反は項目ごとの 400、レスポンスの違反はサーバ側の誤りとして 500 を返す
+func handleSpecError(c *gin.Context, err error) {
+	writeError(c, "OpenAPI validation failed", err, "response does not match the API specification")
+}
+
+// handleNoRoute は存在しないパスへのリクエストに 404 を返す
+func handleNoRoute(c *gin.Context) {
+	writeStatusProblem(c, http.StatusNotFound, "no route for "+c.Request.Method+" "+c.Request.URL.Path)
+}
+
+// handlePanic は panic から回復した場合に 500 を返す
+// gin.CustomRecovery に指定する。panic の内容はレスポンスに含めない
+func handlePanic(c *gin.Context, recovered any) {
+	slog.ErrorContext(c.Request.Context(), "Recovered from panic", slog.Any("panic", recovered))
+	writeStatusProblem(c, http.StatusInternalServerError, "internal server error")
+}
@@ -0,0 +1,193 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/azuki774/mawinter/api"
+	"github.com/azuki774/mawinter/internal/application"
+	"github.com/azuki774/mawinter/internal/domain"
+	"github.com/azuki774/mawinter/pkg/config"
+	"github.com/gin-gonic/gin"
+)
+
+func TestProblemResponses(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		mockRepo     *mockRecordRepository
+		wantStatus   int
+		wantType     string
+		wantDetail   string
+		wantFields   []string
+		hideInternal string // レスポンスに含めてはならない内部のエラー
+	}{
+		{
+			name:   "異常系: 存在しないレコードは not-found",
+			method: "GET",
+			path:   "/api/v3/record/999",
+			mockRepo: &mockRecordRepository{
+				findByIDFunc: func(ctx context.Context, id int) (*domain.Record, error) {
+					return nil, domain.NewNotFoundError("record", id)
+				},
+			},
+			wantStatus: http.StatusNotFound,
+			wantType:   ProblemTypeNotFound,
+			wantDetail: "record not found: 999",
+		},
+		{
+			name:   "異常系: 検証エラーは項目ごとの詳細を返す",
+			method: "GET",
+			path:   "/api/v3/record?yyyymm=202413",
+			mockRepo: &mockRecordRepository{
+				findAllFunc: func(ctx context.Context, num, offset int, yyyymm string, categoryID int) ([]*domain.Record, error) {
+					verr := domain.NewValidationError("yyyymm", "must be in YYYYMM format")
+					verr.Add("category_id", "must be positive")
+					return nil, verr
+				},
+			},
+			wantStatus: http.StatusBadRequest,
+			wantType:   ProblemTypeValidation,
+			wantFields: []string{"yyyymm", "category_id"},
+		},
+		{
+			name:       "異常系: 型が一致しない項目は検証エラー",
+			method:     "POST",
+			path:       "/api/v3/record",
+			body:       `{"category_id": "abc", "price": 100}`,
+			mockRepo:   &mockRecordRepository{},
+			wantStatus: http.StatusBadRequest,
+			wantType:   ProblemTypeValidation,
+			wantFields: []string{"category_id"},
+		},
+		{
+			name:       "異常系: JSON として不正なボディ",
+			method:     "POST",
+			path:       "/api/v3/record",
+			body:       `{`,
+			mockRepo:   &mockRecordRepository{},
+			wantStatus: http.StatusBadRequest,
+			wantType:   ProblemTypeValidation,
+			wantDetail: "validation failed: body: must be valid JSON",
+			wantFields: []string{"body"},
+		},
+		{
+			name:       "異常系: 負の金額は仕様の検証エラー",
+			method:     "POST",
+			path:       "/api/v3/record",
+			body:       `{"category_id": 100, "price": -1}`,
+			mockRepo:   &mockRecordRepository{},
+			wantStatus: http.StatusBadRequest,
+			wantType:   ProblemTypeValidation,
+			wantFields: []string{"price"},
+		},
+		{
+			name:       "異常系: 仕様の違反はまとめて返す",
+			method:     "PUT",
+			path:       "/api/v3/record/1",
+			body:       `{"category_id": "abc", "memo": 1}`,
+			mockRepo:   &mockRecordRepository{},
+			wantStatus: http.StatusBadRequest,
+			wantType:   ProblemTypeValidation,
+			wantFields: []string{"category_id", "memo", "price"},
+		},
+		{
+			name:       "異常系: 件数の category_id が整数でない",
+			method:     "GET",
+			path:       "/api/v3/record/count?category_id=abc",
+			mockRepo:   &mockRecordRepository{},
+			wantStatus: http.StatusBadRequest,
+			wantType:   ProblemTypeValidation,
+			wantFields: []string{"category_id"},
+		},
+		{
+			name:   "異常系: サーバ側のエラーは内部の詳細を返さない",
+			method: "GET",
+			path:   "/api/v3/record",
+			mockRepo: &mockRecordRepository{
+				findAllFunc: func(ctx context.Context, num, offset int, yyyymm string, categoryID int) ([]*domain.Record, error) {
+					return nil, context.DeadlineExceeded
+				},
+			},
+			wantStatus:   http.StatusInternalServerError,
+			wantType:     ProblemTypeBlank,
+			wantDetail:   "failed to get records",
+			hideInternal: context.DeadlineExceeded.Error(),
+		},
+		{
+			name:       "異常系: パスパラメータの形式が不正",
+			method:     "GET",
+			path:       "/api/v3/record/abc",
+			mockRepo:   &mockRecordRepository{},
+			wantStatus: http.StatusBadRequest,
+			wantType:   ProblemTypeValidation,
+			wantFields: []string{"id"},
+		},
+		{
+			name:       "異常系: 存在しないパス",
+			method:     "GET",
+			path:       "/api/v3/unknown",
+			mockRepo:   &mockRecordRepository{},
+			wantStatus: http.StatusNotFound,
+			wantType:   ProblemTypeBlank,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categoryService := application.NewCategoryService(&mockCategoryRepository{})
+			recordService := application.NewRecordService(tt.mockRepo, &mockCategoryRepository{categories: testCategories})
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", &config.DBInfo{}, categoryService, recordService, nil, nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			server.router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status code %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, ProblemContentType) {
+				t.Errorf("expected Content-Type %s, got %s", ProblemContentType, ct)
+			}
+
+			var problem api.Problem
+			if err := json.Unmarshal(w.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			if problem.Status != tt.wantStatus || problem.Type != tt.wantType || problem.Title == "" {
+				t.Errorf("unexpected problem: %+v", problem)
+			}
+			if problem.Instance == nil || !strings.HasPrefix(tt.path, *problem.Instance) {
+				t.Errorf("expected instance to be the request path, got %v", problem.Instance)
+			}
+			if problem.RequestId == nil || *problem.RequestId != w.Header().Get("X-Request-ID") {
+				t.Errorf("expected request_id to match X-Request-ID, got %v", problem.RequestId)
+			}
+			if tt.wantDetail != "" && (problem.Detail == nil || *problem.Detail != tt.wantDetail) {
+				t.Errorf("expected detail %q, got %v", tt.wantDetail, problem.Detail)
+			}
+			if tt.hideInternal != "" && strings.Contains(w.Body.String(), tt.hideInternal) {
+				t.Errorf("response must not contain internal error %q: %s", tt.hideInternal, w.Body.String())
+			}
+
+			var fields []string
+			if problem.Errors != nil {
+				for _, f := range *problem.Errors {
+					fields = append(fields, f.Field)
+				}
+			}
+			if strings.Join(fields, ",") != strings.Join(tt.wantFields, ",") {
+				t.Errorf("expected field errors %v, got %v", tt.wantFields, fields)
+			}
+		})
+	}
+}
@@ -1,9 +1,14 @@
 package http
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
+	"sync/atomic"
+	"time"
 
 	"github.com/azuki774/mawinter/api"
 	"github.com/azuki774/mawinter/internal/adapter/http/middleware"
@@ -14,71 +19,246 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
+// Timeouts は HTTP サーバのタイムアウト設定
+// 0 の場合はタイムアウトしない
+type Timeouts struct {
+	ReadHeader    time.Duration // リクエストヘッダーの読み込み
+	Read          time.Duration // リクエスト全体（ボディを含む）の読み込み
+	Write         time.Duration // レスポンスの書き込み（ヘッダー読み込み完了から）
+	Idle          time.Duration // Keep-Alive 接続で次のリクエストを待つ時間
+	Shutdown      time.Duration // シャットダウン時に処理中のリクエストの完了を待つ時間
+	ShutdownDelay time.Duration // シャットダウン時に readiness を 503 にしてから新しい接続の受け付けを止めるまでの時間
+}
+
+// DefaultTimeouts は HTTP サーバのデフォルトのタイムアウト設定
+var DefaultTimeouts = Timeouts{
+	ReadHeader: 10 * time.Second,
+	Read:       30 * time.Second,
+	Write:      30 * time.Second,
+	Idle:       120 * time.Second,
+	Shutdown:   20 * time.Second,
+}
+
+// ServerOption は Server の生成時オプション
+type ServerOption func(*Server)
+
+// WithTimeouts は HTTP サーバのタイムアウトを指定する
+func WithTimeouts(timeouts Timeouts) ServerOption {
+	return func(s *Server) {
+		s.timeouts = timeouts
+	}
+}
+
+// WithLogLevel は管理用エンドポイント（/v3/admin/log-level）で参照・変更するログレベルを指定する
+// 指定しない場合、管理用エンドポイントは 404 を返す
+func WithLogLevel(level *slog.LevelVar) ServerOption {
+	return func(s *Server) {
+		s.logLevel = level
+	}
+}
+
+// WithResponseValidation はレスポンスを OpenAPI の仕様で検証するかを指定する
+// 指定しない場合、Gin がテストモードのときのみ検証する
+func WithResponseValidation(enabled bool) ServerOption {
+	return func(s *Server) {
+		s.validateResponses = enabled
+	}
+}
+
+// WithSuggestionService はカテゴリの推定（/v3/categories/suggest）に使うサービスを指定する
+// 指定しない場合、推定のエンドポイントは常に空の候補を返す
+func WithSuggestionService(service *application.SuggestionService) ServerOption {
+	return func(s *Server) {
+		s.suggestionService = service
+	}
+}
+
+// untracedPaths はトレースしないパス（ヘルスチェック・メトリクス）
+var untracedPaths = map[string]bool{
+	"/api/v3":              true,
+	"/api/v3/":             true,
+	"/api/v3/health/live":  true,
+	"/api/v3/health/ready": true,
+	MetricsPath:            true,
+}
+
+// MetricsPath は Prometheus 形式のメトリクスを公開するパス
+const MetricsPath = "/metrics"
+
+// WithMetricsHandler は MetricsPath でメトリクスを公開するハンドラを指定する
+func WithMetricsHandler(handler http.Handler) ServerOption {
+	return func(s *Server) {
+		s.router.GET(MetricsPath, gin.WrapH(handler))
+	}
+}
+
 // Server は HTTP サーバの構造体
 // api.ServerInterface を実装する
 type Server struct {
 	router          *gin.Engine
 	host            string
 	port            int
+	timeouts        Timeouts
 	dbInfo          *config.DBInfo
 	version         string
 	revision        string
 	build           string
 	categoryService *application.CategoryService
 	recordService   *application.RecordService
+	healthService   *application.HealthService
+	backupService   *application.BackupService // 自動バックアップが無効な場合は nil
+	// カテゴリの推定。指定しない場合は候補を返さない
+	suggestionService *application.SuggestionService
+	logLevel          *slog.LevelVar // 実行中に変更できるログレベル
+	// レスポンスを OpenAPI の仕様で検証する（違反は 500 を返す）
+	validateResponses bool
+
+	shuttingDown atomic.Bool // シャットダウン中は readiness で 503 を返す
 }
 
 // NewServer は新しい HTTP サーバを作成
-func NewServer(host string, port int, version, revision, build string, dbInfo *config.DBInfo, categoryService *application.CategoryService, recordService *application.RecordService) *Server {
+func NewServer(host string, port int, version, revision, build string, dbInfo *config.DBInfo, categoryService *application.CategoryService, recordService *application.RecordService, healthService *application.HealthService, backupService *application.BackupService, opts ...ServerOption) *Server {
 	router := gin.New()
 
 	// ミドルウェアを設定
-	router.Use(gin.Recovery())                                // panicからの回復
-	router.Use(middleware.Logger())                           // 構造化ログ（JSON形式）
-	// ヘルスチェック(/api/v3)は高頻度アクセスでノイズになるためトレースを除外する
+	router.Use(gin.CustomRecovery(handlePanic)) // panicからの回復
+	// ヘルスチェック・メトリクスは高頻度アクセスでノイズになるためトレースを除外する
+	// リクエスト数・所要時間のメトリクス（http.server.request.duration）もこのミドルウェアで記録する
 	router.Use(otelgin.Middleware(
 		telemetry.ServiceNameAPI,
 		otelgin.WithFilter(func(r *http.Request) bool {
-			path := r.URL.Path
-			return path != "/api/v3" && path != "/api/v3/"
+			return !untracedPaths[r.URL.Path]
 		}),
 	)) // OpenTelemetryトレーシング
+	// ログにトレース ID を含めるため、以下はスパンの開始後に実行する
+	router.Use(middleware.RequestID()) // リクエスト ID（X-Request-ID）
+	router.Use(middleware.Logger())    // 構造化ログ（JSON形式）
 
 	// プロキシを使わない設定
 	router.SetTrustedProxies(nil)
 
+	// 存在しないパスも他のエラーと同じ problem+json で返す
+	router.NoRoute(handleNoRoute)
+
 	s := &Server{
 		router:          router,
 		host:            host,
 		port:            port,
+		timeouts:        DefaultTimeouts,
 		dbInfo:          dbInfo,
 		version:         version,
 		revision:        revision,
 		build:           build,
 		categoryService: categoryService,
 		recordService:   recordService,
+		healthService:   healthService,
+		backupService:   backupService,
+
+		validateResponses: gin.Mode() == gin.TestMode,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	// リクエスト（テスト時はレスポンスも）を埋め込みの OpenAPI の仕様で検証する
+	// 仕様のルートのみに適用するため、ルーティングの設定より前に追加する
+	spec, err := api.GetSwagger()
+	if err != nil {
+		// 埋め込みの仕様はビルド時に生成されるため、読み込めないのはプログラムの誤り
+		panic(fmt.Sprintf("failed to load embedded OpenAPI spec: %v", err))
 	}
+	s.router.Use(middleware.OpenAPIValidator(spec, middleware.OpenAPIValidatorOptions{
+		BaseURL:           "/api",
+		ValidateResponses: s.validateResponses,
+		ErrorHandler:      handleSpecError,
+	}))
 
 	// OpenAPI生成のRegisterHandlersを使用してルーティングを設定
 	// /api プレフィックスを追加
 	api.RegisterHandlersWithOptions(s.router, s, api.GinServerOptions{
-		BaseURL: "/api",
+		BaseURL:      "/api",
+		ErrorHandler: handleParamError,
 	})
 
+	// API の仕様とドキュメントのページを公開する
+	doc, err := newSpecDocument()
+	if err != nil {
+		panic(fmt.Sprintf("failed to load embedded OpenAPI spec: %v", err))
+	}
+	s.registerDocs(doc)
+
 	return s
 }
 
-// Start はサーバを起動
-func (s *Server) Start() error {
+// Run はサーバを起動し、ctx がキャンセルされるまでリクエストを処理する
+// キャンセル後は新しい接続の受け付けを止め、処理中のリクエストの完了を待ってから戻る
+func (s *Server) Run(ctx context.Context) error {
 	addr := fmt.Sprintf("%s:%d", s.host, s.port)
-	slog.Info("HTTP server starting", slog.String("address", addr))
-
-	if err := s.router.Run(addr); err != nil {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
 		slog.Error("Failed to start HTTP server",
 			slog.String("address", addr),
 			slog.String("error", err.Error()),
 		)
 		return err
 	}
+	slog.Info("HTTP server starting", slog.String("address", ln.Addr().String()))
+
+	return s.serve(ctx, ln)
+}
+
+// serve は指定されたリスナーでリクエストを処理する
+func (s *Server) serve(ctx context.Context, ln net.Listener) error {
+	srv := &http.Server{
+		Handler:           s.router,
+		ReadHeaderTimeout: s.timeouts.ReadHeader,
+		ReadTimeout:       s.timeouts.Read,
+		WriteTimeout:      s.timeouts.Write,
+		IdleTimeout:       s.timeouts.Idle,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(ln)
+	}()
+
+	select {
+	case err := <-errCh:
+		slog.Error("HTTP server stopped unexpectedly", slog.String("error", err.Error()))
+		return err
+	case <-ctx.Done():
+	}
+
+	// readiness を 503 にし、ロードバランサが振り分けを止めるまではリクエストを処理し続ける
+	s.shuttingDown.Store(true)
+	if s.timeouts.ShutdownDelay > 0 {
+		slog.Info("HTTP server draining before shutdown", slog.String("delay", s.timeouts.ShutdownDelay.String()))
+		select {
+		case err := <-errCh:
+			slog.Error("HTTP server stopped unexpectedly", slog.String("error", err.Error()))
+			return err
+		case <-time.After(s.timeouts.ShutdownDelay):
+		}
+	}
+	slog.Info("HTTP server shutting down", slog.String("timeout", s.timeouts.Shutdown.String()))
+
+	shutdownCtx := context.Background()
+	if s.timeouts.Shutdown > 0 {
+		var cancel context.CancelFunc
+		shutdownCtx, cancel = context.WithTimeout(shutdownCtx, s.timeouts.Shutdown)
+		defer cancel()
+	}
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		// 期限内に完了しなかったリクエストの接続は強制的に閉じる
+		srv.Close()
+		slog.Error("HTTP server did not shut down gracefully", slog.String("error", err.Error()))
+		return fmt.Errorf("failed to shut down HTTP server: %w", err)
+	}
+	if err := <-errCh; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	slog.Info("HTTP server stopped")
 	return nil
 }
@@ -0,0 +1,197 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/application"
+	"github.com/azuki774/mawinter/pkg/config"
+	"github.com/gin-gonic/gin"
+)
+
+// startSlowServer は指定時間後に応答するエンドポイントを持つサーバを起動する
+// started には /slow のリクエストの処理開始が通知される
+func startSlowServer(t *testing.T, ctx context.Context, delay time.Duration, timeouts Timeouts) (string, <-chan struct{}, <-chan error) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	categoryService := application.NewCategoryService(&mockCategoryRepository{})
+	recordService := application.NewRecordService(&mockRecordRepository{}, &mockCategoryRepository{categories: testCategories})
+	server := NewServer("127.0.0.1", 0, "v1.0.0", "abc123", "20250101", &config.DBInfo{}, categoryService, recordService, nil, nil, WithTimeouts(timeouts))
+
+	started := make(chan struct{}, 1)
+	server.router.GET("/slow", func(c *gin.Context) {
+		started <- struct{}{}
+		time.Sleep(delay)
+		c.String(http.StatusOK, "done")
+	})
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.serve(ctx, ln)
+	}()
+	return "http://" + ln.Addr().String(), started, errCh
+}
+
+func TestServer_GracefulShutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	timeouts := DefaultTimeouts
+	timeouts.Shutdown = 5 * time.Second
+	baseURL, started, errCh := startSlowServer(t, ctx, 200*time.Millisecond, timeouts)
+
+	type result struct {
+		status int
+		body   string
+		err    error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		resp, err := http.Get(baseURL + "/slow")
+		if err != nil {
+			resCh <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		resCh <- result{status: resp.StatusCode, body: string(body), err: err}
+	}()
+
+	// リクエストの処理中に停止を指示する
+	<-started
+	cancel()
+
+	// 処理中のリクエストは最後まで応答される
+	res := <-resCh
+	if res.err != nil || res.status != http.StatusOK || res.body != "done" {
+		t.Fatalf("in-flight request was not completed: %+v", res)
+	}
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatalf("serve() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("serve() did not return after shutdown")
+	}
+
+	// 停止後は新しい接続を受け付けない
+	if _, err := http.Get(baseURL + "/api/v3/"); err == nil {
+		t.Error("expected connection error after shutdown")
+	}
+}
+
+func TestServer_ShutdownDelay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	timeouts := DefaultTimeouts
+	timeouts.ShutdownDelay = 500 * time.Millisecond
+	baseURL, _, errCh := startSlowServer(t, ctx, 0, timeouts)
+
+	ready := func() (int, error) {
+		resp, err := http.Get(baseURL + "/api/v3/health/ready")
+		if err != nil {
+			return 0, err
+		}
+		resp.Body.Close()
+		return resp.StatusCode, nil
+	}
+	if status, err := ready(); err != nil || status != http.StatusOK {
+		t.Fatalf("expected readiness 200 before shutdown, got %d (%v)", status, err)
+	}
+
+	stopped := time.Now()
+	cancel()
+
+	// 待つ間は新しいリクエストも処理し、readiness は 503 を返す
+	time.Sleep(100 * time.Millisecond)
+	if status, err := ready(); err != nil || status != http.StatusServiceUnavailable {
+		t.Fatalf("expected readiness 503 during the shutdown delay, got %d (%v)", status, err)
+	}
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatalf("serve() error = %v", err)
+		}
+		if elapsed := time.Since(stopped); elapsed < timeouts.ShutdownDelay {
+			t.Errorf("serve() returned before the shutdown delay: %s", elapsed)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("serve() did not return after the shutdown delay")
+	}
+	if _, err := ready(); err == nil {
+		t.Error("expected connection error after shutdown")
+	}
+}
+
+func TestServer_ShutdownTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	timeouts := DefaultTimeouts
+	timeouts.Shutdown = 50 * time.Millisecond
+	baseURL, started, errCh := startSlowServer(t, ctx, 2*time.Second, timeouts)
+
+	go func() {
+		resp, err := http.Get(baseURL + "/slow")
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+
+	<-started
+	cancel()
+
+	// 期限内に完了しないリクエストがある場合はエラーを返す
+	select {
+	case err := <-errCh:
+		if err == nil {
+			t.Fatal("expected shutdown timeout error")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("serve() did not give up after the shutdown timeout")
+	}
+}
+
+func TestServer_MetricsHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	categoryService := application.NewCategoryService(&mockCategoryRepository{})
+	recordService := application.NewRecordService(&mockRecordRepository{}, &mockCategoryRepository{categories: testCategories})
+	metricsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("mawinter_records_created 1\n"))
+	})
+
+	tests := []struct {
+		name       string
+		opts       []ServerOption
+		wantStatus int
+	}{
+		{name: "メトリクスを公開する", opts: []ServerOption{WithMetricsHandler(metricsHandler)}, wantStatus: http.StatusOK},
+		{name: "メトリクスが無効", opts: nil, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer("localhost", 8080, "v1.0.0", "abc123", "20250101", &config.DBInfo{}, categoryService, recordService, nil, nil, tt.opts...)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", MetricsPath, nil)
+			server.router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status code %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
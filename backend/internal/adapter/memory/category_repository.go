@@ -0,0 +1,88 @@
+// Package memory はプロセス内のメモリにデータを保持するリポジトリの実装
+// データベースを用意せずに動作確認やテストを行う場合に使用する。プロセスを終了するとデータは失われる
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/azuki774/mawinter/internal/domain"
+)
+
+// CategoryRepository はカテゴリリポジトリのメモリ実装
+type CategoryRepository struct {
+	mu         sync.RWMutex
+	categories []*domain.Category // category_id の昇順
+}
+
+// NewCategoryRepository はCategoryRepositoryを生成する
+func NewCategoryRepository(categories []*domain.Category) *CategoryRepository {
+	copied := make([]*domain.Category, len(categories))
+	for i, c := range categories {
+		category := *c
+		copied[i] = &category
+	}
+	sort.Slice(copied, func(i, j int) bool {
+		return copied[i].CategoryID < copied[j].CategoryID
+	})
+
+	return &CategoryRepository{
+		categories: copied,
+	}
+}
+
+// DefaultCategories はデータベースのマイグレーションで登録されるものと同じカテゴリの一覧を返す
+func DefaultCategories() []*domain.Category {
+	return []*domain.Category{
+		{ID: 1, CategoryID: 100, Name: "月給", CategoryType: domain.CategoryTypeIncome},
+		{ID: 2, CategoryID: 101, Name: "ボーナス", CategoryType: domain.CategoryTypeIncome},
+		{ID: 3, CategoryID: 110, Name: "雑所得", CategoryType: domain.CategoryTypeIncome},
+		{ID: 4, CategoryID: 200, Name: "家賃", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 5, CategoryID: 210, Name: "食費", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 6, CategoryID: 220, Name: "電気代", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 7, CategoryID: 221, Name: "ガス代", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 8, CategoryID: 222, Name: "水道費", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 9, CategoryID: 230, Name: "コンピュータリソース", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 10, CategoryID: 231, Name: "通信費", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 11, CategoryID: 240, Name: "生活用品", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 12, CategoryID: 250, Name: "娯楽費", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 13, CategoryID: 251, Name: "交遊費", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 14, CategoryID: 260, Name: "書籍・勉強", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 15, CategoryID: 270, Name: "交通費", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 16, CategoryID: 280, Name: "衣服等費", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 17, CategoryID: 300, Name: "保険・税金", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 18, CategoryID: 400, Name: "医療・衛生", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 19, CategoryID: 500, Name: "雑費", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 20, CategoryID: 600, Name: "家賃用貯金", CategoryType: domain.CategoryTypeSaving},
+		{ID: 21, CategoryID: 601, Name: "PC用貯金", CategoryType: domain.CategoryTypeSaving},
+		{ID: 22, CategoryID: 700, Name: "NISA入出金", CategoryType: domain.CategoryTypeInvesting},
+		{ID: 23, CategoryID: 701, Name: "NISA変動", CategoryType: domain.CategoryTypeInvesting},
+	}
+}
+
+// FindAll は全てのカテゴリを category_id の昇順で取得する
+func (r *CategoryRepository) FindAll(ctx context.Context) ([]*domain.Category, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	categories := make([]*domain.Category, len(r.categories))
+	for i, c := range r.categories {
+		category := *c
+		categories[i] = &category
+	}
+	return categories, nil
+}
+
+// find は指定された category_id のカテゴリを取得する
+func (r *CategoryRepository) find(categoryID int) (domain.Category, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, c := range r.categories {
+		if c.CategoryID == categoryID {
+			return *c, true
+		}
+	}
+	return domain.Category{}, false
+}
@@ -0,0 +1,32 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/azuki774/mawinter/internal/domain/domaintest"
+)
+
+func TestRepositoryContract(t *testing.T) {
+	domaintest.RunRepositoryContract(t, func(t *testing.T) domaintest.Repositories {
+		categories := NewCategoryRepository(DefaultCategories())
+		records := NewRecordRepository(categories)
+		return domaintest.Repositories{
+			Categories: categories,
+			Records:    records,
+			LockMonth: func(t *testing.T, yyyymm string) {
+				records.LockMonth(yyyymm)
+			},
+			RemoveCategory: func(t *testing.T, categoryID int) {
+				categories.mu.Lock()
+				defer categories.mu.Unlock()
+				for i, c := range categories.categories {
+					if c.CategoryID == categoryID {
+						categories.categories = append(categories.categories[:i], categories.categories[i+1:]...)
+						return
+					}
+				}
+				t.Fatalf("category %d does not exist", categoryID)
+			},
+		}
+	})
+}
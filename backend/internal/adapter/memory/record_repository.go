@@ -0,0 +1,532 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/domain"
+)
+
+// RecordRepository はレコードリポジトリのメモリ実装
+// データベース実装と同様に、ゴミ箱（論理削除）・変更履歴・確定済みの月を保持する
+type RecordRepository struct {
+	mu         sync.RWMutex
+	categories *CategoryRepository
+	records    map[int]*domain.Record
+	history    map[int][]*domain.RecordVersion
+	locked     map[string]bool // 確定済みの月（YYYYMM）
+	nextID     int
+	now        func() time.Time
+}
+
+// NewRecordRepository はRecordRepositoryを生成する
+// カテゴリ名の解決とカテゴリ別の集計に categories を使用する
+func NewRecordRepository(categories *CategoryRepository) *RecordRepository {
+	return &RecordRepository{
+		categories: categories,
+		records:    make(map[int]*domain.Record),
+		history:    make(map[int][]*domain.RecordVersion),
+		locked:     make(map[string]bool),
+		nextID:     1,
+		now:        time.Now,
+	}
+}
+
+// Create は新しいレコードを作成する
+func (r *RecordRepository) Create(ctx context.Context, record *domain.Record) (*domain.Record, error) {
+	category, ok := r.categories.find(record.CategoryID)
+	if !ok {
+		return nil, unknownCategory(record.CategoryID)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	stored := *record
+	stored.ID = r.nextID
+	stored.CategoryName = category.Name
+	stored.Tags = copyTags(record.Tags)
+	stored.DeletedAt = nil
+	if stored.Datetime.IsZero() {
+		stored.Datetime = now
+	}
+	if err := r.checkMonthUnlocked(stored.Datetime); err != nil {
+		return nil, err
+	}
+	r.nextID++
+
+	r.records[stored.ID] = &stored
+	r.appendHistory(&stored, domain.RecordOperationCreate, now)
+
+	return copyRecord(&stored), nil
+}
+
+// Update は既存のレコードを更新し、新しい版を履歴に記録する
+// ゴミ箱内のレコード、および更新前・更新後の日時が確定済みの月に属するレコードは更新できない
+func (r *RecordRepository) Update(ctx context.Context, record *domain.Record) (*domain.Record, error) {
+	category, ok := r.categories.find(record.CategoryID)
+	if !ok {
+		return nil, unknownCategory(record.CategoryID)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.records[record.ID]
+	if !ok || stored.DeletedAt != nil {
+		return nil, domain.NewNotFoundError("record", record.ID)
+	}
+	if err := r.checkMonthUnlocked(stored.Datetime, record.Datetime); err != nil {
+		return nil, err
+	}
+
+	stored.CategoryID = record.CategoryID
+	stored.CategoryName = category.Name
+	if !record.Datetime.IsZero() {
+		stored.Datetime = record.Datetime
+	}
+	stored.From = record.From
+	stored.Type = record.Type
+	stored.Price = record.Price
+	stored.Memo = record.Memo
+	stored.Tags = copyTags(record.Tags)
+	r.appendHistory(stored, domain.RecordOperationUpdate, r.now())
+
+	return copyRecord(stored), nil
+}
+
+// FindByID は指定されたIDのレコードを取得する
+// ゴミ箱内のレコードは取得できない。レコードのカテゴリが存在しない場合はエラーを返す
+func (r *RecordRepository) FindByID(ctx context.Context, id int) (*domain.Record, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored, ok := r.records[id]
+	if !ok || stored.DeletedAt != nil {
+		return nil, domain.NewNotFoundError("record", id)
+	}
+	return r.withCategory(stored)
+}
+
+// FindAll はレコードをID降順で取得する（ページネーション対応）
+func (r *RecordRepository) FindAll(ctx context.Context, num, offset int, yyyymm string, categoryID int) ([]*domain.Record, error) {
+	matched, err := r.filter(yyyymm, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].ID > matched[j].ID
+	})
+
+	return paginate(matched, num, offset), nil
+}
+
+// Count は条件に一致するレコードの総数を取得する
+func (r *RecordRepository) Count(ctx context.Context, yyyymm string, categoryID int) (int, error) {
+	matched, err := r.filter(yyyymm, categoryID)
+	if err != nil {
+		return 0, err
+	}
+	return len(matched), nil
+}
+
+// Delete は指定されたIDのレコードをゴミ箱に移動する（論理削除）
+// 確定済みの月のレコードは移動できない
+func (r *RecordRepository) Delete(ctx context.Context, id int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.records[id]
+	if !ok {
+		return domain.NewNotFoundError("record", id)
+	}
+	if stored.DeletedAt != nil {
+		return domain.NewConflictError("record %d is already in trash", id)
+	}
+	if err := r.checkMonthUnlocked(stored.Datetime); err != nil {
+		return err
+	}
+
+	now := r.now()
+	stored.DeletedAt = &now
+	r.appendHistory(stored, domain.RecordOperationDelete, now)
+	return nil
+}
+
+// FindDeleted はゴミ箱内のレコードを削除日時の新しい順に取得する
+func (r *RecordRepository) FindDeleted(ctx context.Context, num, offset int) ([]*domain.Record, error) {
+	r.mu.RLock()
+	deleted := make([]*domain.Record, 0)
+	for _, stored := range r.records {
+		if stored.DeletedAt != nil {
+			deleted = append(deleted, r.withCategoryName(stored))
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(deleted, func(i, j int) bool {
+		if !deleted[i].DeletedAt.Equal(*deleted[j].DeletedAt) {
+			return deleted[i].DeletedAt.After(*deleted[j].DeletedAt)
+		}
+		return deleted[i].ID > deleted[j].ID
+	})
+
+	return paginate(deleted, num, offset), nil
+}
+
+// Restore はゴミ箱内のレコードを元に戻す
+// 確定済みの月のレコードは復元できない
+func (r *RecordRepository) Restore(ctx context.Context, id int) (*domain.Record, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.records[id]
+	if !ok {
+		return nil, domain.NewNotFoundError("record", id)
+	}
+	if stored.DeletedAt == nil {
+		return nil, domain.NewConflictError("record %d is not in trash", id)
+	}
+	if err := r.checkMonthUnlocked(stored.Datetime); err != nil {
+		return nil, err
+	}
+
+	stored.DeletedAt = nil
+	r.appendHistory(stored, domain.RecordOperationRestore, r.now())
+	return r.withCategory(stored)
+}
+
+// LockMonth は月（YYYYMM）を確定済みにする（データベース実装の Monthly_Confirm に相当）
+// 確定済みの月のレコードは作成・更新・ゴミ箱への移動・復元できない
+func (r *RecordRepository) LockMonth(yyyymm string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.locked[yyyymm] = true
+}
+
+// Purge は before より前にゴミ箱へ移動されたレコードを物理削除し、削除件数を返す
+// 履歴は削除しない
+func (r *RecordRepository) Purge(ctx context.Context, before time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	purged := 0
+	for id, stored := range r.records {
+		if stored.DeletedAt != nil && stored.DeletedAt.Before(before) {
+			delete(r.records, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// FindHistory は指定されたIDのレコードの履歴を版の古い順に取得する
+func (r *RecordRepository) FindHistory(ctx context.Context, id int) ([]*domain.RecordVersion, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	versions, ok := r.history[id]
+	if !ok || len(versions) == 0 {
+		return nil, domain.NewNotFoundError("record", id)
+	}
+
+	result := make([]*domain.RecordVersion, len(versions))
+	for i, v := range versions {
+		version := *v
+		version.Record = *copyRecord(&v.Record)
+		version.Record.CategoryName = r.categoryName(v.Record.CategoryID)
+		result[i] = &version
+	}
+	return result, nil
+}
+
+// GetAvailablePeriods はレコードが存在するYYYYMMとFY(年度)の一覧を新しい順に取得する
+func (r *RecordRepository) GetAvailablePeriods(ctx context.Context) ([]string, []string, error) {
+	r.mu.RLock()
+	periodSet := make(map[int]bool)
+	for _, stored := range r.records {
+		if stored.DeletedAt != nil {
+			continue
+		}
+		t := stored.Datetime.In(time.Local)
+		periodSet[t.Year()*100+int(t.Month())] = true
+	}
+	r.mu.RUnlock()
+
+	periods := make([]int, 0, len(periodSet))
+	for period := range periodSet {
+		periods = append(periods, period)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(periods)))
+
+	yyyymmSlice := make([]string, 0, len(periods))
+	fySlice := []string{}
+	for _, period := range periods {
+		yyyymmSlice = append(yyyymmSlice, fmt.Sprintf("%06d", period))
+
+		fy := fmt.Sprintf("%d", domain.FiscalYear(time.Date(period/100, time.Month(period%100), 1, 0, 0, 0, 0, time.Local)))
+		if len(fySlice) == 0 || fySlice[len(fySlice)-1] != fy {
+			fySlice = append(fySlice, fy)
+		}
+	}
+
+	return yyyymmSlice, fySlice, nil
+}
+
+// GetYearSummary は指定された会計年度のカテゴリ別サマリーを取得する
+func (r *RecordRepository) GetYearSummary(ctx context.Context, year int) ([]*domain.CategoryYearSummary, error) {
+	r.mu.RLock()
+	records := make([]domain.Record, 0, len(r.records))
+	for _, stored := range r.records {
+		if stored.DeletedAt == nil {
+			records = append(records, *stored)
+		}
+	}
+	r.mu.RUnlock()
+
+	return r.summarize(ctx, year, records)
+}
+
+// GetPeriodSummary は指定された期間のカテゴリ別サマリーを、区切る単位ごとの金額とともに取得する
+func (r *RecordRepository) GetPeriodSummary(ctx context.Context, period domain.SummaryPeriod) ([]*domain.CategoryPeriodSummary, error) {
+	categories, err := r.categories.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	categoryMap := make(map[int]*domain.Category, len(categories))
+	for _, cat := range categories {
+		categoryMap[cat.CategoryID] = cat
+	}
+
+	buckets := period.Buckets()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	summaryMap := make(map[int]*domain.CategoryPeriodSummary)
+	for _, record := range r.records {
+		if record.DeletedAt != nil || record.Datetime.Before(period.From) || !record.Datetime.Before(period.To) {
+			continue
+		}
+		cat, exists := categoryMap[record.CategoryID]
+		if !exists {
+			continue // カテゴリが見つからない場合はスキップ
+		}
+
+		summary, exists := summaryMap[record.CategoryID]
+		if !exists {
+			summary = &domain.CategoryPeriodSummary{
+				CategoryID:   cat.CategoryID,
+				CategoryName: cat.Name,
+				CategoryType: cat.CategoryType,
+				Price:        make([]int, len(buckets)),
+			}
+			summaryMap[record.CategoryID] = summary
+		}
+
+		// 区切りは古い順に並んでいるため、日時より後に終わる最初の区切りに含まれる
+		i := sort.Search(len(buckets), func(i int) bool { return record.Datetime.Before(buckets[i].To) })
+		summary.Price[i] += record.Price
+		summary.Count++
+		summary.Total += record.Price
+	}
+
+	result := make([]*domain.CategoryPeriodSummary, 0, len(summaryMap))
+	for _, summary := range summaryMap {
+		result = append(result, summary)
+	}
+	return result, nil
+}
+
+// GetYearSummaryAsOf は asOf 時点の履歴から、指定された会計年度のカテゴリ別サマリーを計算する
+func (r *RecordRepository) GetYearSummaryAsOf(ctx context.Context, year int, asOf time.Time) ([]*domain.CategoryYearSummary, error) {
+	r.mu.RLock()
+	records := make([]domain.Record, 0, len(r.history))
+	for _, versions := range r.history {
+		// asOf 以前に記録された最新の版を採用する
+		var latest *domain.RecordVersion
+		for _, v := range versions {
+			if !v.RecordedAt.After(asOf) {
+				latest = v
+			}
+		}
+		if latest != nil && latest.Operation != domain.RecordOperationDelete {
+			records = append(records, latest.Record)
+		}
+	}
+	r.mu.RUnlock()
+
+	return r.summarize(ctx, year, records)
+}
+
+// summarize はレコードを会計年度の月別・カテゴリ別に集計する
+// 存在しないカテゴリのレコードは集計しない
+func (r *RecordRepository) summarize(ctx context.Context, year int, records []domain.Record) ([]*domain.CategoryYearSummary, error) {
+	categories, err := r.categories.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	categoryMap := make(map[int]*domain.Category, len(categories))
+	for _, cat := range categories {
+		categoryMap[cat.CategoryID] = cat
+	}
+
+	startDate := time.Date(year, time.April, 1, 0, 0, 0, 0, time.Local)
+	endDate := startDate.AddDate(1, 0, 0)
+
+	summaryMap := make(map[int]*domain.CategoryYearSummary)
+	for _, record := range records {
+		if record.Datetime.Before(startDate) || !record.Datetime.Before(endDate) {
+			continue
+		}
+		cat, exists := categoryMap[record.CategoryID]
+		if !exists {
+			continue // カテゴリが見つからない場合はスキップ
+		}
+
+		summary, exists := summaryMap[record.CategoryID]
+		if !exists {
+			summary = &domain.CategoryYearSummary{
+				CategoryID:   cat.CategoryID,
+				CategoryName: cat.Name,
+				CategoryType: cat.CategoryType,
+			}
+			summaryMap[record.CategoryID] = summary
+		}
+
+		summary.Price[domain.FiscalMonthIndex(record.Datetime.In(time.Local))] += record.Price
+		summary.Count++
+		summary.Total += record.Price
+	}
+
+	result := make([]*domain.CategoryYearSummary, 0, len(summaryMap))
+	for _, summary := range summaryMap {
+		result = append(result, summary)
+	}
+	return result, nil
+}
+
+// filter はゴミ箱にない、条件に一致するレコードのコピーを返す
+func (r *RecordRepository) filter(yyyymm string, categoryID int) ([]*domain.Record, error) {
+	var startDate, endDate time.Time
+	if yyyymm != "" {
+		start, err := time.ParseInLocation("200601", yyyymm, time.Local)
+		if err != nil || len(yyyymm) != 6 {
+			return nil, domain.NewValidationError("yyyymm", fmt.Sprintf("must be in YYYYMM format: %q", yyyymm))
+		}
+		startDate, endDate = start, start.AddDate(0, 1, 0)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matched := make([]*domain.Record, 0)
+	for _, stored := range r.records {
+		if stored.DeletedAt != nil {
+			continue
+		}
+		if yyyymm != "" && (stored.Datetime.Before(startDate) || !stored.Datetime.Before(endDate)) {
+			continue
+		}
+		if categoryID > 0 && stored.CategoryID != categoryID {
+			continue
+		}
+		matched = append(matched, r.withCategoryName(stored))
+	}
+	return matched, nil
+}
+
+// appendHistory はレコードの現在の内容を新しい版として履歴に追加する
+// 呼び出し元で書き込みロックを取得していること
+func (r *RecordRepository) appendHistory(record *domain.Record, op domain.RecordOperation, recordedAt time.Time) {
+	versions := r.history[record.ID]
+
+	snapshot := *record
+	snapshot.Tags = copyTags(record.Tags)
+	snapshot.DeletedAt = nil
+	r.history[record.ID] = append(versions, &domain.RecordVersion{
+		Version:    len(versions) + 1,
+		Operation:  op,
+		Record:     snapshot,
+		RecordedAt: recordedAt,
+	})
+}
+
+// checkMonthUnlocked は日時が属する月のいずれかが確定済みの場合に MonthLockedError を返す
+// データベース実装と同じくローカルタイムゾーンで判定し、ゼロ値の日時は無視する
+// 呼び出し元でロックを取得していること
+func (r *RecordRepository) checkMonthUnlocked(datetimes ...time.Time) error {
+	for _, datetime := range datetimes {
+		if datetime.IsZero() {
+			continue
+		}
+		if yyyymm := datetime.In(time.Local).Format("200601"); r.locked[yyyymm] {
+			return &domain.MonthLockedError{YYYYMM: yyyymm}
+		}
+	}
+	return nil
+}
+
+// withCategory は現在のカテゴリ名を設定したレコードのコピーを返す
+// データベース実装と同じく、カテゴリが存在しない場合はエラーを返す
+func (r *RecordRepository) withCategory(record *domain.Record) (*domain.Record, error) {
+	if _, ok := r.categories.find(record.CategoryID); !ok {
+		return nil, fmt.Errorf("category of record %d does not exist: %d", record.ID, record.CategoryID)
+	}
+	return r.withCategoryName(record), nil
+}
+
+// withCategoryName は現在のカテゴリ名を設定したレコードのコピーを返す
+// カテゴリが存在しない場合、カテゴリ名は空文字列になる
+func (r *RecordRepository) withCategoryName(record *domain.Record) *domain.Record {
+	copied := copyRecord(record)
+	copied.CategoryName = r.categoryName(record.CategoryID)
+	return copied
+}
+
+// categoryName はカテゴリIDからカテゴリ名を取得する
+func (r *RecordRepository) categoryName(categoryID int) string {
+	category, _ := r.categories.find(categoryID)
+	return category.Name
+}
+
+// unknownCategory は存在しないカテゴリを指定した場合の検証エラーを返す
+func unknownCategory(categoryID int) error {
+	return domain.NewValidationError("category_id", fmt.Sprintf("category does not exist: %d", categoryID))
+}
+
+// copyRecord は呼び出し元が変更しても保持しているデータに影響しないよう、レコードを複製する
+func copyRecord(record *domain.Record) *domain.Record {
+	copied := *record
+	copied.Tags = copyTags(record.Tags)
+	if record.DeletedAt != nil {
+		deletedAt := *record.DeletedAt
+		copied.DeletedAt = &deletedAt
+	}
+	return &copied
+}
+
+// copyTags はタグのコピーを返す。タグがない場合は DB の実装と同じく空のスライスを返す
+func copyTags(tags []string) []string {
+	return append([]string{}, tags...)
+}
+
+// paginate は offset 件目から最大 num 件を返す
+// num が負の場合は件数を制限しない
+func paginate(records []*domain.Record, num, offset int) []*domain.Record {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(records) {
+		return []*domain.Record{}
+	}
+	records = records[offset:]
+	if num >= 0 && num < len(records) {
+		records = records[:num]
+	}
+	return records
+}
@@ -0,0 +1,98 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/domain"
+)
+
+func TestRecordRepository_ConcurrentAccess(t *testing.T) {
+	ctx := context.Background()
+	repo := NewRecordRepository(NewCategoryRepository(DefaultCategories()))
+
+	const workers = 8
+	const perWorker = 50
+
+	var wg sync.WaitGroup
+	idCh := make(chan int, workers*perWorker)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				record, err := repo.Create(ctx, &domain.Record{
+					CategoryID: 210,
+					Datetime:   time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local),
+					Price:      1,
+				})
+				if err != nil {
+					t.Errorf("Create() error = %v", err)
+					return
+				}
+				idCh <- record.ID
+
+				// 読み込みと並行して実行できること
+				if _, err := repo.Count(ctx, "202405", 0); err != nil {
+					t.Errorf("Count() error = %v", err)
+				}
+				if _, err := repo.GetYearSummary(ctx, 2024); err != nil {
+					t.Errorf("GetYearSummary() error = %v", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(idCh)
+
+	seen := make(map[int]bool)
+	for id := range idCh {
+		if seen[id] {
+			t.Errorf("duplicate id %d", id)
+		}
+		seen[id] = true
+	}
+
+	count, err := repo.Count(ctx, "", 0)
+	if err != nil {
+		t.Fatalf("Count() error = %v", err)
+	}
+	if count != workers*perWorker {
+		t.Errorf("expected %d records, got %d", workers*perWorker, count)
+	}
+}
+
+func TestRecordRepository_ReturnsCopies(t *testing.T) {
+	ctx := context.Background()
+	repo := NewRecordRepository(NewCategoryRepository(DefaultCategories()))
+
+	created, err := repo.Create(ctx, &domain.Record{CategoryID: 210, Datetime: time.Now(), Price: 100, Tags: []string{"a"}})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	created.Price = 999
+
+	found, err := repo.FindByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.Price != 100 {
+		t.Errorf("stored record was modified through the returned value: price = %d", found.Price)
+	}
+
+	// 変更履歴のタグも保持している値と共有しない
+	history, err := repo.FindHistory(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("FindHistory() error = %v", err)
+	}
+	history[0].Record.Tags[0] = "changed"
+	history, err = repo.FindHistory(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("FindHistory() error = %v", err)
+	}
+	if got := history[0].Record.Tags; len(got) != 1 || got[0] != "a" {
+		t.Errorf("stored history was modified through the returned value: tags = %v", got)
+	}
+}
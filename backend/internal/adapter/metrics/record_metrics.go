@@ -0,0 +1,155 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// collectTimeout はメトリクスの収集時にレコードを集計する時間の上限
+const collectTimeout = 5 * time.Second
+
+// DefaultAmountCacheTTL は当年度の金額を集計し直すまでの間隔
+// メトリクスの収集のたびに DB で集計しないよう、この間は前回の集計結果を返す
+const DefaultAmountCacheTTL = 5 * time.Minute
+
+// RecordMetrics はレコードに関するメトリクスを記録する
+// application.RecordObserver として RecordService に登録し、作成されたレコードをカテゴリ種別ごとに数える
+type RecordMetrics struct {
+	created      metric.Int64Counter
+	categoryRepo domain.CategoryRepository
+	recordRepo   domain.RecordRepository
+	amountTTL    time.Duration
+	now          func() time.Time
+
+	typesMu sync.Mutex
+	types   map[int]domain.CategoryType // カテゴリ ID → 種別
+
+	mu         sync.Mutex
+	totals     map[domain.CategoryType]int // 前回集計した当年度の金額
+	year       int                         // 前回集計した会計年度
+	computedAt time.Time                   // 前回集計した日時（未集計の場合はゼロ値）
+}
+
+// RegisterRecordMetrics はレコードに関するメトリクスを登録する
+//   - mawinter.records.created: 起動後に作成されたレコード数（カウンタ）
+//   - mawinter.records.amount: 当年度の金額（ゲージ）。DefaultAmountCacheTTL ごとに年次サマリーから集計する
+//
+// いずれもカテゴリ種別（category_type）ごとに記録する
+func RegisterRecordMetrics(recordRepo domain.RecordRepository, categoryRepo domain.CategoryRepository) (*RecordMetrics, error) {
+	meter := otel.Meter("github.com/azuki774/mawinter/internal/adapter/metrics")
+
+	created, err := meter.Int64Counter(
+		"mawinter.records.created",
+		metric.WithDescription("Number of records created per category type"),
+		metric.WithUnit("{record}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := meter.Int64ObservableGauge(
+		"mawinter.records.amount",
+		metric.WithDescription("Total price of records in the current fiscal year per category type"),
+		metric.WithUnit("{yen}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &RecordMetrics{
+		created:      created,
+		categoryRepo: categoryRepo,
+		recordRepo:   recordRepo,
+		amountTTL:    DefaultAmountCacheTTL,
+		now:          time.Now,
+		types:        make(map[int]domain.CategoryType),
+	}
+	_, err = meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		totals, ok := m.amounts(ctx)
+		if !ok {
+			return nil
+		}
+		for _, ct := range domain.CategoryTypes() {
+			o.ObserveInt64(amount, int64(totals[ct]), metric.WithAttributes(attribute.String("category_type", ct.String())))
+		}
+		return nil
+	}, amount)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RecordCreated は作成されたレコードをカテゴリ種別ごとに数える
+func (m *RecordMetrics) RecordCreated(record *domain.Record) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	ct, ok := m.categoryType(ctx, record.CategoryID)
+	if !ok {
+		return
+	}
+	m.created.Add(ctx, 1, metric.WithAttributes(attribute.String("category_type", ct.String())))
+}
+
+// RecordSaved はレコードの更新・復元を受け取る。作成ではないため数えない
+func (m *RecordMetrics) RecordSaved(record *domain.Record) {}
+
+// RecordRemoved はレコードのゴミ箱への移動を受け取る。作成ではないため数えない
+func (m *RecordMetrics) RecordRemoved(id int) {}
+
+// categoryType はカテゴリ ID の種別を返す
+// 未知のカテゴリ ID の場合のみ、カテゴリを取得し直す
+func (m *RecordMetrics) categoryType(ctx context.Context, categoryID int) (domain.CategoryType, bool) {
+	m.typesMu.Lock()
+	defer m.typesMu.Unlock()
+	if ct, ok := m.types[categoryID]; ok {
+		return ct, true
+	}
+
+	categories, err := m.categoryRepo.FindAll(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get categories for record metrics", slog.String("error", err.Error()))
+		return 0, false
+	}
+	for _, c := range categories {
+		m.types[c.CategoryID] = c.CategoryType
+	}
+	ct, ok := m.types[categoryID]
+	return ct, ok
+}
+
+// amounts は当年度のカテゴリ種別ごとの金額を返す
+// 前回の集計から amountTTL を過ぎていない場合は、DB で集計せずに前回の結果を返す
+func (m *RecordMetrics) amounts(ctx context.Context) (map[domain.CategoryType]int, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	year := domain.FiscalYear(now)
+	if !m.computedAt.IsZero() && year == m.year && now.Sub(m.computedAt) < m.amountTTL {
+		return m.totals, true
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, collectTimeout)
+	defer cancel()
+	summaries, err := m.recordRepo.GetYearSummary(ctx, year)
+	if err != nil {
+		// 収集に失敗しても他のメトリクスは返せるよう、エラーはログに留める
+		slog.WarnContext(ctx, "Failed to collect record metrics", slog.String("error", err.Error()))
+		return nil, false
+	}
+
+	totals := make(map[domain.CategoryType]int)
+	for _, s := range summaries {
+		totals[s.CategoryType] += s.Total
+	}
+	m.totals, m.year, m.computedAt = totals, year, now
+	return totals, true
+}
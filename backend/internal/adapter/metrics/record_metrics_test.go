@@ -0,0 +1,107 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/adapter/memory"
+	"github.com/azuki774/mawinter/internal/application"
+	"github.com/azuki774/mawinter/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestRegisterRecordMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	prev := otel.GetMeterProvider()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	t.Cleanup(func() { otel.SetMeterProvider(prev) })
+
+	ctx := context.Background()
+	categories := memory.NewCategoryRepository(memory.DefaultCategories())
+	recordRepo := memory.NewRecordRepository(categories)
+	now := time.Now()
+	// 登録前のレコードは作成数に含めないが、当年度の金額には含める
+	if _, err := recordRepo.Create(ctx, &domain.Record{CategoryID: 100, Datetime: now, Price: 300000}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	m, err := RegisterRecordMetrics(recordRepo, categories)
+	if err != nil {
+		t.Fatalf("RegisterRecordMetrics() error = %v", err)
+	}
+	m.now = func() time.Time { return now }
+	s := application.NewRecordService(recordRepo, categories, application.WithRecordObserver(m))
+	for _, r := range []*domain.Record{
+		{CategoryID: 210, Datetime: now, Price: 1280},
+		{CategoryID: 210, Datetime: now, Price: 720},
+		// 前年度のレコードは金額に含めない
+		{CategoryID: 210, Datetime: now.AddDate(-1, 0, 0), Price: 5000},
+	} {
+		if _, err := s.CreateRecord(ctx, r); err != nil {
+			t.Fatalf("CreateRecord() error = %v", err)
+		}
+	}
+
+	assertMetrics(t, reader, map[string]int64{
+		"mawinter.records.created outgoing":  3,
+		"mawinter.records.amount income":     300000,
+		"mawinter.records.amount outgoing":   2000,
+		"mawinter.records.amount saving":     0,
+		"mawinter.records.amount investing":  0,
+		"mawinter.records.created income":    0,
+		"mawinter.records.created saving":    0,
+		"mawinter.records.created investing": 0,
+	})
+
+	// 集計し直す間隔を過ぎるまでは、収集のたびに集計しない
+	if _, err := s.CreateRecord(ctx, &domain.Record{CategoryID: 100, Datetime: now, Price: 1000}); err != nil {
+		t.Fatalf("CreateRecord() error = %v", err)
+	}
+	assertMetrics(t, reader, map[string]int64{
+		"mawinter.records.created income": 1,
+		"mawinter.records.amount income":  300000,
+	})
+
+	now = now.Add(DefaultAmountCacheTTL)
+	assertMetrics(t, reader, map[string]int64{
+		"mawinter.records.amount income": 301000,
+	})
+}
+
+// assertMetrics はメトリクスを収集し、「メトリクス名 カテゴリ種別」ごとの値を検証する
+func assertMetrics(t *testing.T, reader sdkmetric.Reader, want map[string]int64) {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("failed to collect metrics: %v", err)
+	}
+
+	got := make(map[string]int64)
+	record := func(name string, dps []metricdata.DataPoint[int64]) {
+		for _, dp := range dps {
+			ct, _ := dp.Attributes.Value(attribute.Key("category_type"))
+			got[name+" "+ct.AsString()] = dp.Value
+		}
+	}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Gauge[int64]:
+				record(m.Name, data.DataPoints)
+			case metricdata.Sum[int64]:
+				record(m.Name, data.DataPoints)
+			}
+		}
+	}
+
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s = %d, want %d", k, got[k], v)
+		}
+	}
+}
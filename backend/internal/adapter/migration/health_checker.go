@@ -0,0 +1,45 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+)
+
+// SchemaHealthChecker はデータベースのスキーマが最新であることを確認する
+// domain.HealthChecker を実装する
+type SchemaHealthChecker struct {
+	migrator *Migrator
+}
+
+// NewSchemaHealthChecker は新しい SchemaHealthChecker を作成する
+func NewSchemaHealthChecker(migrator *Migrator) *SchemaHealthChecker {
+	return &SchemaHealthChecker{migrator: migrator}
+}
+
+// Name はコンポーネント名を返す
+func (c *SchemaHealthChecker) Name() string {
+	return "schema"
+}
+
+// Check は未適用のマイグレーションがないことを確認し、最後に適用されたマイグレーションのIDをバージョンとして返す
+func (c *SchemaHealthChecker) Check(ctx context.Context) (string, error) {
+	statuses, err := c.migrator.Status()
+	if err != nil {
+		return "", err
+	}
+
+	var version string
+	var pending []string
+	for _, s := range statuses {
+		if s.AppliedAt == nil {
+			pending = append(pending, s.ID)
+			continue
+		}
+		version = s.ID
+	}
+
+	if len(pending) > 0 {
+		return version, fmt.Errorf("%d pending migration(s) %v", len(pending), pending)
+	}
+	return version, nil
+}
@@ -0,0 +1,56 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+func TestSchemaHealthChecker_Check(t *testing.T) {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "mawinter.db"))
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+	defer db.Close()
+
+	m, err := NewMigrator(db, "sqlite")
+	if err != nil {
+		t.Fatalf("failed to create migrator: %v", err)
+	}
+	checker := NewSchemaHealthChecker(m)
+	ctx := context.Background()
+
+	// 未適用のマイグレーションがある場合はエラー
+	if _, err := checker.Check(ctx); err == nil || !strings.Contains(err.Error(), "pending migration") {
+		t.Fatalf("expected pending migration error, got %v", err)
+	}
+
+	// 1件だけ適用した状態ではそのIDがバージョンになる
+	if _, err := m.set.ExecMaxContext(ctx, db, m.dialect, m.source, migrate.Up, 1); err != nil {
+		t.Fatalf("failed to apply first migration: %v", err)
+	}
+	version, err := checker.Check(ctx)
+	if err == nil || version != "001_init.sql" {
+		t.Fatalf("Check() = %q, %v; want 001_init.sql with error", version, err)
+	}
+
+	// 全て適用すると最新のIDがバージョンになる
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("failed to apply migrations: %v", err)
+	}
+	version, err = checker.Check(ctx)
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	migrations, err := m.source.FindMigrations()
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if latest := migrations[len(migrations)-1].Id; version != latest {
+		t.Errorf("expected latest version %q, got %q", latest, version)
+	}
+}
@@ -0,0 +1,131 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/azuki774/mawinter/db"
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+// TableName はマイグレーションの適用状況を記録するテーブル名
+// 外部の sql-migrate コマンドと同じテーブルを使用するため、どちらで適用しても互換性がある
+const TableName = "gorp_migrations"
+
+// Status は1つのマイグレーションの適用状況を表す
+type Status struct {
+	ID        string
+	AppliedAt *time.Time // 未適用の場合は nil
+}
+
+// dialects はデータベースドライバ名から sql-migrate の方言名へのマップ
+var dialects = map[string]string{
+	"mysql":    "mysql",
+	"sqlite":   "sqlite3",
+	"postgres": "postgres",
+}
+
+// Migrator はバイナリに埋め込まれたマイグレーションを適用する
+type Migrator struct {
+	db      *sql.DB
+	dialect string
+	set     migrate.MigrationSet
+	source  migrate.MigrationSource
+}
+
+// NewMigrator はMigratorを生成する
+// driver はデータベースドライバ名（"mysql", "sqlite", "postgres"）
+func NewMigrator(sqlDB *sql.DB, driver string) (*Migrator, error) {
+	dialect, ok := dialects[driver]
+	if !ok {
+		return nil, fmt.Errorf("unsupported database driver for migrations: %s", driver)
+	}
+
+	return &Migrator{
+		db:      sqlDB,
+		dialect: dialect,
+		// 新しいバージョンのバイナリで適用されたマイグレーションがあっても起動できるようにする
+		set: migrate.MigrationSet{TableName: TableName, IgnoreUnknown: true},
+		source: &migrate.EmbedFileSystemMigrationSource{
+			FileSystem: db.Migrations,
+			Root:       db.MigrationsDir(driver),
+		},
+	}, nil
+}
+
+// Up は未適用のマイグレーションを全て適用し、適用した件数を返す
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	n, err := m.set.ExecContext(ctx, m.db, m.dialect, m.source, migrate.Up)
+	if err != nil {
+		return n, fmt.Errorf("failed to apply migrations: %w", err)
+	}
+	return n, nil
+}
+
+// Down は適用済みのマイグレーションを新しいものから steps 件ロールバックし、ロールバックした件数を返す
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	if steps <= 0 {
+		return 0, fmt.Errorf("steps must be positive: %d", steps)
+	}
+	n, err := m.set.ExecMaxContext(ctx, m.db, m.dialect, m.source, migrate.Down, steps)
+	if err != nil {
+		return n, fmt.Errorf("failed to roll back migrations: %w", err)
+	}
+	return n, nil
+}
+
+// Status は埋め込まれた全てのマイグレーションと、DBにのみ記録されているマイグレーションの適用状況を返す
+func (m *Migrator) Status() ([]Status, error) {
+	migrations, err := m.source.FindMigrations()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migrations: %w", err)
+	}
+
+	records, err := m.set.GetMigrationRecords(m.db, m.dialect)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get migration records: %w", err)
+	}
+
+	applied := make(map[string]time.Time, len(records))
+	for _, r := range records {
+		applied[r.Id] = r.AppliedAt
+	}
+
+	statuses := make([]Status, 0, len(migrations))
+	known := make(map[string]bool, len(migrations))
+	for _, mig := range migrations {
+		known[mig.Id] = true
+		status := Status{ID: mig.Id}
+		if at, ok := applied[mig.Id]; ok {
+			appliedAt := at
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+
+	// バイナリより新しいバージョンで適用されたマイグレーション
+	for _, r := range records {
+		if !known[r.Id] {
+			appliedAt := r.AppliedAt
+			statuses = append(statuses, Status{ID: r.Id, AppliedAt: &appliedAt})
+		}
+	}
+
+	return statuses, nil
+}
+
+// Pending は未適用のマイグレーションのIDを古い順に返す
+func (m *Migrator) Pending() ([]string, error) {
+	planned, _, err := m.set.PlanMigration(m.db, m.dialect, m.source, migrate.Up, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan migrations: %w", err)
+	}
+
+	ids := make([]string, len(planned))
+	for i, p := range planned {
+		ids[i] = p.Id
+	}
+	return ids, nil
+}
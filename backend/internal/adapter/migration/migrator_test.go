@@ -0,0 +1,214 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	_ "github.com/glebarez/go-sqlite"
+)
+
+func TestMigrator_Source(t *testing.T) {
+	m, err := NewMigrator(nil, "mysql")
+	if err != nil {
+		t.Fatalf("failed to create migrator: %v", err)
+	}
+
+	migrations, err := m.source.FindMigrations()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(migrations) == 0 {
+		t.Fatal("expected embedded migrations, got none")
+	}
+	if migrations[0].Id != "001_init.sql" {
+		t.Errorf("expected first migration 001_init.sql, got %s", migrations[0].Id)
+	}
+	for i, mig := range migrations {
+		if len(mig.Up) == 0 {
+			t.Errorf("migration %s has no up statements", mig.Id)
+		}
+		if i > 0 && !migrations[i-1].Less(mig) {
+			t.Errorf("migrations are not sorted: %s before %s", migrations[i-1].Id, mig.Id)
+		}
+	}
+}
+
+func TestMigrator_Down_InvalidSteps(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps int
+	}{
+		{name: "異常系: 0件", steps: 0},
+		{name: "異常系: 負数", steps: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewMigrator(nil, "mysql")
+			if err != nil {
+				t.Fatalf("failed to create migrator: %v", err)
+			}
+			if _, err := m.Down(context.Background(), tt.steps); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestMigrator_StatusAndPending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	m, err := NewMigrator(db, "mysql")
+	if err != nil {
+		t.Fatalf("failed to create migrator: %v", err)
+	}
+	migrations, err := m.source.FindMigrations()
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+
+	appliedAt := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	recordRows := func() *sqlmock.Rows {
+		// 最新以外のマイグレーションと、バイナリに存在しないマイグレーションが適用済み
+		rows := sqlmock.NewRows([]string{"id", "applied_at"})
+		for _, mig := range migrations[:len(migrations)-1] {
+			rows.AddRow(mig.Id, appliedAt)
+		}
+		rows.AddRow("999_future.sql", appliedAt)
+		return rows
+	}
+
+	// sql-migrate は MySQL で parseTime が有効かどうかを確認する
+	mock.ExpectQuery("SELECT NOW\\(\\)").WillReturnRows(sqlmock.NewRows([]string{"NOW()"}).AddRow(appliedAt))
+	mock.ExpectExec("create table if not exists `gorp_migrations`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT \\* FROM `gorp_migrations`").WillReturnRows(recordRows())
+
+	statuses, err := m.Status()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(statuses) != len(migrations)+1 {
+		t.Fatalf("expected %d statuses, got %d", len(migrations)+1, len(statuses))
+	}
+	if statuses[0].AppliedAt == nil || !statuses[0].AppliedAt.Equal(appliedAt) {
+		t.Errorf("expected first migration applied at %v, got %v", appliedAt, statuses[0].AppliedAt)
+	}
+	if statuses[len(migrations)-1].AppliedAt != nil {
+		t.Errorf("expected latest migration to be pending, got %v", statuses[len(migrations)-1].AppliedAt)
+	}
+	if statuses[len(migrations)].ID != "999_future.sql" {
+		t.Errorf("expected unknown migration to be listed last, got %s", statuses[len(migrations)].ID)
+	}
+
+	mock.ExpectQuery("SELECT NOW\\(\\)").WillReturnRows(sqlmock.NewRows([]string{"NOW()"}).AddRow(appliedAt))
+	mock.ExpectExec("create table if not exists `gorp_migrations`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT \\* FROM `gorp_migrations`").WillReturnRows(recordRows())
+
+	pending, err := m.Pending()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(pending) != 1 || pending[0] != migrations[len(migrations)-1].Id {
+		t.Errorf("expected pending [%s], got %v", migrations[len(migrations)-1].Id, pending)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestMigrator_SQLite(t *testing.T) {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "mawinter.db"))
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+	defer db.Close()
+
+	m, err := NewMigrator(db, "sqlite")
+	if err != nil {
+		t.Fatalf("failed to create migrator: %v", err)
+	}
+	migrations, err := m.source.FindMigrations()
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+
+	n, err := m.Up(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if n != len(migrations) {
+		t.Errorf("expected %d migrations applied, got %d", len(migrations), n)
+	}
+
+	pending, err := m.Pending()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("expected no pending migrations, got %v", pending)
+	}
+
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM `Category` WHERE `category_type` <> 0").Scan(&count); err != nil {
+		t.Fatalf("failed to query categories: %v", err)
+	}
+	if count != 23 {
+		t.Errorf("expected 23 categories with type, got %d", count)
+	}
+
+	// 全てロールバックできること
+	n, err = m.Down(context.Background(), len(migrations))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if n != len(migrations) {
+		t.Errorf("expected %d migrations rolled back, got %d", len(migrations), n)
+	}
+}
+
+func TestMigrator_SameIDsForAllDrivers(t *testing.T) {
+	var ids map[string]bool
+	for driver := range dialects {
+		m, err := NewMigrator(nil, driver)
+		if err != nil {
+			t.Fatalf("failed to create migrator: %v", err)
+		}
+		migrations, err := m.source.FindMigrations()
+		if err != nil {
+			t.Fatalf("failed to load migrations for %s: %v", driver, err)
+		}
+
+		got := make(map[string]bool, len(migrations))
+		for _, mig := range migrations {
+			got[mig.Id] = true
+		}
+		if ids == nil {
+			ids = got
+			continue
+		}
+		if len(got) != len(ids) {
+			t.Errorf("%s has %d migrations, want %d", driver, len(got), len(ids))
+		}
+		for id := range ids {
+			if !got[id] {
+				t.Errorf("%s is missing migration %s", driver, id)
+			}
+		}
+	}
+}
+
+func TestNewMigrator_UnsupportedDriver(t *testing.T) {
+	if _, err := NewMigrator(nil, "oracle"); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
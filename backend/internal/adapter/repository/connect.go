@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/azuki774/mawinter/pkg/config"
+	"gorm.io/gorm"
+)
+
+// PoolStatsInterval はコネクションプールの統計をログに出力する間隔
+const PoolStatsInterval = time.Minute
+
+// Open はデータベースに接続し、コネクションプールを設定する
+// docker compose などでデータベースの起動を待てるよう、接続に失敗した場合は retry の設定に従って再試行する
+func Open(ctx context.Context, dialector gorm.Dialector, pool config.PoolConfig, retry config.RetryConfig) (*gorm.DB, error) {
+	var db *gorm.DB
+	err := retryConnect(ctx, retry, func() error {
+		var err error
+		db, err = gorm.Open(dialector, &gorm.Config{})
+		if err != nil && db != nil {
+			// 接続確認に失敗した場合も生成されたコネクションプールは閉じる
+			if sqlDB, dbErr := db.DB(); dbErr == nil {
+				sqlDB.Close()
+			}
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database handle: %w", err)
+	}
+	sqlDB.SetMaxOpenConns(pool.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(pool.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(pool.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(pool.ConnMaxIdleTime)
+	return db, nil
+}
+
+// retryConnect は connect が成功するまで、待ち時間を倍にしながら retry.Timeout まで再試行する
+// retry.Timeout が 0 の場合は再試行しない
+func retryConnect(ctx context.Context, retry config.RetryConfig, connect func() error) error {
+	deadline := time.Now().Add(retry.Timeout)
+	interval := retry.InitialInterval
+	for attempt := 1; ; attempt++ {
+		err := connect()
+		if err == nil {
+			return nil
+		}
+		remaining := time.Until(deadline)
+		if retry.Timeout <= 0 || remaining <= 0 {
+			return fmt.Errorf("failed to connect to database after %d attempt(s): %w", attempt, err)
+		}
+		// 最後の再試行は期限ちょうどに行う
+		wait := min(interval, remaining)
+
+		slog.WarnContext(ctx, "Failed to connect to database; retrying",
+			slog.Int("attempt", attempt),
+			slog.String("retry_in", wait.String()),
+			slog.String("error", err.Error()),
+		)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("gave up connecting to database: %w (last error: %v)", ctx.Err(), err)
+		case <-timer.C:
+		}
+		interval = min(interval*2, retry.MaxInterval)
+	}
+}
+
+// LogPoolStats はコンテキストがキャンセルされるまで、一定間隔でコネクションプールの統計をデバッグログに出力する
+// 同じ値はメトリクス（go_sql_connections_*）としても公開される
+func LogPoolStats(ctx context.Context, db *gorm.DB, interval time.Duration) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get database handle", slog.String("error", err.Error()))
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			slog.DebugContext(ctx, "Database connection pool stats", PoolStatsAttrs(sqlDB.Stats())...)
+		}
+	}
+}
+
+// PoolStatsAttrs はコネクションプールの統計をログの属性に変換する
+func PoolStatsAttrs(stats sql.DBStats) []any {
+	return []any{
+		slog.Int("max_open", stats.MaxOpenConnections),
+		slog.Int("open", stats.OpenConnections),
+		slog.Int("in_use", stats.InUse),
+		slog.Int("idle", stats.Idle),
+		slog.Int64("wait_count", stats.WaitCount),
+		slog.String("wait_duration", stats.WaitDuration.String()),
+		slog.Int64("max_idle_closed", stats.MaxIdleClosed),
+		slog.Int64("max_idle_time_closed", stats.MaxIdleTimeClosed),
+		slog.Int64("max_lifetime_closed", stats.MaxLifetimeClosed),
+	}
+}
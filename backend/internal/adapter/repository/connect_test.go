@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/azuki774/mawinter/pkg/config"
+	"github.com/glebarez/sqlite"
+)
+
+func TestRetryConnect(t *testing.T) {
+	retry := config.RetryConfig{Timeout: time.Second, InitialInterval: time.Millisecond, MaxInterval: 4 * time.Millisecond}
+
+	tests := []struct {
+		name         string
+		retry        config.RetryConfig
+		failures     int // connect が失敗する回数
+		cancel       bool
+		wantErr      bool
+		wantAttempts int
+	}{
+		{name: "正常系: 初回で接続できる", retry: retry, failures: 0, wantAttempts: 1},
+		{name: "正常系: 再試行して接続できる", retry: retry, failures: 5, wantAttempts: 6},
+		{name: "異常系: リトライしない設定", retry: config.RetryConfig{}, failures: 1, wantErr: true, wantAttempts: 1},
+		{
+			name:         "異常系: タイムアウトまで接続できない",
+			retry:        config.RetryConfig{Timeout: 10 * time.Millisecond, InitialInterval: 2 * time.Millisecond, MaxInterval: 4 * time.Millisecond},
+			failures:     1000,
+			wantErr:      true,
+			wantAttempts: -1, // 回数は実行環境に依存する
+		},
+		{name: "異常系: キャンセルされた", retry: retry, failures: 1000, cancel: true, wantErr: true, wantAttempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancel {
+				cancel()
+			}
+
+			attempts := 0
+			err := retryConnect(ctx, tt.retry, func() error {
+				attempts++
+				if attempts <= tt.failures {
+					return errors.New("connection refused")
+				}
+				return nil
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("retryConnect() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantAttempts >= 0 && attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+			if tt.wantAttempts < 0 && attempts < 2 {
+				t.Errorf("expected retries before timeout, got %d attempt(s)", attempts)
+			}
+		})
+	}
+}
+
+func TestOpen(t *testing.T) {
+	pool := config.PoolConfig{MaxOpenConns: 3, MaxIdleConns: 2, ConnMaxLifetime: time.Minute, ConnMaxIdleTime: time.Second}
+	db, err := Open(context.Background(), sqlite.Open(filepath.Join(t.TempDir(), "mawinter.db")), pool, config.RetryConfig{})
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	defer sqlDB.Close()
+
+	if got := sqlDB.Stats().MaxOpenConnections; got != pool.MaxOpenConns {
+		t.Errorf("max open connections = %d, want %d", got, pool.MaxOpenConns)
+	}
+}
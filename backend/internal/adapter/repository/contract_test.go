@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/azuki774/mawinter/internal/adapter/migration"
+	"github.com/azuki774/mawinter/internal/domain/domaintest"
+	"github.com/glebarez/sqlite"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// migrateForTest はマイグレーションを適用する
+func migrateForTest(t *testing.T, gormDB *gorm.DB) {
+	t.Helper()
+
+	sqlDB, err := gormDB.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+
+	migrator, err := migration.NewMigrator(sqlDB, gormDB.Dialector.Name())
+	if err != nil {
+		t.Fatalf("failed to create migrator: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to apply migrations: %v", err)
+	}
+}
+
+// openForTest はテスト用にデータベースへ接続する
+func openForTest(t *testing.T, dialector gorm.Dialector) *gorm.DB {
+	t.Helper()
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+
+	sqlDB, err := gormDB.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	return gormDB
+}
+
+// repositoriesForTest はマイグレーション済みの接続から、共通のテストで使うリポジトリを生成する
+func repositoriesForTest(gormDB *gorm.DB) domaintest.Repositories {
+	return domaintest.Repositories{
+		Categories: NewCategoryRepository(gormDB),
+		Records:    NewRecordRepository(gormDB),
+		LockMonth: func(t *testing.T, yyyymm string) {
+			t.Helper()
+			if err := gormDB.Create(&MonthlyConfirmModel{YYYYMM: yyyymm, Confirm: 1}).Error; err != nil {
+				t.Fatalf("failed to lock %s: %v", yyyymm, err)
+			}
+		},
+		RemoveCategory: func(t *testing.T, categoryID int) {
+			t.Helper()
+			var category CategoryModel
+			if err := gormDB.Where("category_id = ?", categoryID).First(&category).Error; err != nil {
+				t.Fatalf("failed to get category %d: %v", categoryID, err)
+			}
+			if err := gormDB.Delete(&category).Error; err != nil {
+				t.Fatalf("failed to remove category %d: %v", categoryID, err)
+			}
+			// 既存のデータベースサーバで実行した場合に後のテストに影響しないよう、終了時に元に戻す
+			t.Cleanup(func() {
+				if err := gormDB.Create(&category).Error; err != nil {
+					t.Errorf("failed to restore category %d: %v", categoryID, err)
+				}
+			})
+		},
+	}
+}
+
+func TestRepositoryContract_SQLite(t *testing.T) {
+	domaintest.RunRepositoryContract(t, func(t *testing.T) domaintest.Repositories {
+		gormDB := openForTest(t, sqlite.Open(filepath.Join(t.TempDir(), "mawinter.db")))
+		migrateForTest(t, gormDB)
+		return repositoriesForTest(gormDB)
+	})
+}
+
+// 以下は接続先が環境変数で指定された場合のみ実行する
+// テストごとにレコードと履歴を全て削除するため、テスト専用のデータベースを指定すること
+
+func TestRepositoryContract_MySQL(t *testing.T) {
+	dsn := os.Getenv("MAWINTER_TEST_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("MAWINTER_TEST_MYSQL_DSN is not set")
+	}
+	runContractOnServer(t, mysql.Open(dsn))
+}
+
+func TestRepositoryContract_Postgres(t *testing.T) {
+	dsn := os.Getenv("MAWINTER_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("MAWINTER_TEST_POSTGRES_DSN is not set")
+	}
+	runContractOnServer(t, postgres.Open(dsn))
+}
+
+// runContractOnServer は既存のデータベースサーバに対して共通のテストを実行する
+func runContractOnServer(t *testing.T, dialector gorm.Dialector) {
+	domaintest.RunRepositoryContract(t, func(t *testing.T) domaintest.Repositories {
+		gormDB := openForTest(t, dialector)
+		migrateForTest(t, gormDB)
+
+		for _, table := range []string{"Record", "Record_History", "Monthly_Confirm"} {
+			if err := gormDB.Exec("DELETE FROM " + quoteIdent(gormDB, table)).Error; err != nil {
+				t.Fatalf("failed to clean up %s: %v", table, err)
+			}
+		}
+		return repositoriesForTest(gormDB)
+	})
+}
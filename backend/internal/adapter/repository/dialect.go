@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/domain"
+	"gorm.io/gorm"
+)
+
+// quoteIdent はテーブル名・カラム名をデータベースに合わせてクォートする
+// PostgreSQL では大文字を含むテーブル名（Record など）や予約語のカラム名（from など）をクォートしないと参照できないため、
+// 生の SQL でテーブル名・カラム名を書く場合は必ずこれを通す
+// "Record_History.datetime" のようにテーブル名を付けた場合はそれぞれをクォートする
+func quoteIdent(db *gorm.DB, name string) string {
+	var b strings.Builder
+	db.Dialector.QuoteTo(&b, name)
+	return b.String()
+}
+
+// yearExpr は日時カラムから年（整数）を取り出す SQL 式を返す
+// SQLite は日時をローカル時刻のテキストで保存するため、先頭4文字を切り出す
+// PostgreSQL はセッションのタイムゾーンで年を取り出す
+// （strftime はタイムゾーン付きの値を UTC に変換してしまうため使用しない）
+func yearExpr(db *gorm.DB, column string) string {
+	switch db.Dialector.Name() {
+	case "sqlite":
+		return fmt.Sprintf("CAST(substr(%s, 1, 4) AS INTEGER)", column)
+	case "postgres":
+		return fmt.Sprintf("CAST(EXTRACT(YEAR FROM %s) AS INTEGER)", column)
+	default:
+		return fmt.Sprintf("YEAR(%s)", column)
+	}
+}
+
+// monthExpr は日時カラムから月（整数）を取り出す SQL 式を返す
+func monthExpr(db *gorm.DB, column string) string {
+	switch db.Dialector.Name() {
+	case "sqlite":
+		return fmt.Sprintf("CAST(substr(%s, 6, 2) AS INTEGER)", column)
+	case "postgres":
+		return fmt.Sprintf("CAST(EXTRACT(MONTH FROM %s) AS INTEGER)", column)
+	default:
+		return fmt.Sprintf("MONTH(%s)", column)
+	}
+}
+
+// monthRange は YYYYMM 形式の文字列から、その月の初日と翌月の初日を返す
+// 日付の計算を Go 側で行うことで、データベースごとの日付関数の違いを吸収する
+func monthRange(yyyymm string) (time.Time, time.Time, error) {
+	if len(yyyymm) != 6 {
+		return time.Time{}, time.Time{}, domain.NewValidationError("yyyymm", fmt.Sprintf("must be in YYYYMM format: %q", yyyymm))
+	}
+	start, err := time.ParseInLocation("200601", yyyymm, time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, domain.NewValidationError("yyyymm", fmt.Sprintf("must be in YYYYMM format: %q", yyyymm))
+	}
+	return start, start.AddDate(0, 1, 0), nil
+}
+
+// fiscalYearRange は会計年度の開始日（4月1日）と翌年度の開始日を返す
+func fiscalYearRange(year int) (time.Time, time.Time) {
+	start := time.Date(year, time.April, 1, 0, 0, 0, 0, time.Local)
+	return start, start.AddDate(1, 0, 0)
+}
+
+// bucketExpr は日時カラムが属する区切りの番号（0 始まり）を求める SQL 式と、そのパラメータを返す
+// 区切りの境界をパラメータで渡すため、データベースごとの日付関数の違いによらず同じ式を使える
+// 期間の絞り込みは呼び出し側で行う。期間外の日時は最後の番号の次（len(buckets)）とする
+func bucketExpr(column string, buckets []domain.SummaryRange) (string, []any) {
+	var b strings.Builder
+	args := make([]any, 0, len(buckets))
+	b.WriteString("CASE")
+	for i, bucket := range buckets {
+		fmt.Fprintf(&b, " WHEN %s < ? THEN %d", column, i)
+		args = append(args, bucket.To)
+	}
+	fmt.Fprintf(&b, " ELSE %d END", len(buckets))
+	return b.String(), args
+}
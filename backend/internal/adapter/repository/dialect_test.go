@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/azuki774/mawinter/internal/domain"
+	"github.com/glebarez/sqlite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// setupPostgresMockDB はテスト用の PostgreSQL 方言のモックDBを作成する
+func setupPostgresMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open gorm DB: %v", err)
+	}
+
+	return gormDB, mock
+}
+
+func TestDialectExpressions(t *testing.T) {
+	mysqlDB, _ := setupMockDB(t)
+	postgresDB, _ := setupPostgresMockDB(t)
+	sqliteDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		db        *gorm.DB
+		wantYear  string
+		wantMonth string
+		wantQuote string
+	}{
+		{
+			name:      "正常系: MySQL",
+			db:        mysqlDB,
+			wantYear:  "YEAR(datetime)",
+			wantMonth: "MONTH(datetime)",
+			wantQuote: "`Record_History`.`from`",
+		},
+		{
+			name:      "正常系: SQLite",
+			db:        sqliteDB,
+			wantYear:  "CAST(substr(datetime, 1, 4) AS INTEGER)",
+			wantMonth: "CAST(substr(datetime, 6, 2) AS INTEGER)",
+			wantQuote: "`Record_History`.`from`",
+		},
+		{
+			name:      "正常系: PostgreSQL",
+			db:        postgresDB,
+			wantYear:  "CAST(EXTRACT(YEAR FROM datetime) AS INTEGER)",
+			wantMonth: "CAST(EXTRACT(MONTH FROM datetime) AS INTEGER)",
+			wantQuote: `"Record_History"."from"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := yearExpr(tt.db, "datetime"); got != tt.wantYear {
+				t.Errorf("yearExpr() = %s, want %s", got, tt.wantYear)
+			}
+			if got := monthExpr(tt.db, "datetime"); got != tt.wantMonth {
+				t.Errorf("monthExpr() = %s, want %s", got, tt.wantMonth)
+			}
+			if got := quoteIdent(tt.db, "Record_History.from"); got != tt.wantQuote {
+				t.Errorf("quoteIdent() = %s, want %s", got, tt.wantQuote)
+			}
+		})
+	}
+}
+
+func TestMonthRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		yyyymm    string
+		wantStart time.Time
+		wantEnd   time.Time
+		wantErr   bool
+	}{
+		{
+			name:      "正常系: 月末が31日の月",
+			yyyymm:    "202510",
+			wantStart: time.Date(2025, 10, 1, 0, 0, 0, 0, time.Local),
+			wantEnd:   time.Date(2025, 11, 1, 0, 0, 0, 0, time.Local),
+		},
+		{
+			name:      "正常系: 12月は翌年1月まで",
+			yyyymm:    "202412",
+			wantStart: time.Date(2024, 12, 1, 0, 0, 0, 0, time.Local),
+			wantEnd:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.Local),
+		},
+		{
+			name:    "異常系: 桁数が不正",
+			yyyymm:  "20241",
+			wantErr: true,
+		},
+		{
+			name:    "異常系: 月が不正",
+			yyyymm:  "202413",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := monthRange(tt.yyyymm)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("monthRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !start.Equal(tt.wantStart) {
+				t.Errorf("start = %v, want %v", start, tt.wantStart)
+			}
+			if !end.Equal(tt.wantEnd) {
+				t.Errorf("end = %v, want %v", end, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestRecordRepository_GetAvailablePeriods_Postgres(t *testing.T) {
+	gormDB, mock := setupPostgresMockDB(t)
+
+	rows := sqlmock.NewRows([]string{"year", "month"}).
+		AddRow(2024, 4).
+		AddRow(2024, 3)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT CAST(EXTRACT(YEAR FROM datetime) AS INTEGER) AS year, CAST(EXTRACT(MONTH FROM datetime) AS INTEGER) AS month FROM "Record" WHERE "Record"."deleted_at" IS NULL ORDER BY year DESC, month DESC`)).
+		WillReturnRows(rows)
+
+	repo := NewRecordRepository(gormDB)
+	yyyymm, fy, err := repo.GetAvailablePeriods(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(yyyymm) != 2 || yyyymm[0] != "202404" || yyyymm[1] != "202403" {
+		t.Errorf("unexpected yyyymm: %v", yyyymm)
+	}
+	if len(fy) != 2 || fy[0] != "2024" || fy[1] != "2023" {
+		t.Errorf("unexpected fy: %v", fy)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestRecordRepository_GetYearSummary_Postgres(t *testing.T) {
+	gormDB, mock := setupPostgresMockDB(t)
+
+	categoryRows := sqlmock.NewRows([]string{"id", "category_id", "name", "category_type"}).
+		AddRow(5, 210, "食費", 2)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "Category"`)).
+		WillReturnRows(categoryRows)
+
+	summaryRows := sqlmock.NewRows([]string{"category_id", "fiscal_month", "total_price", "count"}).
+		AddRow(210, 1, 1000, 2)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT category_id, CASE WHEN CAST(EXTRACT(MONTH FROM datetime) AS INTEGER) >= 4 THEN CAST(EXTRACT(MONTH FROM datetime) AS INTEGER) - 3 ELSE CAST(EXTRACT(MONTH FROM datetime) AS INTEGER) + 9 END as fiscal_month, SUM(price) as total_price, COUNT(*) as count FROM "Record" WHERE datetime >= $1 AND datetime < $2 AND deleted_at IS NULL GROUP BY category_id, fiscal_month ORDER BY category_id, fiscal_month`)).
+		WithArgs(time.Date(2024, 4, 1, 0, 0, 0, 0, time.Local), time.Date(2025, 4, 1, 0, 0, 0, 0, time.Local)).
+		WillReturnRows(summaryRows)
+
+	repo := NewRecordRepository(gormDB)
+	summaries, err := repo.GetYearSummary(context.Background(), 2024)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(summaries) != 1 || summaries[0].Price[0] != 1000 || summaries[0].Count != 2 {
+		t.Errorf("unexpected summaries: %+v", summaries)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestRecordRepository_GetPeriodSummary_Postgres(t *testing.T) {
+	gormDB, mock := setupPostgresMockDB(t)
+
+	categoryRows := sqlmock.NewRows([]string{"id", "category_id", "name", "category_type"}).
+		AddRow(5, 210, "食費", 2)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "Category"`)).
+		WillReturnRows(categoryRows)
+
+	// 区切りの境界をパラメータとした CASE 式で区切りの番号を求める
+	date := func(year int, month time.Month) time.Time { return time.Date(year, month, 1, 0, 0, 0, 0, time.Local) }
+	summaryRows := sqlmock.NewRows([]string{"category_id", "bucket", "total_price", "count"}).
+		AddRow(210, 0, 1000, 2).
+		AddRow(210, 3, 500, 1)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT category_id, CASE WHEN datetime < $1 THEN 0 WHEN datetime < $2 THEN 1 WHEN datetime < $3 THEN 2 WHEN datetime < $4 THEN 3 ELSE 4 END as bucket, SUM(price) as total_price, COUNT(*) as count FROM "Record" WHERE datetime >= $5 AND datetime < $6 AND deleted_at IS NULL GROUP BY category_id, bucket ORDER BY category_id, bucket`)).
+		WithArgs(date(2025, 4), date(2025, 7), date(2025, 10), date(2026, 1), date(2025, 1), date(2026, 1)).
+		WillReturnRows(summaryRows)
+
+	period, err := domain.CalendarYearPeriod(2025, domain.SummaryBucketQuarter)
+	if err != nil {
+		t.Fatalf("CalendarYearPeriod() error = %v", err)
+	}
+	repo := NewRecordRepository(gormDB)
+	summaries, err := repo.GetPeriodSummary(context.Background(), period)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(summaries) != 1 || len(summaries[0].Price) != 4 || summaries[0].Price[0] != 1000 || summaries[0].Price[3] != 500 || summaries[0].Total != 1500 || summaries[0].Count != 3 {
+		t.Errorf("unexpected summaries: %+v", summaries)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
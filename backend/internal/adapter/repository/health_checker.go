@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// DatabaseHealthChecker はデータベースへの接続を確認する
+// domain.HealthChecker を実装する
+type DatabaseHealthChecker struct {
+	db *gorm.DB
+}
+
+// NewDatabaseHealthChecker は新しい DatabaseHealthChecker を作成する
+func NewDatabaseHealthChecker(db *gorm.DB) *DatabaseHealthChecker {
+	return &DatabaseHealthChecker{db: db}
+}
+
+// Name はコンポーネント名を返す
+func (c *DatabaseHealthChecker) Name() string {
+	return "database"
+}
+
+// Check はデータベースに ping を送り、接続できることを確認する
+func (c *DatabaseHealthChecker) Check(ctx context.Context) (string, error) {
+	sqlDB, err := c.db.DB()
+	if err != nil {
+		return "", fmt.Errorf("failed to get database handle: %w", err)
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return "", fmt.Errorf("failed to ping database: %w", err)
+	}
+	return c.db.Dialector.Name(), nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func TestDatabaseHealthChecker_Check(t *testing.T) {
+	tests := []struct {
+		name    string
+		pingErr error
+		wantErr bool
+	}{
+		{name: "正常系: 接続できる", pingErr: nil, wantErr: false},
+		{name: "異常系: 接続できない", pingErr: errors.New("connection refused"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			gormDB, err := gorm.Open(mysql.New(mysql.Config{
+				Conn:                      db,
+				SkipInitializeWithVersion: true,
+			}), &gorm.Config{DisableAutomaticPing: true})
+			if err != nil {
+				t.Fatalf("failed to open gorm DB: %v", err)
+			}
+
+			mock.ExpectPing().WillReturnError(tt.pingErr)
+
+			checker := NewDatabaseHealthChecker(gormDB)
+			detail, err := checker.Check(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Check() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && detail != "mysql" {
+				t.Errorf("expected detail mysql, got %q", detail)
+			}
+			if checker.Name() != "database" {
+				t.Errorf("expected name database, got %q", checker.Name())
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
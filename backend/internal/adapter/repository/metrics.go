@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"gorm.io/gorm"
+)
+
+// metricsStartKey はクエリの開始時刻を保持するインスタンス変数のキー
+const metricsStartKey = "mawinter:metrics:start"
+
+// MetricsPlugin は GORM で実行したクエリの所要時間をメトリクスとして記録するプラグイン
+// 操作（create, query, update, delete, row, raw）とテーブルごとに集計する
+type MetricsPlugin struct {
+	duration metric.Float64Histogram
+}
+
+// NewMetricsPlugin は新しい MetricsPlugin を作成する
+// グローバルなメータープロバイダーを使用する
+func NewMetricsPlugin() (*MetricsPlugin, error) {
+	duration, err := otel.Meter("github.com/azuki774/mawinter/internal/adapter/repository").Float64Histogram(
+		"db.client.operation.duration",
+		metric.WithDescription("Duration of database queries executed through GORM"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &MetricsPlugin{duration: duration}, nil
+}
+
+// Name はプラグイン名を返す
+func (p *MetricsPlugin) Name() string {
+	return "mawinter:metrics"
+}
+
+// Initialize は各操作の前後にコールバックを登録する
+func (p *MetricsPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	type register func(name string, fn func(*gorm.DB)) error
+	hooks := []struct {
+		operation     string
+		before, after register
+	}{
+		{"create", cb.Create().Before("*").Register, cb.Create().After("*").Register},
+		{"query", cb.Query().Before("*").Register, cb.Query().After("*").Register},
+		{"update", cb.Update().Before("*").Register, cb.Update().After("*").Register},
+		{"delete", cb.Delete().Before("*").Register, cb.Delete().After("*").Register},
+		{"row", cb.Row().Before("*").Register, cb.Row().After("*").Register},
+		{"raw", cb.Raw().Before("*").Register, cb.Raw().After("*").Register},
+	}
+
+	for _, h := range hooks {
+		if err := h.before(p.Name()+":before_"+h.operation, p.before); err != nil {
+			return err
+		}
+		if err := h.after(p.Name()+":after_"+h.operation, p.after(h.operation)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *MetricsPlugin) before(db *gorm.DB) {
+	db.InstanceSet(metricsStartKey, time.Now())
+}
+
+func (p *MetricsPlugin) after(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.InstanceGet(metricsStartKey)
+		if !ok {
+			return
+		}
+		start, ok := v.(time.Time)
+		if !ok {
+			return
+		}
+
+		outcome := "success"
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			outcome = "error"
+		}
+
+		p.duration.Record(db.Statement.Context, time.Since(start).Seconds(), metric.WithAttributes(
+			attribute.String("db.system.name", db.Dialector.Name()),
+			attribute.String("db.operation.name", operation),
+			attribute.String("db.collection.name", db.Statement.Table),
+			attribute.String("outcome", outcome),
+		))
+	}
+}
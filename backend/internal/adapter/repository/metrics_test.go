@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/domain"
+	"github.com/glebarez/sqlite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestMetricsPlugin(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	prev := otel.GetMeterProvider()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	t.Cleanup(func() { otel.SetMeterProvider(prev) })
+
+	gormDB := openForTest(t, sqlite.Open(filepath.Join(t.TempDir(), "mawinter.db")))
+	migrateForTest(t, gormDB)
+
+	plugin, err := NewMetricsPlugin()
+	if err != nil {
+		t.Fatalf("NewMetricsPlugin() error = %v", err)
+	}
+	if err := gormDB.Use(plugin); err != nil {
+		t.Fatalf("failed to register plugin: %v", err)
+	}
+
+	ctx := context.Background()
+	repo := NewRecordRepository(gormDB)
+	created, err := repo.Create(ctx, &domain.Record{CategoryID: 210, Datetime: time.Now(), Price: 1280})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := repo.FindByID(ctx, created.ID); err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	// 見つからない場合はエラーとして扱わない
+	if _, err := repo.FindByID(ctx, created.ID+1); err == nil {
+		t.Fatal("expected not found error")
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatalf("failed to collect metrics: %v", err)
+	}
+
+	counts := make(map[string]uint64)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "db.client.operation.duration" {
+				continue
+			}
+			hist, ok := m.Data.(metricdata.Histogram[float64])
+			if !ok {
+				t.Fatalf("unexpected data type %T", m.Data)
+			}
+			for _, dp := range hist.DataPoints {
+				system, _ := dp.Attributes.Value(attribute.Key("db.system.name"))
+				if system.AsString() != "sqlite" {
+					t.Errorf("unexpected db.system.name: %v", system)
+				}
+				op, _ := dp.Attributes.Value(attribute.Key("db.operation.name"))
+				table, _ := dp.Attributes.Value(attribute.Key("db.collection.name"))
+				outcome, _ := dp.Attributes.Value(attribute.Key("outcome"))
+				counts[op.AsString()+" "+table.AsString()+" "+outcome.AsString()] += dp.Count
+			}
+		}
+	}
+
+	if counts["create Record success"] != 1 {
+		t.Errorf("expected 1 record insert, got %v", counts)
+	}
+	if counts["query Record success"] != 2 {
+		t.Errorf("expected 2 record queries, got %v", counts)
+	}
+	if counts["query Record error"] != 0 {
+		t.Errorf("expected not found to be counted as success, got %v", counts)
+	}
+}
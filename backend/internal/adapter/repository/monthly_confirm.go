@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/azuki774/mawinter/internal/domain"
+	"gorm.io/gorm"
+)
+
+// MonthlyConfirmModel はMonthly_Confirmテーブル（月次の確定状態）のGORMモデル
+// confirm が 1 の月は確定済みとし、その月のレコードは変更できない
+type MonthlyConfirmModel struct {
+	YYYYMM          string     `gorm:"column:yyyymm;primaryKey"`
+	Confirm         int        `gorm:"column:confirm;not null"`
+	ConfirmDatetime *time.Time `gorm:"column:confirm_datetime"`
+}
+
+// TableName はテーブル名を指定する
+func (MonthlyConfirmModel) TableName() string {
+	return "Monthly_Confirm"
+}
+
+// checkMonthUnlocked は日時が属する月のいずれかが確定済みの場合に MonthLockedError を返す
+// 月の判定は monthRange と同じくローカルタイムゾーンで行う。ゼロ値の日時は無視する
+// 呼び出し元のトランザクション内で実行すること
+func checkMonthUnlocked(tx *gorm.DB, datetimes ...time.Time) error {
+	checked := make(map[string]bool, len(datetimes))
+	for _, datetime := range datetimes {
+		if datetime.IsZero() {
+			continue
+		}
+		yyyymm := datetime.In(time.Local).Format("200601")
+		if checked[yyyymm] {
+			continue
+		}
+		checked[yyyymm] = true
+
+		var count int64
+		if err := tx.Model(&MonthlyConfirmModel{}).
+			Where("yyyymm = ? AND confirm = ?", yyyymm, 1).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return &domain.MonthLockedError{YYYYMM: yyyymm}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/azuki774/mawinter/internal/domain"
+	"gorm.io/gorm"
+)
+
+// RecordHistoryModel はRecord_HistoryテーブルのGORMモデル
+// Record の作成・更新・削除・復元のたびに、その時点の内容を1版として保存する
+type RecordHistoryModel struct {
+	ID         int       `gorm:"column:id;primaryKey;autoIncrement"`
+	RecordID   int       `gorm:"column:record_id;not null"`
+	Version    int       `gorm:"column:version;not null"`
+	Operation  string    `gorm:"column:operation;not null"`
+	CategoryID int       `gorm:"column:category_id;not null"`
+	Datetime   time.Time `gorm:"column:datetime;not null"`
+	From       string    `gorm:"column:from;not null"`
+	Type       string    `gorm:"column:type;not null"`
+	Price      int       `gorm:"column:price;not null"`
+	Memo       string    `gorm:"column:memo;not null"`
+	Tags       string    `gorm:"column:tags;not null"`
+	RecordedAt time.Time `gorm:"column:recorded_at;not null"`
+}
+
+// TableName はテーブル名を指定する
+func (RecordHistoryModel) TableName() string {
+	return "Record_History"
+}
+
+// ToDomain はGORMモデルをドメインエンティティに変換する
+func (m *RecordHistoryModel) ToDomain(categoryName string) *domain.RecordVersion {
+	return &domain.RecordVersion{
+		Version:   m.Version,
+		Operation: domain.RecordOperation(m.Operation),
+		Record: domain.Record{
+			ID:           m.RecordID,
+			CategoryID:   m.CategoryID,
+			CategoryName: categoryName,
+			Datetime:     m.Datetime,
+			From:         m.From,
+			Type:         m.Type,
+			Price:        m.Price,
+			Memo:         m.Memo,
+			Tags:         splitTags(m.Tags),
+		},
+		RecordedAt: m.RecordedAt,
+	}
+}
+
+// appendHistory はレコードの現在の内容を新しい版として履歴に追加する
+// 呼び出し元のトランザクション内で実行すること
+func appendHistory(tx *gorm.DB, model *RecordModel, op domain.RecordOperation, recordedAt time.Time) error {
+	var latest int
+	if err := tx.Model(&RecordHistoryModel{}).
+		Select("COALESCE(MAX(version), 0)").
+		Where("record_id = ?", model.ID).
+		Scan(&latest).Error; err != nil {
+		return err
+	}
+
+	history := &RecordHistoryModel{
+		RecordID:   model.ID,
+		Version:    latest + 1,
+		Operation:  string(op),
+		CategoryID: model.CategoryID,
+		Datetime:   model.Datetime,
+		From:       model.From,
+		Type:       model.Type,
+		Price:      model.Price,
+		Memo:       model.Memo,
+		Tags:       model.Tags,
+		RecordedAt: recordedAt,
+	}
+	return tx.Create(history).Error
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/azuki774/mawinter/internal/domain"
@@ -11,15 +13,17 @@ import (
 
 // RecordModel はRecordテーブルのGORMモデル
 type RecordModel struct {
-	ID         int       `gorm:"column:id;primaryKey;autoIncrement"`
-	CategoryID int       `gorm:"column:category_id;not null"`
-	Datetime   time.Time `gorm:"column:datetime;not null;default:CURRENT_TIMESTAMP"`
-	From       string    `gorm:"column:from;not null"`
-	Type       string    `gorm:"column:type;not null"`
-	Price      int       `gorm:"column:price;not null"`
-	Memo       string    `gorm:"column:memo;not null"`
-	CreatedAt  time.Time `gorm:"column:created_at;default:CURRENT_TIMESTAMP"`
-	UpdatedAt  time.Time `gorm:"column:updated_at;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP"`
+	ID         int            `gorm:"column:id;primaryKey;autoIncrement"`
+	CategoryID int            `gorm:"column:category_id;not null"`
+	Datetime   time.Time      `gorm:"column:datetime;not null;default:CURRENT_TIMESTAMP"`
+	From       string         `gorm:"column:from;not null"`
+	Type       string         `gorm:"column:type;not null"`
+	Price      int            `gorm:"column:price;not null"`
+	Memo       string         `gorm:"column:memo;not null"`
+	Tags       string         `gorm:"column:tags;not null"` // カンマ区切り
+	CreatedAt  time.Time      `gorm:"column:created_at;default:CURRENT_TIMESTAMP"`
+	UpdatedAt  time.Time      `gorm:"column:updated_at;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP"`
+	DeletedAt  gorm.DeletedAt `gorm:"column:deleted_at;index"`
 }
 
 // TableName はテーブル名を指定する
@@ -30,7 +34,7 @@ func (RecordModel) TableName() string {
 // ToDomain はGORMモデルをドメインエンティティに変換する
 // CategoryNameは別途取得が必要
 func (m *RecordModel) ToDomain(categoryName string) *domain.Record {
-	return &domain.Record{
+	record := &domain.Record{
 		ID:           m.ID,
 		CategoryID:   m.CategoryID,
 		CategoryName: categoryName,
@@ -39,7 +43,13 @@ func (m *RecordModel) ToDomain(categoryName string) *domain.Record {
 		Type:         m.Type,
 		Price:        m.Price,
 		Memo:         m.Memo,
+		Tags:         splitTags(m.Tags),
 	}
+	if m.DeletedAt.Valid {
+		deletedAt := m.DeletedAt.Time
+		record.DeletedAt = &deletedAt
+	}
+	return record
 }
 
 // FromDomain はドメインエンティティからGORMモデルに変換する
@@ -51,6 +61,20 @@ func (m *RecordModel) FromDomain(record *domain.Record) {
 	m.Type = record.Type
 	m.Price = record.Price
 	m.Memo = record.Memo
+	m.Tags = joinTags(record.Tags)
+}
+
+// joinTags はタグを Record テーブルに保存するカンマ区切りの文字列にする
+func joinTags(tags []string) string {
+	return strings.Join(tags, ",")
+}
+
+// splitTags はカンマ区切りのタグを分割する。タグがない場合は空のスライスを返す
+func splitTags(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(s, ",")
 }
 
 // RecordRepository はレコードリポジトリの実装
@@ -66,17 +90,74 @@ func NewRecordRepository(db *gorm.DB) *RecordRepository {
 }
 
 // Create は新しいレコードを作成する
+// レコードの作成と初版の履歴の記録は同一トランザクションで行う
+// 確定済みの月のレコードは作成できない
 func (r *RecordRepository) Create(ctx context.Context, record *domain.Record) (*domain.Record, error) {
 	model := &RecordModel{}
 	model.FromDomain(record)
 
-	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+	// 日時が省略された場合は現在日時とし、確定済みの月の確認・挿入・履歴・戻り値で同じ日時を使う
+	if model.Datetime.IsZero() {
+		model.Datetime = time.Now()
+	}
+
+	// カテゴリが存在しない場合は挿入しない
+	var category *CategoryModel
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if category, err = findRecordCategory(tx, model.CategoryID); err != nil {
+			return err
+		}
+		if err := checkMonthUnlocked(tx, model.Datetime); err != nil {
+			return err
+		}
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+		return appendHistory(tx, model, domain.RecordOperationCreate, time.Now())
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	// カテゴリ名を取得
-	var category CategoryModel
-	if err := r.db.WithContext(ctx).Where("category_id = ?", model.CategoryID).First(&category).Error; err != nil {
+	return model.ToDomain(category.Name), nil
+}
+
+// Update は既存のレコードを更新し、新しい版を履歴に記録する
+// ゴミ箱内のレコード、および更新前・更新後の日時が確定済みの月に属するレコードは更新できない
+func (r *RecordRepository) Update(ctx context.Context, record *domain.Record) (*domain.Record, error) {
+	var model RecordModel
+	var category *CategoryModel
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id = ?", record.ID).First(&model).Error; err != nil {
+			return recordNotFound(err, record.ID)
+		}
+		var err error
+		if category, err = findRecordCategory(tx, record.CategoryID); err != nil {
+			return err
+		}
+		if err := checkMonthUnlocked(tx, model.Datetime, record.Datetime); err != nil {
+			return err
+		}
+
+		model.CategoryID = record.CategoryID
+		if !record.Datetime.IsZero() {
+			model.Datetime = record.Datetime
+		}
+		model.From = record.From
+		model.Type = record.Type
+		model.Price = record.Price
+		model.Memo = record.Memo
+		model.Tags = joinTags(record.Tags)
+
+		if err := tx.Model(&model).
+			Select("category_id", "datetime", "from", "type", "price", "memo", "tags").
+			Updates(&model).Error; err != nil {
+			return err
+		}
+		return appendHistory(tx, &model, domain.RecordOperationUpdate, time.Now())
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -87,7 +168,7 @@ func (r *RecordRepository) Create(ctx context.Context, record *domain.Record) (*
 func (r *RecordRepository) FindByID(ctx context.Context, id int) (*domain.Record, error) {
 	var model RecordModel
 	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
-		return nil, err
+		return nil, recordNotFound(err, id)
 	}
 
 	// カテゴリ名を取得
@@ -105,16 +186,12 @@ func (r *RecordRepository) FindAll(ctx context.Context, num, offset int, yyyymm
 
 	// YYYYMMフィルタ
 	if yyyymm != "" {
-		// YYYYMMをYYYY-MM-01形式に変換
-		if len(yyyymm) != 6 {
-			return nil, fmt.Errorf("invalid yyyymm format: %s", yyyymm)
+		// その月の1日から次の月の1日まで
+		startDate, endDate, err := monthRange(yyyymm)
+		if err != nil {
+			return nil, err
 		}
-		startDate := yyyymm[:4] + "-" + yyyymm[4:6] + "-01"
-		// 次の月の1日を計算（その月の終わりまで）
-		year := yyyymm[:4]
-		month := yyyymm[4:6]
-		endDate := fmt.Sprintf("%s-%s-01", year, month)
-		query = query.Where("datetime >= ? AND datetime < DATE_ADD(?, INTERVAL 1 MONTH)", startDate, endDate)
+		query = query.Where("datetime >= ? AND datetime < ?", startDate, endDate)
 	}
 
 	// カテゴリIDフィルタ
@@ -131,16 +208,11 @@ func (r *RecordRepository) FindAll(ctx context.Context, num, offset int, yyyymm
 	}
 
 	// カテゴリIDのマップを作成（一度に全カテゴリを取得）
-	var categories []*CategoryModel
-	if err := r.db.WithContext(ctx).Find(&categories).Error; err != nil {
+	categoryMap, err := r.categoryNameMap(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	categoryMap := make(map[int]string)
-	for _, cat := range categories {
-		categoryMap[cat.CategoryID] = cat.Name
-	}
-
 	// ドメインエンティティに変換
 	records := make([]*domain.Record, len(models))
 	for i, model := range models {
@@ -157,14 +229,11 @@ func (r *RecordRepository) Count(ctx context.Context, yyyymm string, categoryID
 
 	// YYYYMMフィルタ
 	if yyyymm != "" {
-		if len(yyyymm) != 6 {
-			return 0, fmt.Errorf("invalid yyyymm format: %s", yyyymm)
+		startDate, endDate, err := monthRange(yyyymm)
+		if err != nil {
+			return 0, err
 		}
-		startDate := yyyymm[:4] + "-" + yyyymm[4:6] + "-01"
-		year := yyyymm[:4]
-		month := yyyymm[4:6]
-		endDate := fmt.Sprintf("%s-%s-01", year, month)
-		query = query.Where("datetime >= ? AND datetime < DATE_ADD(?, INTERVAL 1 MONTH)", startDate, endDate)
+		query = query.Where("datetime >= ? AND datetime < ?", startDate, endDate)
 	}
 
 	// カテゴリIDフィルタ
@@ -180,63 +249,177 @@ func (r *RecordRepository) Count(ctx context.Context, yyyymm string, categoryID
 	return int(count), nil
 }
 
-// Delete は指定されたIDのレコードを削除する
+// Delete は指定されたIDのレコードをゴミ箱に移動する（論理削除）
+// RecordModel.DeletedAt により GORM が deleted_at の UPDATE に変換する
+// 確定済みの月のレコードは削除できない
 func (r *RecordRepository) Delete(ctx context.Context, id int) error {
-	result := r.db.WithContext(ctx).Delete(&RecordModel{}, id)
-	if result.Error != nil {
-		return result.Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var model RecordModel
+		if err := tx.Where("id = ?", id).First(&model).Error; err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			// ゴミ箱にある場合は存在しない場合と区別する
+			trashed, err := recordExists(tx.Unscoped(), id)
+			if err != nil {
+				return err
+			}
+			if trashed {
+				return domain.NewConflictError("record %d is already in trash", id)
+			}
+			return domain.NewNotFoundError("record", id)
+		}
+		if err := checkMonthUnlocked(tx, model.Datetime); err != nil {
+			return err
+		}
+
+		result := tx.Delete(&model)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.NewNotFoundError("record", id)
+		}
+		return appendHistory(tx, &model, domain.RecordOperationDelete, time.Now())
+	})
+}
+
+// FindDeleted はゴミ箱内のレコードを削除日時の新しい順に取得する
+func (r *RecordRepository) FindDeleted(ctx context.Context, num, offset int) ([]*domain.Record, error) {
+	var models []*RecordModel
+	if err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").
+		Order("id DESC").
+		Limit(num).
+		Offset(offset).
+		Find(&models).Error; err != nil {
+		return nil, err
 	}
-	if result.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
+
+	categoryMap, err := r.categoryNameMap(ctx)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+
+	records := make([]*domain.Record, len(models))
+	for i, model := range models {
+		records[i] = model.ToDomain(categoryMap[model.CategoryID])
+	}
+
+	return records, nil
 }
 
-// GetAvailablePeriods はDBに登録されているレコードのYYYYMMとFY(年度)の一覧を取得する
-// 年度は4月始まり(4月〜翌年3月)で計算される
-// 返される配列はいずれも新しい順にソートされている
-func (r *RecordRepository) GetAvailablePeriods(ctx context.Context) ([]string, []string, error) {
-	// YYYYMMを取得（重複なし、降順）
-	type YYYYMMResult struct {
-		YYYYMM string
+// Restore はゴミ箱内のレコードを元に戻す
+// 確定済みの月のレコードは復元できない
+func (r *RecordRepository) Restore(ctx context.Context, id int) (*domain.Record, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var model RecordModel
+		if err := tx.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).First(&model).Error; err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			// ゴミ箱にない場合は存在しない場合と区別する
+			active, err := recordExists(tx, id)
+			if err != nil {
+				return err
+			}
+			if active {
+				return domain.NewConflictError("record %d is not in trash", id)
+			}
+			return domain.NewNotFoundError("record", id)
+		}
+		if err := checkMonthUnlocked(tx, model.Datetime); err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Model(&model).Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		return appendHistory(tx, &model, domain.RecordOperationRestore, time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.FindByID(ctx, id)
+}
+
+// Purge は before より前にゴミ箱へ移動されたレコードを物理削除し、削除件数を返す
+func (r *RecordRepository) Purge(ctx context.Context, before time.Time) (int, error) {
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Delete(&RecordModel{})
+	if result.Error != nil {
+		return 0, result.Error
 	}
-	var yyyymmResults []YYYYMMResult
+	return int(result.RowsAffected), nil
+}
 
-	// DATE_FORMAT を使ってYYYYMMを抽出し、DISTINCTで重複排除
+// FindHistory は指定されたIDのレコードの履歴を版の古い順に取得する
+// ゴミ箱内・物理削除済みのレコードの履歴も取得できる
+func (r *RecordRepository) FindHistory(ctx context.Context, id int) ([]*domain.RecordVersion, error) {
+	var models []*RecordHistoryModel
 	if err := r.db.WithContext(ctx).
-		Model(&RecordModel{}).
-		Select("DISTINCT DATE_FORMAT(datetime, '%Y%m') as yyyymm").
-		Order("yyyymm DESC").
-		Scan(&yyyymmResults).Error; err != nil {
-		return nil, nil, err
+		Where("record_id = ?", id).
+		Order("version").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, domain.NewNotFoundError("record", id)
 	}
 
-	// YYYYMMのスライスを作成
-	yyyymmSlice := make([]string, len(yyyymmResults))
-	for i, result := range yyyymmResults {
-		yyyymmSlice[i] = result.YYYYMM
+	categoryMap, err := r.categoryNameMap(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	// FY(年度)を計算
-	// 年度は4月始まりなので、CASE文で計算
-	// 1-3月は前年度、4-12月は当年度
-	type FYResult struct {
-		FY string
+	versions := make([]*domain.RecordVersion, len(models))
+	for i, model := range models {
+		versions[i] = model.ToDomain(categoryMap[model.CategoryID])
 	}
-	var fyResults []FYResult
+
+	return versions, nil
+}
+
+// GetAvailablePeriods はDBに登録されているレコードのYYYYMMとFY(年度)の一覧を取得する
+// 年度は4月始まり(4月〜翌年3月)で計算される
+// 返される配列はいずれも新しい順にソートされている
+func (r *RecordRepository) GetAvailablePeriods(ctx context.Context) ([]string, []string, error) {
+	// レコードが存在する年・月の組を取得（重複なし、降順）
+	type PeriodResult struct {
+		Year  int
+		Month int
+	}
+	var periodResults []PeriodResult
 
 	if err := r.db.WithContext(ctx).
 		Model(&RecordModel{}).
-		Select("DISTINCT CASE WHEN MONTH(datetime) BETWEEN 1 AND 3 THEN YEAR(datetime) - 1 ELSE YEAR(datetime) END as fy").
-		Order("fy DESC").
-		Scan(&fyResults).Error; err != nil {
+		Select(fmt.Sprintf("DISTINCT %s AS year, %s AS month", yearExpr(r.db, "datetime"), monthExpr(r.db, "datetime"))).
+		Order("year DESC, month DESC").
+		Scan(&periodResults).Error; err != nil {
 		return nil, nil, err
 	}
 
-	// FYのスライスを作成
-	fySlice := make([]string, len(fyResults))
-	for i, result := range fyResults {
-		fySlice[i] = result.FY
+	// YYYYMMとFY(年度)のスライスを作成
+	// 年度は4月始まりなので、1-3月は前年度、4-12月は当年度
+	// 年月の降順に走査しているため、年度も降順に並ぶ
+	yyyymmSlice := make([]string, 0, len(periodResults))
+	fySlice := []string{}
+	for _, result := range periodResults {
+		yyyymmSlice = append(yyyymmSlice, fmt.Sprintf("%04d%02d", result.Year, result.Month))
+
+		fy := result.Year
+		if result.Month <= 3 {
+			fy--
+		}
+		fyStr := fmt.Sprintf("%d", fy)
+		if len(fySlice) == 0 || fySlice[len(fySlice)-1] != fyStr {
+			fySlice = append(fySlice, fyStr)
+		}
 	}
 
 	return yyyymmSlice, fySlice, nil
@@ -247,8 +430,7 @@ func (r *RecordRepository) GetAvailablePeriods(ctx context.Context) ([]string, [
 func (r *RecordRepository) GetYearSummary(ctx context.Context, year int) ([]*domain.CategoryYearSummary, error) {
 	// 会計年度の開始日と終了日を計算
 	// year=2024 → 2024-04-01 〜 2025-03-31
-	startDate := fmt.Sprintf("%d-04-01", year)
-	endDate := fmt.Sprintf("%d-04-01", year+1)
+	startDate, endDate := fiscalYearRange(year)
 
 	// カテゴリ情報を全て取得
 	var categories []*CategoryModel
@@ -265,8 +447,8 @@ func (r *RecordRepository) GetYearSummary(ctx context.Context, year int) ([]*dom
 	// 月別・カテゴリ別の集計を取得
 	// 会計年度の4月を1とし、翌年3月を12とする
 	type MonthlySum struct {
-		CategoryID int
-		FiscalMonth int  // 会計年度での月番号（1-12）
+		CategoryID  int
+		FiscalMonth int // 会計年度での月番号（1-12）
 		TotalPrice  int
 		Count       int
 	}
@@ -275,17 +457,18 @@ func (r *RecordRepository) GetYearSummary(ctx context.Context, year int) ([]*dom
 
 	// SQLクエリで月別・カテゴリ別に集計
 	// 会計年度の月を計算: 4月=1, 5月=2, ..., 3月=12
+	month := monthExpr(r.db, "datetime")
 	query := `
 		SELECT
 			category_id,
 			CASE
-				WHEN MONTH(datetime) >= 4 THEN MONTH(datetime) - 3
-				ELSE MONTH(datetime) + 9
+				WHEN ` + month + ` >= 4 THEN ` + month + ` - 3
+				ELSE ` + month + ` + 9
 			END as fiscal_month,
 			SUM(price) as total_price,
 			COUNT(*) as count
-		FROM Record
-		WHERE datetime >= ? AND datetime < ?
+		FROM ` + quoteIdent(r.db, "Record") + `
+		WHERE datetime >= ? AND datetime < ? AND deleted_at IS NULL
 		GROUP BY category_id, fiscal_month
 		ORDER BY category_id, fiscal_month
 	`
@@ -334,3 +517,182 @@ func (r *RecordRepository) GetYearSummary(ctx context.Context, year int) ([]*dom
 
 	return result, nil
 }
+
+// GetPeriodSummary は指定された期間のカテゴリ別サマリーを、区切る単位ごとの金額とともに取得する
+// 区切りの境界をパラメータとした CASE 式で区切りの番号を求め、1つの集計クエリでカテゴリ・区切りごとに集計する
+func (r *RecordRepository) GetPeriodSummary(ctx context.Context, period domain.SummaryPeriod) ([]*domain.CategoryPeriodSummary, error) {
+	buckets := period.Buckets()
+
+	var categories []*CategoryModel
+	if err := r.db.WithContext(ctx).Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	categoryMap := make(map[int]*CategoryModel, len(categories))
+	for _, cat := range categories {
+		categoryMap[cat.CategoryID] = cat
+	}
+
+	type BucketSum struct {
+		CategoryID int
+		Bucket     int // 区切りの番号（0 始まり）
+		TotalPrice int
+		Count      int
+	}
+	var bucketSums []BucketSum
+
+	bucket, args := bucketExpr("datetime", buckets)
+	query := `
+		SELECT
+			category_id,
+			` + bucket + ` as bucket,
+			SUM(price) as total_price,
+			COUNT(*) as count
+		FROM ` + quoteIdent(r.db, "Record") + `
+		WHERE datetime >= ? AND datetime < ? AND deleted_at IS NULL
+		GROUP BY category_id, bucket
+		ORDER BY category_id, bucket
+	`
+	args = append(args, period.From, period.To)
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&bucketSums).Error; err != nil {
+		return nil, err
+	}
+
+	summaryMap := make(map[int]*domain.CategoryPeriodSummary)
+	for _, bs := range bucketSums {
+		cat, exists := categoryMap[bs.CategoryID]
+		if !exists {
+			continue // カテゴリが見つからない場合はスキップ
+		}
+
+		summary, exists := summaryMap[bs.CategoryID]
+		if !exists {
+			summary = &domain.CategoryPeriodSummary{
+				CategoryID:   cat.CategoryID,
+				CategoryName: cat.Name,
+				CategoryType: domain.CategoryType(cat.CategoryType),
+				Price:        make([]int, len(buckets)),
+			}
+			summaryMap[bs.CategoryID] = summary
+		}
+
+		if bs.Bucket >= 0 && bs.Bucket < len(buckets) {
+			summary.Price[bs.Bucket] = bs.TotalPrice
+			summary.Count += bs.Count
+			summary.Total += bs.TotalPrice
+		}
+	}
+
+	result := make([]*domain.CategoryPeriodSummary, 0, len(summaryMap))
+	for _, summary := range summaryMap {
+		result = append(result, summary)
+	}
+
+	return result, nil
+}
+
+// recordExists は指定されたIDのレコードが存在するかを返す
+// ゴミ箱内のレコードを含める場合は Unscoped を指定した tx を渡す
+func recordExists(tx *gorm.DB, id int) (bool, error) {
+	var count int64
+	if err := tx.Model(&RecordModel{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// findRecordCategory はレコードに設定するカテゴリを取得する
+// 存在しない場合は category_id の ValidationError を返す
+func findRecordCategory(tx *gorm.DB, categoryID int) (*CategoryModel, error) {
+	var category CategoryModel
+	if err := tx.Where("category_id = ?", categoryID).First(&category).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.NewValidationError("category_id", fmt.Sprintf("category does not exist: %d", categoryID))
+		}
+		return nil, err
+	}
+	return &category, nil
+}
+
+// recordNotFound は gorm.ErrRecordNotFound をドメインの NotFoundError に変換する
+func recordNotFound(err error, id int) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.NewNotFoundError("record", id)
+	}
+	return err
+}
+
+// categoryNameMap はカテゴリIDからカテゴリ名へのマップを作成する
+func (r *RecordRepository) categoryNameMap(ctx context.Context) (map[int]string, error) {
+	var categories []*CategoryModel
+	if err := r.db.WithContext(ctx).Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	categoryMap := make(map[int]string, len(categories))
+	for _, cat := range categories {
+		categoryMap[cat.CategoryID] = cat.Name
+	}
+	return categoryMap, nil
+}
+
+// GetYearSummaryAsOf は asOf 時点の履歴から、指定された会計年度のカテゴリ別サマリーを計算する
+// 各レコードについて asOf 以前に記録された最新の版を採用し、その版が削除でなければ集計に含める
+func (r *RecordRepository) GetYearSummaryAsOf(ctx context.Context, year int, asOf time.Time) ([]*domain.CategoryYearSummary, error) {
+	startDate, endDate := fiscalYearRange(year)
+
+	// レコードごとに asOf 時点の最新版の版番号を求める
+	latest := r.db.WithContext(ctx).
+		Model(&RecordHistoryModel{}).
+		Select("record_id, MAX(version) AS version").
+		Where("recorded_at <= ?", asOf).
+		Group("record_id")
+
+	var versions []*RecordHistoryModel
+	if err := r.db.WithContext(ctx).
+		Model(&RecordHistoryModel{}).
+		Joins(fmt.Sprintf("JOIN (?) AS latest ON latest.record_id = %s AND latest.version = %s",
+			quoteIdent(r.db, "Record_History.record_id"), quoteIdent(r.db, "Record_History.version")), latest).
+		Where(quoteIdent(r.db, "Record_History.operation")+" <> ?", string(domain.RecordOperationDelete)).
+		Where(quoteIdent(r.db, "Record_History.datetime")+" >= ? AND "+quoteIdent(r.db, "Record_History.datetime")+" < ?", startDate, endDate).
+		Find(&versions).Error; err != nil {
+		return nil, err
+	}
+
+	var categories []*CategoryModel
+	if err := r.db.WithContext(ctx).Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	categoryMap := make(map[int]*CategoryModel, len(categories))
+	for _, cat := range categories {
+		categoryMap[cat.CategoryID] = cat
+	}
+
+	summaryMap := make(map[int]*domain.CategoryYearSummary)
+	for _, v := range versions {
+		cat, exists := categoryMap[v.CategoryID]
+		if !exists {
+			continue // カテゴリが見つからない場合はスキップ
+		}
+
+		summary, exists := summaryMap[v.CategoryID]
+		if !exists {
+			summary = &domain.CategoryYearSummary{
+				CategoryID:   cat.CategoryID,
+				CategoryName: cat.Name,
+				CategoryType: domain.CategoryType(cat.CategoryType),
+			}
+			summaryMap[v.CategoryID] = summary
+		}
+
+		summary.Price[domain.FiscalMonthIndex(v.Datetime)] += v.Price
+		summary.Count++
+		summary.Total += v.Price
+	}
+
+	result := make([]*domain.CategoryYearSummary, 0, len(summaryMap))
+	for _, summary := range summaryMap {
+		result = append(result, summary)
+	}
+
+	return result, nil
+}
@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
-	"fmt"
+	"database/sql/driver"
+	"errors"
 	"regexp"
+	"sort"
 	"testing"
 	"time"
 
@@ -190,19 +192,20 @@ func TestRecordRepository_Create(t *testing.T) {
 		Memo:       "test-memo",
 	}
 
-	// INSERT クエリのモック（created_at, updated_atは自動追加されない）
+	// カテゴリの存在を確認してから INSERT する（created_at, updated_atは自動追加されない）
+	// 同一トランザクション内で初版の履歴も記録される
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `Record`")).
-		WithArgs(210, "test-from", "test-type", 1234, "test-memo", now).
-		WillReturnResult(sqlmock.NewResult(1, 1))
-	mock.ExpectCommit()
-
-	// カテゴリ名取得のSELECTクエリのモック
 	categoryRows := sqlmock.NewRows([]string{"id", "category_id", "name", "category_type", "created_at", "updated_at"}).
 		AddRow(1, 210, "食費", 2, time.Now(), time.Now())
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Category` WHERE category_id = ? ORDER BY `Category`.`id` LIMIT ?")).
 		WithArgs(210, 1).
 		WillReturnRows(categoryRows)
+	expectMonthUnlocked(mock, now, false)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `Record`")).
+		WithArgs(210, "test-from", "test-type", 1234, "test-memo", "", nil, now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	expectAppendHistory(mock, 1, 0, domain.RecordOperationCreate)
+	mock.ExpectCommit()
 
 	// テスト実行
 	repo := NewRecordRepository(gormDB)
@@ -226,6 +229,81 @@ func TestRecordRepository_Create(t *testing.T) {
 	}
 }
 
+// timeArg は日時の引数が現在日時付近であることを検証し、値を記録する sqlmock.Argument
+type timeArg struct {
+	got *time.Time
+}
+
+func (a timeArg) Match(v driver.Value) bool {
+	t, ok := v.(time.Time)
+	if !ok || time.Since(t) > time.Minute {
+		return false
+	}
+	*a.got = t
+	return true
+}
+
+func TestRecordRepository_Create_DefaultDatetime(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+
+	// 日時を省略した場合は、確定済みの月の確認・レコード・履歴・戻り値で同じ現在日時を使う
+	var inserted, history time.Time
+	mock.ExpectBegin()
+	categoryRows := sqlmock.NewRows([]string{"id", "category_id", "name", "category_type", "created_at", "updated_at"}).
+		AddRow(1, 210, "食費", 2, time.Now(), time.Now())
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Category` WHERE category_id = ? ORDER BY `Category`.`id` LIMIT ?")).
+		WithArgs(210, 1).
+		WillReturnRows(categoryRows)
+	expectMonthUnlocked(mock, time.Now(), false)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `Record`")).
+		WithArgs(210, "", "", 100, "", "", nil, timeArg{got: &inserted}).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(MAX(version), 0) FROM `Record_History` WHERE record_id = ?")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `Record_History`")).
+		WithArgs(1, 1, string(domain.RecordOperationCreate), 210, timeArg{got: &history}, "", "", 100, "", "", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(100, 1))
+	mock.ExpectCommit()
+
+	repo := NewRecordRepository(gormDB)
+	result, err := repo.Create(context.Background(), &domain.Record{CategoryID: 210, Price: 100})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unfulfilled expectations: %v", err)
+	}
+	if !inserted.Equal(history) || !result.Datetime.Equal(inserted) {
+		t.Errorf("expected the same datetime, got record %v, history %v, result %v", inserted, history, result.Datetime)
+	}
+}
+
+func TestRecordRepository_Create_UnknownCategory(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+
+	// カテゴリが存在しない場合は INSERT せずにロールバックする
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Category` WHERE category_id = ? ORDER BY `Category`.`id` LIMIT ?")).
+		WithArgs(999, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "category_id", "name", "category_type", "created_at", "updated_at"}))
+	mock.ExpectRollback()
+
+	repo := NewRecordRepository(gormDB)
+	_, err := repo.Create(context.Background(), &domain.Record{
+		CategoryID: 999,
+		Datetime:   time.Date(2025, 10, 21, 0, 0, 0, 0, time.UTC),
+		Price:      1234,
+	})
+
+	if !errors.Is(err, domain.ErrValidation) {
+		t.Fatalf("expected ErrValidation, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
 func TestRecordRepository_FindByID(t *testing.T) {
 	gormDB, mock := setupMockDB(t)
 
@@ -234,7 +312,7 @@ func TestRecordRepository_FindByID(t *testing.T) {
 	// SELECT クエリのモック
 	recordRows := sqlmock.NewRows([]string{"id", "category_id", "datetime", "from", "type", "price", "memo", "created_at", "updated_at"}).
 		AddRow(1, 210, now, "test-from", "test-type", 1234, "test-memo", time.Now(), time.Now())
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Record` WHERE id = ? ORDER BY `Record`.`id` LIMIT ?")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Record` WHERE id = ? AND `Record`.`deleted_at` IS NULL ORDER BY `Record`.`id` LIMIT ?")).
 		WithArgs(1, 1).
 		WillReturnRows(recordRows)
 
@@ -279,7 +357,7 @@ func TestRecordRepository_FindAll(t *testing.T) {
 	recordRows := sqlmock.NewRows([]string{"id", "category_id", "datetime", "from", "type", "price", "memo", "created_at", "updated_at"}).
 		AddRow(2, 210, now, "test-from-2", "test-type", 5678, "test-memo-2", time.Now(), time.Now()).
 		AddRow(1, 210, now, "test-from-1", "test-type", 1234, "test-memo-1", time.Now(), time.Now())
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Record` ORDER BY id DESC LIMIT ?")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Record` WHERE `Record`.`deleted_at` IS NULL ORDER BY id DESC LIMIT ?")).
 		WithArgs(20).
 		WillReturnRows(recordRows)
 
@@ -329,8 +407,8 @@ func TestRecordRepository_FindAll_WithFilters(t *testing.T) {
 	// YYYYMMとcategory_idでフィルタするSELECTクエリのモック
 	recordRows := sqlmock.NewRows([]string{"id", "category_id", "datetime", "from", "type", "price", "memo", "created_at", "updated_at"}).
 		AddRow(1, 210, now, "test-from", "test-type", 1234, "test-memo", time.Now(), time.Now())
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Record` WHERE (datetime >= ? AND datetime < DATE_ADD(?, INTERVAL 1 MONTH)) AND category_id = ? ORDER BY id DESC LIMIT ? OFFSET ?")).
-		WithArgs("2025-10-01", "2025-10-01", 210, 10, 5).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Record` WHERE (datetime >= ? AND datetime < ?) AND category_id = ? AND `Record`.`deleted_at` IS NULL ORDER BY id DESC LIMIT ? OFFSET ?")).
+		WithArgs(time.Date(2025, 10, 1, 0, 0, 0, 0, time.Local), time.Date(2025, 11, 1, 0, 0, 0, 0, time.Local), 210, 10, 5).
 		WillReturnRows(recordRows)
 
 	// カテゴリ一覧取得のSELECTクエリのモック
@@ -364,7 +442,7 @@ func TestRecordRepository_Count(t *testing.T) {
 	// COUNT クエリのモック
 	countRows := sqlmock.NewRows([]string{"count"}).
 		AddRow(42)
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `Record`")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `Record` WHERE `Record`.`deleted_at` IS NULL")).
 		WillReturnRows(countRows)
 
 	// テスト実行
@@ -392,8 +470,8 @@ func TestRecordRepository_Count_WithFilters(t *testing.T) {
 	// フィルタ付きCOUNT クエリのモック
 	countRows := sqlmock.NewRows([]string{"count"}).
 		AddRow(10)
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `Record` WHERE (datetime >= ? AND datetime < DATE_ADD(?, INTERVAL 1 MONTH)) AND category_id = ?")).
-		WithArgs("2025-10-01", "2025-10-01", 210).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `Record` WHERE (datetime >= ? AND datetime < ?) AND category_id = ? AND `Record`.`deleted_at` IS NULL")).
+		WithArgs(time.Date(2025, 10, 1, 0, 0, 0, 0, time.Local), time.Date(2025, 11, 1, 0, 0, 0, 0, time.Local), 210).
 		WillReturnRows(countRows)
 
 	// テスト実行
@@ -418,11 +496,22 @@ func TestRecordRepository_Count_WithFilters(t *testing.T) {
 func TestRecordRepository_Delete(t *testing.T) {
 	gormDB, mock := setupMockDB(t)
 
-	// DELETE クエリのモック
+	now := time.Date(2025, 10, 21, 0, 0, 0, 0, time.UTC)
+
+	// 削除対象の取得
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `Record` WHERE `Record`.`id` = ?")).
-		WithArgs(1).
+	recordRows := sqlmock.NewRows([]string{"id", "category_id", "datetime", "from", "type", "price", "memo", "created_at", "updated_at", "deleted_at"}).
+		AddRow(1, 210, now, "test-from", "test-type", 1234, "test-memo", now, now, nil)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Record` WHERE id = ? AND `Record`.`deleted_at` IS NULL ORDER BY `Record`.`id` LIMIT ?")).
+		WithArgs(1, 1).
+		WillReturnRows(recordRows)
+	expectMonthUnlocked(mock, now, false)
+
+	// 論理削除（deleted_at の UPDATE）クエリのモック
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `Record` SET `deleted_at`=? WHERE `Record`.`id` = ? AND `Record`.`deleted_at` IS NULL")).
+		WithArgs(sqlmock.AnyArg(), 1).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectAppendHistory(mock, 1, 1, domain.RecordOperationDelete)
 	mock.ExpectCommit()
 
 	// テスト実行
@@ -443,20 +532,377 @@ func TestRecordRepository_Delete(t *testing.T) {
 func TestRecordRepository_Delete_NotFound(t *testing.T) {
 	gormDB, mock := setupMockDB(t)
 
-	// DELETE クエリのモック（削除対象が0件）
+	// 削除対象が存在しない（ゴミ箱内のレコードも対象外）
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `Record` WHERE `Record`.`id` = ?")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Record` WHERE id = ? AND `Record`.`deleted_at` IS NULL ORDER BY `Record`.`id` LIMIT ?")).
+		WithArgs(999, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	// ゴミ箱にもない
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `Record` WHERE id = ?")).
 		WithArgs(999).
-		WillReturnResult(sqlmock.NewResult(0, 0))
-	mock.ExpectCommit()
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectRollback()
 
 	// テスト実行
 	repo := NewRecordRepository(gormDB)
 	err := repo.Delete(context.Background(), 999)
 
+	// 検証
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	// 全ての期待が満たされたか確認
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestRecordRepository_Delete_MonthLocked(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+
+	now := time.Date(2025, 10, 21, 0, 0, 0, 0, time.UTC)
+
+	// 確定済みの月のレコードは削除しない
+	mock.ExpectBegin()
+	recordRows := sqlmock.NewRows([]string{"id", "category_id", "datetime", "from", "type", "price", "memo", "created_at", "updated_at", "deleted_at"}).
+		AddRow(1, 210, now, "test-from", "test-type", 1234, "test-memo", now, now, nil)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Record` WHERE id = ? AND `Record`.`deleted_at` IS NULL ORDER BY `Record`.`id` LIMIT ?")).
+		WithArgs(1, 1).
+		WillReturnRows(recordRows)
+	expectMonthUnlocked(mock, now, true)
+	mock.ExpectRollback()
+
+	// テスト実行
+	repo := NewRecordRepository(gormDB)
+	err := repo.Delete(context.Background(), 1)
+
+	// 検証
+	var locked *domain.MonthLockedError
+	if !errors.As(err, &locked) {
+		t.Fatalf("expected MonthLockedError, got %v", err)
+	}
+	if want := now.In(time.Local).Format("200601"); locked.YYYYMM != want {
+		t.Errorf("expected locked month %s, got %s", want, locked.YYYYMM)
+	}
+
+	// 全ての期待が満たされたか確認
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestRecordRepository_FindDeleted(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+
+	deletedAt := time.Date(2025, 10, 22, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2025, 10, 21, 0, 0, 0, 0, time.UTC)
+
+	// ゴミ箱内のレコードを取得するSELECTクエリのモック
+	recordRows := sqlmock.NewRows([]string{"id", "category_id", "datetime", "from", "type", "price", "memo", "created_at", "updated_at", "deleted_at"}).
+		AddRow(3, 210, now, "test-from", "test-type", 1234, "test-memo", now, now, deletedAt)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Record` WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC,id DESC LIMIT ?")).
+		WithArgs(20).
+		WillReturnRows(recordRows)
+
+	categoryRows := sqlmock.NewRows([]string{"id", "category_id", "name", "category_type", "created_at", "updated_at"}).
+		AddRow(1, 210, "食費", 2, time.Now(), time.Now())
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Category`")).
+		WillReturnRows(categoryRows)
+
+	// テスト実行
+	repo := NewRecordRepository(gormDB)
+	results, err := repo.FindDeleted(context.Background(), 20, 0)
+
+	// 検証
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(results))
+	}
+	if results[0].DeletedAt == nil || !results[0].DeletedAt.Equal(deletedAt) {
+		t.Errorf("expected DeletedAt %v, got %v", deletedAt, results[0].DeletedAt)
+	}
+	if results[0].CategoryName != "食費" {
+		t.Errorf("expected CategoryName '食費', got '%s'", results[0].CategoryName)
+	}
+
+	// 全ての期待が満たされたか確認
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestRecordRepository_Restore(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+
+	now := time.Date(2025, 10, 21, 0, 0, 0, 0, time.UTC)
+	deletedAt := time.Date(2025, 10, 22, 9, 0, 0, 0, time.UTC)
+
+	// ゴミ箱内のレコードの取得
+	mock.ExpectBegin()
+	trashedRows := sqlmock.NewRows([]string{"id", "category_id", "datetime", "from", "type", "price", "memo", "created_at", "updated_at", "deleted_at"}).
+		AddRow(1, 210, now, "test-from", "test-type", 1234, "test-memo", now, now, deletedAt)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Record` WHERE id = ? AND deleted_at IS NOT NULL ORDER BY `Record`.`id` LIMIT ?")).
+		WithArgs(1, 1).
+		WillReturnRows(trashedRows)
+	expectMonthUnlocked(mock, now, false)
+
+	// deleted_at をクリアするUPDATEクエリのモック
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `Record` SET `deleted_at`=?,`updated_at`=? WHERE `id` = ?")).
+		WithArgs(nil, sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectAppendHistory(mock, 1, 2, domain.RecordOperationRestore)
+	mock.ExpectCommit()
+
+	// 復元後のレコード取得のモック
+	recordRows := sqlmock.NewRows([]string{"id", "category_id", "datetime", "from", "type", "price", "memo", "created_at", "updated_at", "deleted_at"}).
+		AddRow(1, 210, now, "test-from", "test-type", 1234, "test-memo", now, now, nil)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Record` WHERE id = ? AND `Record`.`deleted_at` IS NULL ORDER BY `Record`.`id` LIMIT ?")).
+		WithArgs(1, 1).
+		WillReturnRows(recordRows)
+
+	categoryRows := sqlmock.NewRows([]string{"id", "category_id", "name", "category_type", "created_at", "updated_at"}).
+		AddRow(1, 210, "食費", 2, time.Now(), time.Now())
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Category` WHERE category_id = ? ORDER BY `Category`.`id` LIMIT ?")).
+		WithArgs(210, 1).
+		WillReturnRows(categoryRows)
+
+	// テスト実行
+	repo := NewRecordRepository(gormDB)
+	result, err := repo.Restore(context.Background(), 1)
+
+	// 検証
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.ID != 1 {
+		t.Errorf("expected ID 1, got %d", result.ID)
+	}
+	if result.DeletedAt != nil {
+		t.Errorf("expected DeletedAt nil, got %v", result.DeletedAt)
+	}
+
+	// 全ての期待が満たされたか確認
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestRecordRepository_Restore_NotFound(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+
+	// ゴミ箱に存在しない場合
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Record` WHERE id = ? AND deleted_at IS NOT NULL ORDER BY `Record`.`id` LIMIT ?")).
+		WithArgs(999, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	// ゴミ箱の外には存在する
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `Record` WHERE id = ? AND `Record`.`deleted_at` IS NULL")).
+		WithArgs(999).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectRollback()
+
+	// テスト実行
+	repo := NewRecordRepository(gormDB)
+	_, err := repo.Restore(context.Background(), 999)
+
+	// 検証
+	if !errors.Is(err, domain.ErrConflict) {
+		t.Errorf("expected ErrConflict for record not in trash, got %v", err)
+	}
+
+	// 全ての期待が満たされたか確認
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestRecordRepository_Update(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+
+	now := time.Date(2025, 10, 21, 0, 0, 0, 0, time.UTC)
+
+	// 更新対象の取得
+	mock.ExpectBegin()
+	recordRows := sqlmock.NewRows([]string{"id", "category_id", "datetime", "from", "type", "price", "memo", "created_at", "updated_at", "deleted_at"}).
+		AddRow(1, 210, now, "test-from", "test-type", 1234, "test-memo", now, now, nil)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Record` WHERE id = ? AND `Record`.`deleted_at` IS NULL ORDER BY `Record`.`id` LIMIT ?")).
+		WithArgs(1, 1).
+		WillReturnRows(recordRows)
+	categoryRows := sqlmock.NewRows([]string{"id", "category_id", "name", "category_type", "created_at", "updated_at"}).
+		AddRow(6, 220, "電気代", 2, time.Now(), time.Now())
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Category` WHERE category_id = ? ORDER BY `Category`.`id` LIMIT ?")).
+		WithArgs(220, 1).
+		WillReturnRows(categoryRows)
+	expectMonthUnlocked(mock, now, false)
+
+	// 日時は省略されたため元の値のまま更新される
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `Record` SET `category_id`=?,`datetime`=?,`from`=?,`type`=?,`price`=?,`memo`=?,`tags`=?,`updated_at`=? WHERE `Record`.`deleted_at` IS NULL AND `id` = ?")).
+		WithArgs(220, now, "test-from", "test-type", 5000, "更新後", "", sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectAppendHistory(mock, 1, 1, domain.RecordOperationUpdate)
+	mock.ExpectCommit()
+
+	// テスト実行
+	repo := NewRecordRepository(gormDB)
+	result, err := repo.Update(context.Background(), &domain.Record{
+		ID:         1,
+		CategoryID: 220,
+		From:       "test-from",
+		Type:       "test-type",
+		Price:      5000,
+		Memo:       "更新後",
+	})
+
+	// 検証
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.CategoryName != "電気代" {
+		t.Errorf("expected CategoryName '電気代', got '%s'", result.CategoryName)
+	}
+	if !result.Datetime.Equal(now) {
+		t.Errorf("expected Datetime %v, got %v", now, result.Datetime)
+	}
+
+	// 全ての期待が満たされたか確認
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestRecordRepository_FindHistory(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+
+	now := time.Date(2025, 10, 21, 0, 0, 0, 0, time.UTC)
+
+	historyRows := sqlmock.NewRows([]string{"id", "record_id", "version", "operation", "category_id", "datetime", "from", "type", "price", "memo", "recorded_at"}).
+		AddRow(10, 1, 1, "create", 210, now, "", "", 1000, "", now).
+		AddRow(11, 1, 2, "update", 210, now, "", "", 1200, "", now.Add(time.Hour))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Record_History` WHERE record_id = ? ORDER BY version")).
+		WithArgs(1).
+		WillReturnRows(historyRows)
+
+	categoryRows := sqlmock.NewRows([]string{"id", "category_id", "name", "category_type", "created_at", "updated_at"}).
+		AddRow(1, 210, "食費", 2, time.Now(), time.Now())
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Category`")).
+		WillReturnRows(categoryRows)
+
+	// テスト実行
+	repo := NewRecordRepository(gormDB)
+	versions, err := repo.FindHistory(context.Background(), 1)
+
+	// 検証
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(versions) != 2 {
+		t.Fatalf("expected 2 versions, got %d", len(versions))
+	}
+	if versions[1].Operation != domain.RecordOperationUpdate || versions[1].Record.Price != 1200 {
+		t.Errorf("unexpected second version: %+v", versions[1])
+	}
+	if versions[0].Record.CategoryName != "食費" {
+		t.Errorf("expected CategoryName '食費', got '%s'", versions[0].Record.CategoryName)
+	}
+
+	// 全ての期待が満たされたか確認
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestRecordRepository_FindHistory_NotFound(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Record_History` WHERE record_id = ? ORDER BY version")).
+		WithArgs(999).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	// テスト実行
+	repo := NewRecordRepository(gormDB)
+	_, err := repo.FindHistory(context.Background(), 999)
+
 	// 検証
 	if err == nil {
-		t.Error("expected error for not found record, got nil")
+		t.Error("expected error for record without history, got nil")
+	}
+
+	// 全ての期待が満たされたか確認
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestRecordRepository_GetYearSummaryAsOf(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+
+	asOf := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	// asOf 時点の最新版（削除版は除外済み）
+	versionRows := sqlmock.NewRows([]string{"id", "record_id", "version", "operation", "category_id", "datetime", "from", "type", "price", "memo", "recorded_at"}).
+		AddRow(10, 1, 1, "create", 210, time.Date(2025, 4, 10, 0, 0, 0, 0, time.Local), "", "", 1000, "", asOf).
+		AddRow(12, 2, 2, "update", 210, time.Date(2025, 5, 3, 0, 0, 0, 0, time.Local), "", "", 1500, "", asOf).
+		AddRow(13, 3, 1, "create", 100, time.Date(2026, 3, 25, 0, 0, 0, 0, time.Local), "", "", 300000, "", asOf)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `Record_History`.`id`,`Record_History`.`record_id`,`Record_History`.`version`,`Record_History`.`operation`,`Record_History`.`category_id`,`Record_History`.`datetime`,`Record_History`.`from`,`Record_History`.`type`,`Record_History`.`price`,`Record_History`.`memo`,`Record_History`.`tags`,`Record_History`.`recorded_at` FROM `Record_History` JOIN (SELECT record_id, MAX(version) AS version FROM `Record_History` WHERE recorded_at <= ? GROUP BY `record_id`) AS latest ON latest.record_id = `Record_History`.`record_id` AND latest.version = `Record_History`.`version` WHERE `Record_History`.`operation` <> ? AND (`Record_History`.`datetime` >= ? AND `Record_History`.`datetime` < ?)")).
+		WithArgs(asOf, "delete", time.Date(2025, 4, 1, 0, 0, 0, 0, time.Local), time.Date(2026, 4, 1, 0, 0, 0, 0, time.Local)).
+		WillReturnRows(versionRows)
+
+	categoryRows := sqlmock.NewRows([]string{"id", "category_id", "name", "category_type"}).
+		AddRow(5, 210, "食費", 2).
+		AddRow(1, 100, "月給", 1)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `Category`")).
+		WillReturnRows(categoryRows)
+
+	// テスト実行
+	repo := NewRecordRepository(gormDB)
+	summaries, err := repo.GetYearSummaryAsOf(context.Background(), 2025, asOf)
+
+	// 検証
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	summaryMap := make(map[int]*domain.CategoryYearSummary)
+	for _, s := range summaries {
+		summaryMap[s.CategoryID] = s
+	}
+	if s := summaryMap[210]; s == nil || s.Count != 2 || s.Total != 2500 || s.Price[0] != 1000 || s.Price[1] != 1500 {
+		t.Errorf("unexpected summary for 210: %+v", s)
+	}
+	if s := summaryMap[100]; s == nil || s.Price[11] != 300000 {
+		t.Errorf("unexpected summary for 100: %+v", s)
+	}
+
+	// 全ての期待が満たされたか確認
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestRecordRepository_Purge(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+
+	before := time.Date(2025, 9, 21, 0, 0, 0, 0, time.UTC)
+
+	// 物理削除のDELETEクエリのモック
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `Record` WHERE deleted_at IS NOT NULL AND deleted_at < ?")).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 4))
+	mock.ExpectCommit()
+
+	// テスト実行
+	repo := NewRecordRepository(gormDB)
+	num, err := repo.Purge(context.Background(), before)
+
+	// 検証
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if num != 4 {
+		t.Errorf("expected 4 purged records, got %d", num)
 	}
 
 	// 全ての期待が満たされたか確認
@@ -530,65 +976,23 @@ func TestRecordRepository_GetAvailablePeriods(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			gormDB, mock := setupMockDB(t)
 
-			// YYYYMM取得クエリのモック
-			yyyymmRows := sqlmock.NewRows([]string{"yyyymm"})
-			yyyymmMap := make(map[string]bool)
+			// 年・月取得クエリのモック（重複なし、降順）
+			periods := make([]int, 0, len(tt.mockRecords))
+			periodMap := make(map[int]bool)
 			for _, record := range tt.mockRecords {
-				yyyymm := record.Datetime.Format("200601")
-				if !yyyymmMap[yyyymm] {
-					yyyymmMap[yyyymm] = true
+				period := record.Datetime.Year()*100 + int(record.Datetime.Month())
+				if !periodMap[period] {
+					periodMap[period] = true
+					periods = append(periods, period)
 				}
 			}
-			// 降順でソート
-			yyyymmList := make([]string, 0, len(yyyymmMap))
-			for k := range yyyymmMap {
-				yyyymmList = append(yyyymmList, k)
+			sort.Sort(sort.Reverse(sort.IntSlice(periods)))
+			periodRows := sqlmock.NewRows([]string{"year", "month"})
+			for _, period := range periods {
+				periodRows.AddRow(period/100, period%100)
 			}
-			for i := 0; i < len(yyyymmList)-1; i++ {
-				for j := i + 1; j < len(yyyymmList); j++ {
-					if yyyymmList[i] < yyyymmList[j] {
-						yyyymmList[i], yyyymmList[j] = yyyymmList[j], yyyymmList[i]
-					}
-				}
-			}
-			for _, ym := range yyyymmList {
-				yyyymmRows.AddRow(ym)
-			}
-			mock.ExpectQuery(regexp.QuoteMeta("SELECT DISTINCT DATE_FORMAT(datetime, '%Y%m') as yyyymm FROM `Record` ORDER BY yyyymm DESC")).
-				WillReturnRows(yyyymmRows)
-
-			// FY取得クエリのモック
-			fyRows := sqlmock.NewRows([]string{"fy"})
-			fyMap := make(map[string]bool)
-			for _, record := range tt.mockRecords {
-				year := record.Datetime.Year()
-				month := record.Datetime.Month()
-				fyYear := year
-				if month >= 1 && month <= 3 {
-					fyYear = year - 1
-				}
-				fy := fmt.Sprintf("%d", fyYear)
-				if !fyMap[fy] {
-					fyMap[fy] = true
-				}
-			}
-			// 降順でソート
-			fyList := make([]string, 0, len(fyMap))
-			for k := range fyMap {
-				fyList = append(fyList, k)
-			}
-			for i := 0; i < len(fyList)-1; i++ {
-				for j := i + 1; j < len(fyList); j++ {
-					if fyList[i] < fyList[j] {
-						fyList[i], fyList[j] = fyList[j], fyList[i]
-					}
-				}
-			}
-			for _, f := range fyList {
-				fyRows.AddRow(f)
-			}
-			mock.ExpectQuery(regexp.QuoteMeta("SELECT DISTINCT CASE WHEN MONTH(datetime) BETWEEN 1 AND 3 THEN YEAR(datetime) - 1 ELSE YEAR(datetime) END as fy FROM `Record` ORDER BY fy DESC")).
-				WillReturnRows(fyRows)
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT DISTINCT YEAR(datetime) AS year, MONTH(datetime) AS month FROM `Record` WHERE `Record`.`deleted_at` IS NULL ORDER BY year DESC, month DESC")).
+				WillReturnRows(periodRows)
 
 			// テスト実行
 			repo := NewRecordRepository(gormDB)
@@ -693,13 +1097,13 @@ func TestRecordRepository_GetYearSummary(t *testing.T) {
 			END as fiscal_month,
 			SUM(price) as total_price,
 			COUNT(*) as count
-		FROM Record
-		WHERE datetime >= ? AND datetime < ?
+		FROM ` + "`Record`" + `
+		WHERE datetime >= ? AND datetime < ? AND deleted_at IS NULL
 		GROUP BY category_id, fiscal_month
 		ORDER BY category_id, fiscal_month
 	`
 				mock.ExpectQuery(regexp.QuoteMeta(querySQL)).
-					WithArgs("2024-04-01", "2025-04-01").
+					WithArgs(time.Date(2024, 4, 1, 0, 0, 0, 0, time.Local), time.Date(2025, 4, 1, 0, 0, 0, 0, time.Local)).
 					WillReturnRows(summaryRows)
 			},
 			wantErr: false,
@@ -775,13 +1179,13 @@ func TestRecordRepository_GetYearSummary(t *testing.T) {
 			END as fiscal_month,
 			SUM(price) as total_price,
 			COUNT(*) as count
-		FROM Record
-		WHERE datetime >= ? AND datetime < ?
+		FROM ` + "`Record`" + `
+		WHERE datetime >= ? AND datetime < ? AND deleted_at IS NULL
 		GROUP BY category_id, fiscal_month
 		ORDER BY category_id, fiscal_month
 	`
 				mock.ExpectQuery(regexp.QuoteMeta(querySQL)).
-					WithArgs("2024-04-01", "2025-04-01").
+					WithArgs(time.Date(2024, 4, 1, 0, 0, 0, 0, time.Local), time.Date(2025, 4, 1, 0, 0, 0, 0, time.Local)).
 					WillReturnRows(summaryRows)
 			},
 			wantErr: false,
@@ -818,3 +1222,24 @@ func TestRecordRepository_GetYearSummary(t *testing.T) {
 		})
 	}
 }
+
+// expectMonthUnlocked は月の確定状態の確認クエリを期待値として登録する
+func expectMonthUnlocked(mock sqlmock.Sqlmock, datetime time.Time, locked bool) {
+	count := 0
+	if locked {
+		count = 1
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `Monthly_Confirm` WHERE yyyymm = ? AND confirm = ?")).
+		WithArgs(datetime.In(time.Local).Format("200601"), 1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+}
+
+// expectAppendHistory は履歴の版番号取得と追加のクエリを期待値として登録する
+func expectAppendHistory(mock sqlmock.Sqlmock, recordID, latest int, op domain.RecordOperation) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(MAX(version), 0) FROM `Record_History` WHERE record_id = ?")).
+		WithArgs(recordID).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(latest))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `Record_History`")).
+		WithArgs(recordID, latest+1, string(op), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(int64(100+latest), 1))
+}
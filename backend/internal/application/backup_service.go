@@ -0,0 +1,170 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/domain"
+)
+
+// DefaultBackupInterval は自動バックアップを実行するデフォルトの間隔
+const DefaultBackupInterval = 24 * time.Hour
+
+// DefaultBackupRetention は自動バックアップのデフォルトの保持ポリシー
+var DefaultBackupRetention = domain.BackupRetention{KeepLast: 7, KeepDaily: 7, KeepMonthly: 12}
+
+// BackupStatus は自動バックアップの実行状況
+// まだ実行していない項目はゼロ値となる
+type BackupStatus struct {
+	Interval       time.Duration
+	Retention      domain.BackupRetention
+	LastStartedAt  time.Time
+	LastFinishedAt time.Time
+	LastSuccessAt  time.Time
+	LastFile       string // 直近の実行で作成したファイル名
+	LastErr        error  // 直近の実行が失敗した場合のエラー
+	NextAt         time.Time
+	Files          []*domain.BackupFile
+}
+
+// BackupService は自動バックアップに関するアプリケーションサービス
+type BackupService struct {
+	repo      domain.BackupRepository
+	interval  time.Duration
+	retention domain.BackupRetention
+	now       func() time.Time
+
+	runMu sync.Mutex // バックアップの同時実行を防ぐ
+
+	mu     sync.Mutex // 以下の実行状況を保護する
+	status BackupStatus
+}
+
+// NewBackupService はBackupServiceを生成する
+func NewBackupService(repo domain.BackupRepository, interval time.Duration, retention domain.BackupRetention) *BackupService {
+	return &BackupService{
+		repo:      repo,
+		interval:  interval,
+		retention: retention,
+		now:       time.Now,
+	}
+}
+
+// Run はコンテキストがキャンセルされるまで、一定間隔でバックアップを実行する
+// 最初のバックアップは、保存済みの最新のファイルから間隔が経過した時点（ファイルがなければ直ちに）実行する
+func (s *BackupService) Run(ctx context.Context) {
+	next := s.firstRunAt(ctx)
+	for {
+		s.mu.Lock()
+		s.status.NextAt = next
+		s.mu.Unlock()
+
+		timer := time.NewTimer(next.Sub(s.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		file, err := s.RunBackup(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "Scheduled backup failed", slog.String("error", err.Error()))
+		} else {
+			slog.InfoContext(ctx, "Scheduled backup completed",
+				slog.String("file", file.Name),
+				slog.Int64("size", file.Size),
+			)
+		}
+		next = s.now().Add(s.interval)
+	}
+}
+
+// firstRunAt は最初のバックアップを実行する日時を返す
+func (s *BackupService) firstRunAt(ctx context.Context) time.Time {
+	now := s.now()
+	files, err := s.repo.List(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to list backup files", slog.String("error", err.Error()))
+		return now
+	}
+	if len(files) == 0 {
+		return now
+	}
+	next := files[0].CreatedAt.Add(s.interval)
+	if next.Before(now) {
+		return now
+	}
+	return next
+}
+
+// RunBackup はバックアップファイルを作成し、保持ポリシーに該当しない古いファイルを削除する
+func (s *BackupService) RunBackup(ctx context.Context) (*domain.BackupFile, error) {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+
+	s.mu.Lock()
+	s.status.LastStartedAt = s.now()
+	s.mu.Unlock()
+
+	file, err := s.repo.Create(ctx)
+	if err == nil {
+		err = s.rotate(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status.LastFinishedAt = s.now()
+	s.status.LastFile = ""
+	if file != nil {
+		s.status.LastFile = file.Name
+	}
+	s.status.LastErr = err
+	if err != nil {
+		return file, err
+	}
+	s.status.LastSuccessAt = s.status.LastFinishedAt
+	return file, nil
+}
+
+// rotate は保持ポリシーに該当しないバックアップファイルを削除する
+func (s *BackupService) rotate(ctx context.Context) error {
+	files, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, f := range s.retention.Expired(files) {
+		if err := s.repo.Remove(ctx, f.Name); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		slog.InfoContext(ctx, "Expired backup removed", slog.String("file", f.Name))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to rotate backups: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// Status は自動バックアップの実行状況と保存済みのバックアップファイルを取得する
+func (s *BackupService) Status(ctx context.Context) (*BackupStatus, error) {
+	files, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	status := s.status
+	s.mu.Unlock()
+
+	status.Interval = s.interval
+	status.Retention = s.retention
+	status.Files = files
+	return &status, nil
+}
@@ -0,0 +1,230 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/domain"
+)
+
+// mockBackupRepository はテスト用のモックリポジトリ
+type mockBackupRepository struct {
+	mu        sync.Mutex
+	files     []*domain.BackupFile
+	now       func() time.Time
+	createErr error
+	removeErr error
+	created   int
+}
+
+func (m *mockBackupRepository) Create(ctx context.Context) (*domain.BackupFile, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createErr != nil {
+		return nil, m.createErr
+	}
+	m.created++
+	now := time.Now()
+	if m.now != nil {
+		now = m.now()
+	}
+	f := &domain.BackupFile{Name: fmt.Sprintf("backup-%d", m.created), CreatedAt: now, Size: 100}
+	m.files = append(m.files, f)
+	return f, nil
+}
+
+func (m *mockBackupRepository) List(ctx context.Context) ([]*domain.BackupFile, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	files := make([]*domain.BackupFile, len(m.files))
+	copy(files, m.files)
+	sort.Slice(files, func(i, j int) bool { return files[i].CreatedAt.After(files[j].CreatedAt) })
+	return files, nil
+}
+
+func (m *mockBackupRepository) Remove(ctx context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.removeErr != nil {
+		return m.removeErr
+	}
+	for i, f := range m.files {
+		if f.Name == name {
+			m.files = append(m.files[:i], m.files[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("not found")
+}
+
+func TestBackupService_RunBackup(t *testing.T) {
+	now := time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		repo      *mockBackupRepository
+		wantErr   bool
+		wantFiles []string
+		checkFunc func(t *testing.T, status *BackupStatus)
+	}{
+		{
+			name: "正常系: 作成後に保持ポリシーに該当しないファイルを削除する",
+			repo: &mockBackupRepository{
+				files: []*domain.BackupFile{
+					{Name: "old-1", CreatedAt: now.Add(-72 * time.Hour)},
+					{Name: "old-2", CreatedAt: now.Add(-48 * time.Hour)},
+					{Name: "old-3", CreatedAt: now.Add(-24 * time.Hour)},
+				},
+			},
+			wantFiles: []string{"backup-1", "old-3"},
+			checkFunc: func(t *testing.T, status *BackupStatus) {
+				if status.LastFile != "backup-1" || status.LastErr != nil {
+					t.Errorf("unexpected last result: file=%q err=%v", status.LastFile, status.LastErr)
+				}
+				if !status.LastSuccessAt.Equal(now) {
+					t.Errorf("expected last success at %v, got %v", now, status.LastSuccessAt)
+				}
+			},
+		},
+		{
+			name:      "異常系: 作成に失敗した場合はエラーを記録する",
+			repo:      &mockBackupRepository{createErr: errors.New("disk full")},
+			wantErr:   true,
+			wantFiles: []string{},
+			checkFunc: func(t *testing.T, status *BackupStatus) {
+				if status.LastErr == nil || status.LastFile != "" {
+					t.Errorf("unexpected last result: file=%q err=%v", status.LastFile, status.LastErr)
+				}
+				if !status.LastSuccessAt.IsZero() {
+					t.Errorf("expected no successful backup, got %v", status.LastSuccessAt)
+				}
+			},
+		},
+		{
+			name: "異常系: 古いファイルの削除に失敗した場合もエラーを記録する",
+			repo: &mockBackupRepository{
+				files:     []*domain.BackupFile{{Name: "old-1", CreatedAt: now.Add(-72 * time.Hour)}, {Name: "old-2", CreatedAt: now.Add(-48 * time.Hour)}},
+				removeErr: errors.New("permission denied"),
+			},
+			wantErr:   true,
+			wantFiles: []string{"backup-1", "old-2", "old-1"},
+			checkFunc: func(t *testing.T, status *BackupStatus) {
+				if status.LastErr == nil || status.LastFile != "backup-1" {
+					t.Errorf("unexpected last result: file=%q err=%v", status.LastFile, status.LastErr)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.repo.now = func() time.Time { return now }
+			service := NewBackupService(tt.repo, DefaultBackupInterval, domain.BackupRetention{KeepLast: 2})
+			service.now = func() time.Time { return now }
+
+			_, err := service.RunBackup(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RunBackup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			status, err := service.Status(context.Background())
+			if err != nil {
+				t.Fatalf("Status() error = %v", err)
+			}
+			names := []string{}
+			for _, f := range status.Files {
+				names = append(names, f.Name)
+			}
+			if fmt.Sprint(names) != fmt.Sprint(tt.wantFiles) {
+				t.Errorf("files = %v, want %v", names, tt.wantFiles)
+			}
+			if !status.LastStartedAt.Equal(now) || !status.LastFinishedAt.Equal(now) {
+				t.Errorf("unexpected run time: %v - %v", status.LastStartedAt, status.LastFinishedAt)
+			}
+			tt.checkFunc(t, status)
+		})
+	}
+}
+
+func TestBackupService_FirstRunAt(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		files []*domain.BackupFile
+		want  time.Time
+	}{
+		{
+			name:  "ファイルがなければ直ちに実行する",
+			files: nil,
+			want:  now,
+		},
+		{
+			name:  "最新のファイルから間隔が経過していなければ待つ",
+			files: []*domain.BackupFile{{Name: "a", CreatedAt: now.Add(-30 * time.Hour)}, {Name: "b", CreatedAt: now.Add(-6 * time.Hour)}},
+			want:  now.Add(18 * time.Hour),
+		},
+		{
+			name:  "最新のファイルから間隔が経過していれば直ちに実行する",
+			files: []*domain.BackupFile{{Name: "a", CreatedAt: now.Add(-30 * time.Hour)}},
+			want:  now,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewBackupService(&mockBackupRepository{files: tt.files}, 24*time.Hour, DefaultBackupRetention)
+			service.now = func() time.Time { return now }
+			if got := service.firstRunAt(context.Background()); !got.Equal(tt.want) {
+				t.Errorf("firstRunAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackupService_Run(t *testing.T) {
+	repo := &mockBackupRepository{}
+	service := NewBackupService(repo, 10*time.Millisecond, domain.BackupRetention{KeepLast: 100})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		service.Run(ctx)
+		close(done)
+	}()
+
+	deadline := time.After(5 * time.Second)
+	for {
+		repo.mu.Lock()
+		created := repo.created
+		repo.mu.Unlock()
+		if created >= 2 {
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatalf("expected at least 2 scheduled backups, got %d", created)
+		case <-time.After(time.Millisecond):
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not return after cancel")
+	}
+
+	status, err := service.Status(context.Background())
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+	if status.LastSuccessAt.IsZero() || status.NextAt.IsZero() {
+		t.Errorf("unexpected status: %+v", status)
+	}
+}
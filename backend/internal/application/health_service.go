@@ -0,0 +1,102 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/domain"
+)
+
+// DefaultHealthCheckTimeout は各コンポーネントの確認にかける時間のデフォルト値
+const DefaultHealthCheckTimeout = 2 * time.Second
+
+// ComponentStatus は1つのコンポーネントの確認結果
+type ComponentStatus struct {
+	Name    string
+	Detail  string
+	Err     error // 利用できない場合のエラー
+	Latency time.Duration
+}
+
+// Healthy はコンポーネントが利用可能かどうかを返す
+func (s ComponentStatus) Healthy() bool {
+	return s.Err == nil
+}
+
+// Readiness はリクエストを受け付けられる状態かどうかの確認結果
+type Readiness struct {
+	Components []ComponentStatus
+}
+
+// Ready は全てのコンポーネントが利用可能かどうかを返す
+func (r *Readiness) Ready() bool {
+	for _, c := range r.Components {
+		if !c.Healthy() {
+			return false
+		}
+	}
+	return true
+}
+
+// HealthService はヘルスチェックに関するアプリケーションサービス
+type HealthService struct {
+	checkers []domain.HealthChecker
+	timeout  time.Duration
+}
+
+// NewHealthService はHealthServiceを生成する
+func NewHealthService(checkers ...domain.HealthChecker) *HealthService {
+	return &HealthService{
+		checkers: checkers,
+		timeout:  DefaultHealthCheckTimeout,
+	}
+}
+
+// CheckReadiness は全てのコンポーネントを並行して確認する
+// 結果はコンポーネントの登録順に並ぶ
+func (s *HealthService) CheckReadiness(ctx context.Context) *Readiness {
+	components := make([]ComponentStatus, len(s.checkers))
+
+	var wg sync.WaitGroup
+	for i, checker := range s.checkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			components[i] = s.check(ctx, checker)
+		}()
+	}
+	wg.Wait()
+
+	return &Readiness{Components: components}
+}
+
+// check は1つのコンポーネントをタイムアウト付きで確認する
+// コンテキストに対応していない確認処理でも、タイムアウトした時点で結果を返す
+func (s *HealthService) check(ctx context.Context, checker domain.HealthChecker) ComponentStatus {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	type result struct {
+		detail string
+		err    error
+	}
+	done := make(chan result, 1)
+
+	start := time.Now()
+	go func() {
+		detail, err := checker.Check(ctx)
+		done <- result{detail: detail, err: err}
+	}()
+
+	status := ComponentStatus{Name: checker.Name()}
+	select {
+	case r := <-done:
+		status.Detail, status.Err = r.detail, r.err
+	case <-ctx.Done():
+		status.Err = fmt.Errorf("health check timed out: %w", ctx.Err())
+	}
+	status.Latency = time.Since(start)
+	return status
+}
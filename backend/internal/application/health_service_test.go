@@ -0,0 +1,110 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// mockHealthChecker はテスト用のモックチェッカー
+type mockHealthChecker struct {
+	name   string
+	detail string
+	err    error
+	delay  time.Duration
+}
+
+func (m *mockHealthChecker) Name() string {
+	return m.name
+}
+
+func (m *mockHealthChecker) Check(ctx context.Context) (string, error) {
+	// コンテキストに対応していない確認処理を模倣する
+	time.Sleep(m.delay)
+	return m.detail, m.err
+}
+
+func TestHealthService_CheckReadiness(t *testing.T) {
+	tests := []struct {
+		name      string
+		checkers  []*mockHealthChecker
+		wantReady bool
+		checkFunc func(t *testing.T, readiness *Readiness)
+	}{
+		{
+			name:      "正常系: チェッカーがない場合は準備完了",
+			checkers:  nil,
+			wantReady: true,
+		},
+		{
+			name: "正常系: 全てのコンポーネントが利用可能",
+			checkers: []*mockHealthChecker{
+				{name: "database", detail: "mysql", delay: 20 * time.Millisecond},
+				{name: "schema", detail: "007_add_record_history.sql"},
+			},
+			wantReady: true,
+			checkFunc: func(t *testing.T, readiness *Readiness) {
+				// 結果は登録順に並ぶ
+				if readiness.Components[0].Name != "database" || readiness.Components[1].Name != "schema" {
+					t.Errorf("unexpected order: %+v", readiness.Components)
+				}
+				if readiness.Components[0].Detail != "mysql" {
+					t.Errorf("expected detail mysql, got %q", readiness.Components[0].Detail)
+				}
+				if readiness.Components[0].Latency < 20*time.Millisecond {
+					t.Errorf("expected latency >= 20ms, got %v", readiness.Components[0].Latency)
+				}
+			},
+		},
+		{
+			name: "異常系: 1つでも利用できなければ準備未完了",
+			checkers: []*mockHealthChecker{
+				{name: "database", err: errors.New("connection refused")},
+				{name: "schema", detail: "007_add_record_history.sql"},
+			},
+			wantReady: false,
+			checkFunc: func(t *testing.T, readiness *Readiness) {
+				if readiness.Components[0].Healthy() || !readiness.Components[1].Healthy() {
+					t.Errorf("unexpected component health: %+v", readiness.Components)
+				}
+			},
+		},
+		{
+			name: "異常系: タイムアウトした場合は待たずに結果を返す",
+			checkers: []*mockHealthChecker{
+				{name: "database", delay: time.Second},
+			},
+			wantReady: false,
+			checkFunc: func(t *testing.T, readiness *Readiness) {
+				if !errors.Is(readiness.Components[0].Err, context.DeadlineExceeded) {
+					t.Errorf("expected deadline exceeded, got %v", readiness.Components[0].Err)
+				}
+				if readiness.Components[0].Latency >= time.Second {
+					t.Errorf("expected to give up before the check finished, took %v", readiness.Components[0].Latency)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewHealthService()
+			for _, c := range tt.checkers {
+				service.checkers = append(service.checkers, c)
+			}
+			service.timeout = 100 * time.Millisecond
+
+			readiness := service.CheckReadiness(context.Background())
+			if readiness.Ready() != tt.wantReady {
+				t.Errorf("Ready() = %v, want %v", readiness.Ready(), tt.wantReady)
+			}
+			if len(readiness.Components) != len(tt.checkers) {
+				t.Fatalf("expected %d components, got %d", len(tt.checkers), len(readiness.Components))
+			}
+			if tt.checkFunc != nil {
+				tt.checkFunc(t, readiness)
+			}
+		})
+	}
+}
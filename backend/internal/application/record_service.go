@@ -2,25 +2,254 @@ package application
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/azuki774/mawinter/internal/domain"
 )
 
+// DefaultTrashRetention はゴミ箱内のレコードを保持するデフォルト期間
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
+// rulePageSize は規則を既存のレコードに適用する際に、一度に取得するレコードの件数
+const rulePageSize = 500
+
 // RecordService はレコードに関するアプリケーションサービス
 type RecordService struct {
-	repo domain.RecordRepository
+	repo           domain.RecordRepository
+	categoryRepo   domain.CategoryRepository
+	policy         domain.RecordPolicy
+	aliases        map[string]int
+	rules          *domain.RuleSet
+	observers      []RecordObserver
+	trashRetention time.Duration
+	now            func() time.Time
+}
+
+// RecordServiceOption はRecordServiceの生成時オプション
+type RecordServiceOption func(*RecordService)
+
+// WithTrashRetention はゴミ箱内のレコードを保持する期間を指定する
+func WithTrashRetention(retention time.Duration) RecordServiceOption {
+	return func(s *RecordService) {
+		s.trashRetention = retention
+	}
+}
+
+// WithRecordPolicy はレコードの作成・更新時の検証の規則を指定する
+// 指定しない場合は未来の日付・金額 0 を許可しない
+func WithRecordPolicy(policy domain.RecordPolicy) RecordServiceOption {
+	return func(s *RecordService) {
+		s.policy = policy
+	}
+}
+
+// WithCategoryAliases は短い文の解釈に使うカテゴリの別名（別名 → カテゴリ ID）を指定する
+func WithCategoryAliases(aliases map[string]int) RecordServiceOption {
+	return func(s *RecordService) {
+		s.aliases = aliases
+	}
+}
+
+// WithRules はレコードを自動で分類する規則を指定する
+// 作成時にカテゴリ・種別が省略された場合に規則の値で埋め、規則のタグを追加する
+func WithRules(rules *domain.RuleSet) RecordServiceOption {
+	return func(s *RecordService) {
+		s.rules = rules
+	}
+}
+
+// WithRecordObserver はレコードの作成・更新・ゴミ箱への移動・復元を受け取る RecordObserver を追加する
+func WithRecordObserver(observer RecordObserver) RecordServiceOption {
+	return func(s *RecordService) {
+		s.observers = append(s.observers, observer)
+	}
 }
 
 // NewRecordService はRecordServiceを生成する
-func NewRecordService(repo domain.RecordRepository) *RecordService {
-	return &RecordService{
-		repo: repo,
+// categoryRepo はレコードのカテゴリが存在するかの検証に使用する
+func NewRecordService(repo domain.RecordRepository, categoryRepo domain.CategoryRepository, opts ...RecordServiceOption) *RecordService {
+	s := &RecordService{
+		repo:           repo,
+		categoryRepo:   categoryRepo,
+		trashRetention: DefaultTrashRetention,
+		now:            time.Now,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // CreateRecord は新しいレコードを作成する
+// 日時が省略された（ゼロ値の）場合は現在日時とする
+// 自動分類の規則がある場合は、カテゴリ ID が 0・種別が空の場合に規則の値で埋め、規則のタグを追加する
+// 検証に違反する場合は DB に書き込まず、全ての違反をまとめた ValidationError を返す
 func (s *RecordService) CreateRecord(ctx context.Context, record *domain.Record) (*domain.Record, error) {
-	return s.repo.Create(ctx, record)
+	now := s.now()
+	created := *record
+	if created.Datetime.IsZero() {
+		created.Datetime = now
+	}
+	created.Tags = domain.NormalizeTags(created.Tags)
+	if s.rules != nil {
+		s.rules.Fill(&created)
+	}
+	if err := s.validate(ctx, &created, now); err != nil {
+		return nil, err
+	}
+	saved, err := s.repo.Create(ctx, &created)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range s.observers {
+		o.RecordCreated(saved)
+	}
+	return saved, nil
+}
+
+// UpdateRecord は既存のレコードを更新する
+// 更新前の内容は履歴として保持される
+// 検証に違反する場合は DB に書き込まず、全ての違反をまとめた ValidationError を返す
+func (s *RecordService) UpdateRecord(ctx context.Context, record *domain.Record) (*domain.Record, error) {
+	updated := *record
+	updated.Tags = domain.NormalizeTags(updated.Tags)
+	if err := s.validate(ctx, &updated, s.now()); err != nil {
+		return nil, err
+	}
+	return s.saved(s.repo.Update(ctx, &updated))
+}
+
+// saved はリポジトリの更新・復元が成功した場合に、保存したレコードを RecordObserver に通知する
+func (s *RecordService) saved(record *domain.Record, err error) (*domain.Record, error) {
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range s.observers {
+		o.RecordSaved(record)
+	}
+	return record, nil
+}
+
+// validate は登録されているカテゴリを取得し、レコードを規則に従って検証する
+func (s *RecordService) validate(ctx context.Context, record *domain.Record, now time.Time) error {
+	categories, err := s.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get categories: %w", err)
+	}
+	return s.policy.Validate(record, categories, now)
+}
+
+// ParseQuickEntry は「食費 1280 コンビニ 昨日」のような短い文を解釈し、登録するレコードの内容を返す
+// レコードは作成しない。解釈した内容が作成時の検証に違反する場合は ValidationError を返す
+func (s *RecordService) ParseQuickEntry(ctx context.Context, text string) (*domain.QuickEntry, error) {
+	categories, err := s.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	now := s.now()
+	entry, err := domain.ParseQuickEntry(text, categories, s.aliases, s.rules, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.policy.Validate(entry.Record, categories, now); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// GetRules は設定されている自動分類の規則を優先度の順に返す
+func (s *RecordService) GetRules() []domain.Rule {
+	if s.rules == nil {
+		return []domain.Rule{}
+	}
+	return s.rules.Rules()
+}
+
+// ApplyRules は自動分類の規則を既存のレコード（ゴミ箱内を除く）に適用し、変更されるレコードを ID の降順で返す
+// rules が nil の場合は設定されている規則を使う。yyyymm・categoryID で対象のレコードを絞り込める（GetRecords と同じ）
+// 規則が設定するカテゴリと種別で上書きし、タグを追加する。変更は履歴に記録される
+// dryRun が true の場合は変更しない
+// 適用後の内容が作成・更新時の検証に違反するレコード、確定済みの月のレコード、適用中に削除されたレコードは変更せず、Skipped に理由を設定して返す
+// 更新はレコードごとに行う。DB の障害などで更新に失敗した場合はエラーを返すが、それまでに更新したレコードは変更されたままとなる
+func (s *RecordService) ApplyRules(ctx context.Context, rules *domain.RuleSet, yyyymm string, categoryID int, dryRun bool) ([]*domain.RuleChange, error) {
+	if rules == nil {
+		rules = s.rules
+	}
+	changes := make([]*domain.RuleChange, 0)
+	if rules == nil || rules.Len() == 0 {
+		return changes, nil
+	}
+
+	categories, err := s.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	names := make(map[int]string, len(categories))
+	for _, c := range categories {
+		names[c.CategoryID] = c.Name
+	}
+	verr := &domain.ValidationError{}
+	for _, rule := range rules.Rules() {
+		if _, ok := names[rule.Set.CategoryID]; rule.Set.CategoryID != 0 && !ok {
+			verr.Add("rules", fmt.Sprintf("rule %q: category does not exist: %d", rule.Name, rule.Set.CategoryID))
+		}
+	}
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	// 適用によってカテゴリの絞り込みの結果が変わるため、先に対象のレコードを全て取得する
+	now := s.now()
+	for offset := 0; ; offset += rulePageSize {
+		records, err := s.repo.FindAll(ctx, rulePageSize, offset, yyyymm, categoryID)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range records {
+			change := rules.Reclassify(record)
+			if change == nil {
+				continue
+			}
+			change.After.CategoryName = names[change.After.CategoryID]
+			// 規則が追加するタグで件数の上限を超える場合などは、試行でも適用できないことを返す
+			if err := s.policy.Validate(change.After, categories, now); err != nil {
+				change.Skipped = err.Error()
+			}
+			changes = append(changes, change)
+		}
+		if len(records) < rulePageSize {
+			break
+		}
+	}
+	if dryRun {
+		return changes, nil
+	}
+
+	for _, change := range changes {
+		if change.Skipped != "" {
+			continue
+		}
+		updated, err := s.saved(s.repo.Update(ctx, change.After))
+		var verr *domain.ValidationError
+		if errors.Is(err, domain.ErrMonthLocked) || errors.Is(err, domain.ErrNotFound) || errors.As(err, &verr) {
+			change.Skipped = err.Error()
+			continue
+		}
+		if err != nil {
+			// DB の障害などはレコードごとの理由にせず、サーバのエラーとして返す
+			return nil, fmt.Errorf("failed to update record %d: %w", change.Before.ID, err)
+		}
+		change.After = updated
+	}
+	return changes, nil
+}
+
+// GetRecordHistory は指定されたIDのレコードの履歴を版の古い順に取得する
+func (s *RecordService) GetRecordHistory(ctx context.Context, id int) ([]*domain.RecordVersion, error) {
+	return s.repo.FindHistory(ctx, id)
 }
 
 // GetRecordByID は指定されたIDのレコードを取得する
@@ -38,9 +267,30 @@ func (s *RecordService) CountRecords(ctx context.Context, yyyymm string, categor
 	return s.repo.Count(ctx, yyyymm, categoryID)
 }
 
-// DeleteRecord は指定されたIDのレコードを削除する
+// DeleteRecord は指定されたIDのレコードをゴミ箱に移動する
 func (s *RecordService) DeleteRecord(ctx context.Context, id int) error {
-	return s.repo.Delete(ctx, id)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	for _, o := range s.observers {
+		o.RecordRemoved(id)
+	}
+	return nil
+}
+
+// GetTrashedRecords はゴミ箱内のレコードを取得する（ページネーション対応）
+func (s *RecordService) GetTrashedRecords(ctx context.Context, num, offset int) ([]*domain.Record, error) {
+	return s.repo.FindDeleted(ctx, num, offset)
+}
+
+// RestoreRecord はゴミ箱内のレコードを元に戻す
+func (s *RecordService) RestoreRecord(ctx context.Context, id int) (*domain.Record, error) {
+	return s.saved(s.repo.Restore(ctx, id))
+}
+
+// PurgeTrash は保持期間を過ぎたゴミ箱内のレコードを物理削除し、削除件数を返す
+func (s *RecordService) PurgeTrash(ctx context.Context) (int, error) {
+	return s.repo.Purge(ctx, s.now().Add(-s.trashRetention))
 }
 
 // GetAvailablePeriods はDBに登録されているレコードのYYYYMMとFY(年度)の一覧を取得する
@@ -52,3 +302,85 @@ func (s *RecordService) GetAvailablePeriods(ctx context.Context) ([]string, []st
 func (s *RecordService) GetYearSummary(ctx context.Context, year int) ([]*domain.CategoryYearSummary, error) {
 	return s.repo.GetYearSummary(ctx, year)
 }
+
+// GetPeriodSummary は指定された期間（暦年・任意の期間）のカテゴリ別サマリーを、区切る単位ごとの金額とともに取得する
+func (s *RecordService) GetPeriodSummary(ctx context.Context, period domain.SummaryPeriod) ([]*domain.CategoryPeriodSummary, error) {
+	return s.repo.GetPeriodSummary(ctx, period)
+}
+
+// CompareMonths は yyyymm の月のカテゴリ別・種類別の金額を、with の月と比較する
+// with が空の場合は前年の同じ月と比較する。会計年度のサマリー（GetYearSummary）の月別の金額を使う
+func (s *RecordService) CompareMonths(ctx context.Context, yyyymm, with string) (*domain.SummaryComparison, error) {
+	verr := &domain.ValidationError{}
+	current, err := time.ParseInLocation("200601", yyyymm, time.Local)
+	if err != nil {
+		verr.Add("yyyymm", fmt.Sprintf("must be in YYYYMM format: %q", yyyymm))
+	}
+	comparison := current.AddDate(-1, 0, 0)
+	if with != "" {
+		if comparison, err = time.ParseInLocation("200601", with, time.Local); err != nil {
+			verr.Add("with", fmt.Sprintf("must be in YYYYMM format: %q", with))
+		}
+	}
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	currentAmounts, err := s.monthAmounts(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+	comparisonAmounts, err := s.monthAmounts(ctx, comparison)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewSummaryComparison(current.Format("200601"), comparison.Format("200601"), currentAmounts, comparisonAmounts), nil
+}
+
+// CompareYears は会計年度 year のカテゴリ別・種類別の金額を、会計年度 with と比較する
+// with が 0 の場合は前年度と比較する
+func (s *RecordService) CompareYears(ctx context.Context, year, with int) (*domain.SummaryComparison, error) {
+	if with == 0 {
+		with = year - 1
+	}
+	total := func(summary *domain.CategoryYearSummary) int { return summary.Total }
+	currentAmounts, err := s.yearAmounts(ctx, year, total)
+	if err != nil {
+		return nil, err
+	}
+	comparisonAmounts, err := s.yearAmounts(ctx, with, total)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewSummaryComparison(strconv.Itoa(year), strconv.Itoa(with), currentAmounts, comparisonAmounts), nil
+}
+
+// monthAmounts は月を含む会計年度のサマリーから、その月のカテゴリ別の金額を返す
+func (s *RecordService) monthAmounts(ctx context.Context, month time.Time) ([]domain.CategoryAmount, error) {
+	index := domain.FiscalMonthIndex(month)
+	return s.yearAmounts(ctx, domain.FiscalYear(month), func(summary *domain.CategoryYearSummary) int { return summary.Price[index] })
+}
+
+// yearAmounts は会計年度のサマリーから、price で選んだカテゴリ別の金額を返す
+func (s *RecordService) yearAmounts(ctx context.Context, year int, price func(*domain.CategoryYearSummary) int) ([]domain.CategoryAmount, error) {
+	summaries, err := s.repo.GetYearSummary(ctx, year)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get year summary for %d: %w", year, err)
+	}
+	amounts := make([]domain.CategoryAmount, len(summaries))
+	for i, summary := range summaries {
+		amounts[i] = domain.CategoryAmount{
+			CategoryID:   summary.CategoryID,
+			CategoryName: summary.CategoryName,
+			CategoryType: summary.CategoryType,
+			Price:        price(summary),
+		}
+	}
+	return amounts, nil
+}
+
+// GetYearSummaryAsOf は asOf 時点のデータに基づく、指定された会計年度のカテゴリ別サマリーを取得する
+// 過去のレポートとの差異を説明するために使用する
+func (s *RecordService) GetYearSummaryAsOf(ctx context.Context, year int, asOf time.Time) ([]*domain.CategoryYearSummary, error) {
+	return s.repo.GetYearSummaryAsOf(ctx, year, asOf)
+}
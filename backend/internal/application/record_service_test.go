@@ -0,0 +1,443 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/domain"
+)
+
+// mockRecordRepository はテスト用のモックリポジトリ
+// 作成・更新・一覧の取得のみを実装し、作成・更新で渡されたレコードを記録する
+type mockRecordRepository struct {
+	domain.RecordRepository
+	created *domain.Record
+	updated *domain.Record
+	records []*domain.Record // FindAll が返すレコード（絞り込みはしない）
+	locked  map[int]bool     // 更新すると MonthLockedError を返すレコードの ID
+	failing map[int]error    // 更新するとエラーを返すレコードの ID と、返すエラー
+	// GetYearSummary が返す会計年度ごとのサマリー
+	summaries map[int][]*domain.CategoryYearSummary
+}
+
+// errConnectionLost は DB の障害を表すテスト用のエラー
+var errConnectionLost = errors.New("connection lost")
+
+func (m *mockRecordRepository) Create(ctx context.Context, record *domain.Record) (*domain.Record, error) {
+	m.created = record
+	return record, nil
+}
+
+func (m *mockRecordRepository) Update(ctx context.Context, record *domain.Record) (*domain.Record, error) {
+	if m.locked[record.ID] {
+		return nil, &domain.MonthLockedError{YYYYMM: record.Datetime.Format("200601")}
+	}
+	if err := m.failing[record.ID]; err != nil {
+		return nil, err
+	}
+	m.updated = record
+	return record, nil
+}
+
+func (m *mockRecordRepository) FindAll(ctx context.Context, num, offset int, yyyymm string, categoryID int) ([]*domain.Record, error) {
+	if offset >= len(m.records) {
+		return []*domain.Record{}, nil
+	}
+	return m.records[offset:min(offset+num, len(m.records))], nil
+}
+
+func (m *mockRecordRepository) GetYearSummary(ctx context.Context, year int) ([]*domain.CategoryYearSummary, error) {
+	return m.summaries[year], nil
+}
+
+func TestRecordService_CreateRecord(t *testing.T) {
+	now := time.Date(2025, 10, 19, 12, 0, 0, 0, time.UTC)
+	categoryRepo := &mockCategoryRepository{
+		categories: []*domain.Category{{ID: 1, CategoryID: 210, Name: "食費", CategoryType: domain.CategoryTypeOutgoing}},
+	}
+	rules, err := domain.NewRuleSet([]domain.Rule{
+		{Name: "スーパー", Match: domain.RuleMatch{MemoContains: "スーパー"}, Set: domain.RuleAction{CategoryID: 210}},
+	})
+	if err != nil {
+		t.Fatalf("NewRuleSet() error = %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		record       *domain.Record
+		policy       domain.RecordPolicy
+		categoryRepo *mockCategoryRepository
+		wantErr      error
+		wantDatetime time.Time
+	}{
+		{
+			name:         "正常系: 日時を省略した場合は現在日時とする",
+			record:       &domain.Record{CategoryID: 210, Price: 1280},
+			categoryRepo: categoryRepo,
+			wantDatetime: now,
+		},
+		{
+			name:         "正常系: 指定した日時を使用する",
+			record:       &domain.Record{CategoryID: 210, Price: 1280, Datetime: now.AddDate(0, -1, 0)},
+			categoryRepo: categoryRepo,
+			wantDatetime: now.AddDate(0, -1, 0),
+		},
+		{
+			name:         "正常系: 規則で許可した金額 0",
+			record:       &domain.Record{CategoryID: 210},
+			policy:       domain.RecordPolicy{AllowZeroPrice: true},
+			categoryRepo: categoryRepo,
+			wantDatetime: now,
+		},
+		{
+			name:         "正常系: カテゴリを省略した場合は規則のカテゴリ",
+			record:       &domain.Record{Price: 1280, Memo: "スーパー"},
+			categoryRepo: categoryRepo,
+			wantDatetime: now,
+		},
+		{
+			name:         "異常系: 規則に一致せずカテゴリがない",
+			record:       &domain.Record{Price: 1280, Memo: "不明"},
+			categoryRepo: categoryRepo,
+			wantErr:      domain.ErrValidation,
+		},
+		{
+			name:         "異常系: 違反がある場合は作成しない",
+			record:       &domain.Record{CategoryID: 999, Price: 0, Datetime: now.AddDate(0, 0, 2)},
+			categoryRepo: categoryRepo,
+			wantErr:      domain.ErrValidation,
+		},
+		{
+			name:         "異常系: カテゴリの取得に失敗",
+			record:       &domain.Record{CategoryID: 210, Price: 1280},
+			categoryRepo: &mockCategoryRepository{err: context.DeadlineExceeded},
+			wantErr:      context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockRecordRepository{}
+			s := NewRecordService(repo, tt.categoryRepo, WithRecordPolicy(tt.policy), WithRules(rules))
+			s.now = func() time.Time { return now }
+
+			_, err := s.CreateRecord(context.Background(), tt.record)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				if repo.created != nil {
+					t.Errorf("record must not be created: %+v", repo.created)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if repo.created == nil || !repo.created.Datetime.Equal(tt.wantDatetime) {
+				t.Errorf("expected datetime %v, got %+v", tt.wantDatetime, repo.created)
+			}
+		})
+	}
+}
+
+func TestRecordService_UpdateRecord(t *testing.T) {
+	now := time.Date(2025, 10, 19, 12, 0, 0, 0, time.UTC)
+	categoryRepo := &mockCategoryRepository{
+		categories: []*domain.Category{{ID: 1, CategoryID: 210, Name: "食費", CategoryType: domain.CategoryTypeOutgoing}},
+	}
+
+	tests := []struct {
+		name       string
+		record     *domain.Record
+		wantFields int // 違反の数（0 の場合は更新される）
+	}{
+		{
+			name:   "正常系: 日時を省略した場合は日時を検証しない",
+			record: &domain.Record{ID: 1, CategoryID: 210, Price: 1280},
+		},
+		{
+			name:       "異常系: 全ての違反をまとめて返す",
+			record:     &domain.Record{ID: 1, CategoryID: 999, Price: -1, Memo: string(make([]byte, domain.MaxRecordMemoLength+1))},
+			wantFields: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockRecordRepository{}
+			s := NewRecordService(repo, categoryRepo)
+			s.now = func() time.Time { return now }
+
+			_, err := s.UpdateRecord(context.Background(), tt.record)
+			if tt.wantFields == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if repo.updated == nil || !repo.updated.Datetime.IsZero() {
+					t.Errorf("expected record with zero datetime to be updated, got %+v", repo.updated)
+				}
+				return
+			}
+
+			var verr *domain.ValidationError
+			if !errors.As(err, &verr) || len(verr.Fields) != tt.wantFields {
+				t.Fatalf("expected %d field errors, got %v", tt.wantFields, err)
+			}
+			if repo.updated != nil {
+				t.Errorf("record must not be updated: %+v", repo.updated)
+			}
+		})
+	}
+}
+
+func TestRecordService_ParseQuickEntry(t *testing.T) {
+	now := time.Date(2025, 10, 19, 12, 0, 0, 0, time.UTC)
+	categoryRepo := &mockCategoryRepository{
+		categories: []*domain.Category{{ID: 1, CategoryID: 210, Name: "食費", CategoryType: domain.CategoryTypeOutgoing}},
+	}
+
+	tests := []struct {
+		name         string
+		text         string
+		categoryRepo *mockCategoryRepository
+		wantCategory int
+		wantErr      error
+	}{
+		{
+			name:         "正常系: 別名でカテゴリを解釈する",
+			text:         "ランチ 1280",
+			categoryRepo: categoryRepo,
+			wantCategory: 210,
+		},
+		{
+			name:         "異常系: 解釈した内容が作成時の検証に違反する",
+			text:         "食費 0 今日",
+			categoryRepo: categoryRepo,
+			wantErr:      domain.ErrValidation,
+		},
+		{
+			name:         "異常系: カテゴリの取得に失敗",
+			text:         "食費 1280",
+			categoryRepo: &mockCategoryRepository{err: context.DeadlineExceeded},
+			wantErr:      context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockRecordRepository{}
+			s := NewRecordService(repo, tt.categoryRepo, WithCategoryAliases(map[string]int{"ランチ": 210}))
+			s.now = func() time.Time { return now }
+
+			entry, err := s.ParseQuickEntry(context.Background(), tt.text)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if entry.Record.CategoryID != tt.wantCategory {
+				t.Errorf("expected category %d, got %d", tt.wantCategory, entry.Record.CategoryID)
+			}
+			// 解釈のみでレコードは作成しない
+			if repo.created != nil {
+				t.Errorf("record must not be created: %+v", repo.created)
+			}
+		})
+	}
+}
+
+func TestRecordService_ApplyRules(t *testing.T) {
+	categoryRepo := &mockCategoryRepository{
+		categories: []*domain.Category{
+			{ID: 1, CategoryID: 210, Name: "食費", CategoryType: domain.CategoryTypeOutgoing},
+			{ID: 2, CategoryID: 220, Name: "電気代", CategoryType: domain.CategoryTypeOutgoing},
+		},
+	}
+	configured, err := domain.NewRuleSet([]domain.Rule{
+		{Name: "電気", Match: domain.RuleMatch{MemoContains: "電気"}, Set: domain.RuleAction{CategoryID: 220, Tags: []string{"光熱費"}}},
+	})
+	if err != nil {
+		t.Fatalf("NewRuleSet() error = %v", err)
+	}
+	unknown, err := domain.NewRuleSet([]domain.Rule{
+		{Name: "不明", Match: domain.RuleMatch{MemoContains: "電気"}, Set: domain.RuleAction{CategoryID: 999}},
+	})
+	if err != nil {
+		t.Fatalf("NewRuleSet() error = %v", err)
+	}
+	datetime := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	records := func() []*domain.Record {
+		return []*domain.Record{
+			{ID: 3, CategoryID: 210, CategoryName: "食費", Datetime: datetime, Price: 100, Memo: "電気代"},
+			{ID: 2, CategoryID: 210, CategoryName: "食費", Datetime: datetime, Price: 100, Memo: "ランチ"},
+			{ID: 1, CategoryID: 210, CategoryName: "食費", Datetime: datetime, Price: 100, Memo: "電気代 3月分"},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		rules       *domain.RuleSet
+		dryRun      bool
+		tags        []string // ID 1 のレコードのタグ
+		locked      map[int]bool
+		failing     map[int]error
+		wantIDs     []int
+		wantSkipped int
+		wantUpdated bool
+		wantErr     error
+	}{
+		{
+			name:    "正常系: 試行では変更されるレコードを返し、更新しない",
+			dryRun:  true,
+			wantIDs: []int{3, 1},
+		},
+		{
+			name:        "正常系: 確定済みの月のレコードは更新せずに理由を返す",
+			locked:      map[int]bool{1: true},
+			wantIDs:     []int{3, 1},
+			wantSkipped: 1,
+			wantUpdated: true,
+		},
+		{
+			name:        "異常系: 規則のタグを追加すると件数の上限を超えるレコードは試行でも理由を返す",
+			dryRun:      true,
+			tags:        []string{"t1", "t2", "t3", "t4", "t5", "t6", "t7", "t8", "t9", "t10"},
+			wantIDs:     []int{3, 1},
+			wantSkipped: 1,
+		},
+		{
+			name:        "異常系: 適用中に削除されたレコードは理由を返す",
+			failing:     map[int]error{1: domain.NewNotFoundError("record", 1)},
+			wantIDs:     []int{3, 1},
+			wantSkipped: 1,
+			wantUpdated: true,
+		},
+		{
+			name:    "異常系: DB の障害などドメインのエラー以外はエラーを返す",
+			failing: map[int]error{1: errConnectionLost},
+			wantErr: errConnectionLost,
+		},
+		{
+			name:    "異常系: 存在しないカテゴリを設定する規則",
+			rules:   unknown,
+			wantErr: domain.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recs := records()
+			recs[2].Tags = tt.tags
+			repo := &mockRecordRepository{records: recs, locked: tt.locked, failing: tt.failing}
+			s := NewRecordService(repo, categoryRepo, WithRules(configured))
+
+			changes, err := s.ApplyRules(context.Background(), tt.rules, "", 0, tt.dryRun)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var ids []int
+			skipped := 0
+			for _, c := range changes {
+				ids = append(ids, c.Before.ID)
+				if c.Skipped != "" {
+					skipped++
+				}
+				if c.After.CategoryID != 220 || c.After.CategoryName != "電気代" || c.Before.CategoryID != 210 {
+					t.Errorf("unexpected change: before %+v, after %+v", c.Before, c.After)
+				}
+			}
+			if len(ids) != len(tt.wantIDs) || ids[0] != tt.wantIDs[0] || ids[1] != tt.wantIDs[1] {
+				t.Errorf("expected changed records %v, got %v", tt.wantIDs, ids)
+			}
+			if skipped != tt.wantSkipped {
+				t.Errorf("expected %d skipped records, got %d", tt.wantSkipped, skipped)
+			}
+			if (repo.updated != nil) != tt.wantUpdated {
+				t.Errorf("expected updated = %v, got %+v", tt.wantUpdated, repo.updated)
+			}
+		})
+	}
+}
+
+func TestRecordService_CompareMonths(t *testing.T) {
+	food := func(price [12]int) *domain.CategoryYearSummary {
+		return &domain.CategoryYearSummary{CategoryID: 210, CategoryName: "食費", CategoryType: domain.CategoryTypeOutgoing, Price: price}
+	}
+	repo := &mockRecordRepository{summaries: map[int][]*domain.CategoryYearSummary{
+		// 会計年度の月は4月〜3月の順
+		2024: {food([12]int{6: 48000, 9: 30000})},
+		2025: {food([12]int{6: 52000})},
+	}}
+	s := NewRecordService(repo, &mockCategoryRepository{})
+
+	tests := []struct {
+		name           string
+		yyyymm         string
+		with           string
+		wantComparison string
+		wantCurrent    int
+		wantPrevious   int
+		wantErr        bool
+	}{
+		{name: "正常系: 省略した場合は前年の同じ月", yyyymm: "202510", wantComparison: "202410", wantCurrent: 52000, wantPrevious: 48000},
+		{name: "正常系: 前の会計年度の1月と比較する", yyyymm: "202510", with: "202501", wantComparison: "202501", wantCurrent: 52000, wantPrevious: 30000},
+		{name: "異常系: 不正な月", yyyymm: "2025-10", wantErr: true},
+		{name: "異常系: 不正な比較する月", yyyymm: "202510", with: "202513", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.CompareMonths(context.Background(), tt.yyyymm, tt.with)
+			if tt.wantErr {
+				var verr *domain.ValidationError
+				if !errors.As(err, &verr) {
+					t.Fatalf("expected ValidationError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CompareMonths() error = %v", err)
+			}
+			if got.Current != tt.yyyymm || got.Comparison != tt.wantComparison {
+				t.Errorf("unexpected periods: %s, %s", got.Current, got.Comparison)
+			}
+			if len(got.Categories) != 1 || got.Categories[0].Current != tt.wantCurrent || got.Categories[0].Comparison != tt.wantPrevious {
+				t.Errorf("unexpected categories: %+v", got.Categories)
+			}
+		})
+	}
+}
+
+func TestRecordService_CompareYears(t *testing.T) {
+	repo := &mockRecordRepository{summaries: map[int][]*domain.CategoryYearSummary{
+		2023: {{CategoryID: 100, CategoryName: "月給", CategoryType: domain.CategoryTypeIncome, Total: 3000000}},
+		2024: {{CategoryID: 100, CategoryName: "月給", CategoryType: domain.CategoryTypeIncome, Total: 3300000}},
+	}}
+	s := NewRecordService(repo, &mockCategoryRepository{})
+
+	got, err := s.CompareYears(context.Background(), 2024, 0)
+	if err != nil {
+		t.Fatalf("CompareYears() error = %v", err)
+	}
+	if got.Current != "2024" || got.Comparison != "2023" {
+		t.Errorf("unexpected periods: %s, %s", got.Current, got.Comparison)
+	}
+	income := got.CategoryTypes[0]
+	if income.CategoryType != domain.CategoryTypeIncome || income.Difference != 300000 || income.Percentage == nil || *income.Percentage != 10 {
+		t.Errorf("unexpected income comparison: %+v", income)
+	}
+}
@@ -0,0 +1,153 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/domain"
+)
+
+// DefaultSuggestionRebuildInterval はカテゴリの推定モデルを全てのレコードから作り直すデフォルトの間隔
+// 差分の反映は RecordService の操作ごとに行うため、作り直しはバックアップからの復元など DB を直接変更した場合に備えるもの
+const DefaultSuggestionRebuildInterval = 24 * time.Hour
+
+// suggestionPageSize は推定モデルの学習時に、一度に取得するレコードの件数
+const suggestionPageSize = 500
+
+// RecordObserver はレコードの作成・更新・削除を受け取る
+// RecordService は操作が成功した後に呼び出す
+type RecordObserver interface {
+	// RecordCreated はレコードが作成された場合に呼び出される
+	RecordCreated(record *domain.Record)
+	// RecordSaved はレコードが更新・復元された場合に呼び出される
+	RecordSaved(record *domain.Record)
+	// RecordRemoved はレコードがゴミ箱に移動された場合に呼び出される
+	RecordRemoved(id int)
+}
+
+// SuggestionService は過去のレコードからカテゴリを推定するアプリケーションサービス
+// 最初の推定時に全てのレコード（ゴミ箱内を除く）から学習し、以降は RecordObserver として差分を反映する
+type SuggestionService struct {
+	recordRepo      domain.RecordRepository
+	categoryRepo    domain.CategoryRepository
+	rebuildInterval time.Duration
+	now             func() time.Time
+
+	mu        sync.Mutex
+	model     *domain.SuggestionModel // 未学習の場合は nil
+	trainedAt time.Time
+}
+
+// SuggestionServiceOption はSuggestionServiceの生成時オプション
+type SuggestionServiceOption func(*SuggestionService)
+
+// WithSuggestionRebuildInterval は推定モデルを全てのレコードから作り直す間隔を指定する
+// 0 以下の場合は作り直さない
+func WithSuggestionRebuildInterval(interval time.Duration) SuggestionServiceOption {
+	return func(s *SuggestionService) {
+		s.rebuildInterval = interval
+	}
+}
+
+// NewSuggestionService はSuggestionServiceを生成する
+// categoryRepo は推定したカテゴリの名前・種別の取得に使用する
+func NewSuggestionService(recordRepo domain.RecordRepository, categoryRepo domain.CategoryRepository, opts ...SuggestionServiceOption) *SuggestionService {
+	s := &SuggestionService{
+		recordRepo:      recordRepo,
+		categoryRepo:    categoryRepo,
+		rebuildInterval: DefaultSuggestionRebuildInterval,
+		now:             time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// SuggestCategories はレコード（メモ・登録元・金額）のカテゴリの候補を確からしい順に最大 limit 件返す
+// 学習したレコードの件数も返す。推定できない場合は空のスライスを返す
+// 登録されていないカテゴリ（削除されたカテゴリ）の候補は除く
+func (s *SuggestionService) SuggestCategories(ctx context.Context, record *domain.Record, limit int) ([]*domain.CategorySuggestion, int, error) {
+	categories, err := s.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get categories: %w", err)
+	}
+	byID := make(map[int]*domain.Category, len(categories))
+	for _, c := range categories {
+		byID[c.CategoryID] = c
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.trainLocked(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	// 削除されたカテゴリの候補を除くため、全てのカテゴリの候補を受け取ってから件数を制限する
+	suggestions := make([]*domain.CategorySuggestion, 0, limit)
+	for _, sg := range s.model.Suggest(record, s.model.Len()) {
+		c, ok := byID[sg.CategoryID]
+		if !ok {
+			continue
+		}
+		sg.CategoryName = c.Name
+		sg.CategoryType = c.CategoryType
+		suggestions = append(suggestions, &sg)
+		if len(suggestions) == limit {
+			break
+		}
+	}
+	return suggestions, s.model.Len(), nil
+}
+
+// RecordCreated は作成されたレコードを推定モデルに反映する
+func (s *SuggestionService) RecordCreated(record *domain.Record) {
+	s.RecordSaved(record)
+}
+
+// RecordSaved はレコードの更新・復元を推定モデルに反映する
+// 未学習の場合は、最初の推定時に DB から学習するため何もしない
+func (s *SuggestionService) RecordSaved(record *domain.Record) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.model != nil {
+		s.model.Observe(record)
+	}
+}
+
+// RecordRemoved はレコードのゴミ箱への移動を推定モデルに反映する
+func (s *SuggestionService) RecordRemoved(id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.model != nil {
+		s.model.Forget(id)
+	}
+}
+
+// trainLocked は未学習か、前回の学習から作り直す間隔を過ぎた場合に全てのレコードから学習する
+// s.mu を取得した状態で呼び出すこと
+func (s *SuggestionService) trainLocked(ctx context.Context) error {
+	now := s.now()
+	if s.model != nil && (s.rebuildInterval <= 0 || now.Sub(s.trainedAt) < s.rebuildInterval) {
+		return nil
+	}
+
+	model := domain.NewSuggestionModel()
+	for offset := 0; ; offset += suggestionPageSize {
+		records, err := s.recordRepo.FindAll(ctx, suggestionPageSize, offset, "", 0)
+		if err != nil {
+			return fmt.Errorf("failed to get records: %w", err)
+		}
+		for _, record := range records {
+			model.Observe(record)
+		}
+		if len(records) < suggestionPageSize {
+			break
+		}
+	}
+	s.model = model
+	s.trainedAt = now
+	return nil
+}
@@ -0,0 +1,126 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/domain"
+)
+
+func TestSuggestionService_SuggestCategories(t *testing.T) {
+	categories := []*domain.Category{
+		{ID: 1, CategoryID: 210, Name: "食費", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 2, CategoryID: 220, Name: "電気代", CategoryType: domain.CategoryTypeOutgoing},
+	}
+	records := []*domain.Record{
+		{ID: 3, CategoryID: 210, Memo: "コンビニ", From: "card", Price: 480},
+		{ID: 2, CategoryID: 220, Memo: "電気", From: "bank", Price: 8200},
+		{ID: 1, CategoryID: 999, Memo: "コンビニ", From: "card", Price: 500}, // 削除されたカテゴリ
+	}
+
+	tests := []struct {
+		name         string
+		categoryRepo *mockCategoryRepository
+		record       *domain.Record
+		limit        int
+		want         []int
+		wantErr      bool
+	}{
+		{
+			name:         "正常系: 確からしい順に返し、削除されたカテゴリは除く",
+			categoryRepo: &mockCategoryRepository{categories: categories},
+			record:       &domain.Record{Memo: "コンビニ", From: "card"},
+			limit:        3,
+			want:         []int{210, 220},
+		},
+		{
+			name:         "正常系: 件数を制限する",
+			categoryRepo: &mockCategoryRepository{categories: categories},
+			record:       &domain.Record{Memo: "電気", Price: 7000},
+			limit:        1,
+			want:         []int{220},
+		},
+		{
+			name:         "異常系: カテゴリの取得に失敗",
+			categoryRepo: &mockCategoryRepository{err: errors.New("db error")},
+			record:       &domain.Record{Memo: "コンビニ"},
+			limit:        3,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSuggestionService(&mockRecordRepository{records: records}, tt.categoryRepo)
+			got, trained, err := s.SuggestCategories(context.Background(), tt.record, tt.limit)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SuggestCategories() error = %v", err)
+			}
+			if trained != len(records) {
+				t.Errorf("expected %d trained records, got %d", len(records), trained)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("SuggestCategories() = %+v, want categories %v", got, tt.want)
+			}
+			for i, sg := range got {
+				if sg.CategoryID != tt.want[i] || sg.CategoryName == "" || sg.CategoryType != domain.CategoryTypeOutgoing {
+					t.Errorf("SuggestCategories()[%d] = %+v, want category %d", i, sg, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSuggestionService_Refresh(t *testing.T) {
+	now := time.Date(2025, 10, 19, 12, 0, 0, 0, time.UTC)
+	categoryRepo := &mockCategoryRepository{categories: []*domain.Category{
+		{ID: 1, CategoryID: 210, Name: "食費", CategoryType: domain.CategoryTypeOutgoing},
+		{ID: 2, CategoryID: 220, Name: "電気代", CategoryType: domain.CategoryTypeOutgoing},
+	}}
+	recordRepo := &mockRecordRepository{records: []*domain.Record{
+		{ID: 1, CategoryID: 210, Memo: "コンビニ", Price: 480, Datetime: now},
+	}}
+	suggestions := NewSuggestionService(recordRepo, categoryRepo, WithSuggestionRebuildInterval(time.Hour))
+	suggestions.now = func() time.Time { return now }
+	records := NewRecordService(recordRepo, categoryRepo, WithRecordObserver(suggestions))
+	records.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	suggest := func() (int, int) {
+		t.Helper()
+		got, trained, err := suggestions.SuggestCategories(ctx, &domain.Record{Memo: "電気"}, 1)
+		if err != nil {
+			t.Fatalf("SuggestCategories() error = %v", err)
+		}
+		if len(got) == 0 {
+			return 0, trained
+		}
+		return got[0].CategoryID, trained
+	}
+
+	if got, trained := suggest(); got != 0 || trained != 1 {
+		t.Fatalf("expected no suggestion from 1 record, got %d (%d records)", got, trained)
+	}
+
+	// 作成したレコードは DB から読み直さずに反映する
+	if _, err := records.CreateRecord(ctx, &domain.Record{ID: 2, CategoryID: 220, Memo: "電気", Price: 8200}); err != nil {
+		t.Fatalf("CreateRecord() error = %v", err)
+	}
+	if got, trained := suggest(); got != 220 || trained != 2 {
+		t.Errorf("expected category 220 from 2 records, got %d (%d records)", got, trained)
+	}
+
+	// 作り直す間隔を過ぎた場合は DB のレコードから学習し直す
+	now = now.Add(2 * time.Hour)
+	if got, trained := suggest(); got != 0 || trained != 1 {
+		t.Errorf("expected rebuilt model from 1 record, got %d (%d records)", got, trained)
+	}
+}
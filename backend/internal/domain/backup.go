@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// BackupFile は保存済みのバックアップファイル
+type BackupFile struct {
+	Name      string    // ファイル名
+	CreatedAt time.Time // 作成日時
+	Size      int64     // ファイルサイズ（バイト）
+}
+
+// BackupRetention はバックアップファイルの保持ポリシー
+// いずれかの条件に該当するファイルを保持し、どの条件にも該当しないファイルを削除対象とする
+type BackupRetention struct {
+	KeepLast    int // 新しい順に保持する件数
+	KeepDaily   int // 直近の何日分について、日ごとに最新の1件を保持するか
+	KeepMonthly int // 直近の何か月分について、月ごとに最新の1件を保持するか
+}
+
+// Expired は保持ポリシーのどの条件にも該当しないバックアップファイルを新しい順に返す
+// 最新のファイルはポリシーに関わらず常に保持する
+func (p BackupRetention) Expired(files []*BackupFile) []*BackupFile {
+	sorted := make([]*BackupFile, len(files))
+	copy(sorted, files)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.After(sorted[j].CreatedAt)
+	})
+
+	days := make(map[string]bool)
+	months := make(map[string]bool)
+	var expired []*BackupFile
+	for i, f := range sorted {
+		keep := i == 0 || i < p.KeepLast
+
+		day := f.CreatedAt.Format("2006-01-02")
+		if !days[day] && len(days) < p.KeepDaily {
+			days[day] = true
+			keep = true
+		}
+
+		month := f.CreatedAt.Format("2006-01")
+		if !months[month] && len(months) < p.KeepMonthly {
+			months[month] = true
+			keep = true
+		}
+
+		if !keep {
+			expired = append(expired, f)
+		}
+	}
+	return expired
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "context"
+
+// BackupRepository はバックアップファイルのリポジトリのインターフェース
+type BackupRepository interface {
+	// Create は現在のデータのバックアップファイルを作成する
+	Create(ctx context.Context) (*BackupFile, error)
+
+	// List は保存済みのバックアップファイルを作成日時の新しい順に取得する
+	List(ctx context.Context) ([]*BackupFile, error)
+
+	// Remove は指定された名前のバックアップファイルを削除する
+	Remove(ctx context.Context, name string) error
+}
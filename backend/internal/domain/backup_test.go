@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBackupRetention_Expired(t *testing.T) {
+	// 2025-01-01 〜 2025-03-31 の毎日 3:00 と 15:00 に作成したファイル（新しい順に並べない）
+	var files []*BackupFile
+	for d := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC); d.Month() <= time.March; d = d.AddDate(0, 0, 1) {
+		files = append(files,
+			&BackupFile{Name: d.Format("20060102") + "-0300", CreatedAt: d.Add(3 * time.Hour)},
+			&BackupFile{Name: d.Format("20060102") + "-1500", CreatedAt: d.Add(15 * time.Hour)},
+		)
+	}
+
+	tests := []struct {
+		name      string
+		retention BackupRetention
+		files     []*BackupFile
+		wantKept  []string
+	}{
+		{
+			name:      "KeepLast: 新しい順に指定件数を保持する",
+			retention: BackupRetention{KeepLast: 3},
+			files:     files,
+			wantKept:  []string{"20250331-1500", "20250331-0300", "20250330-1500"},
+		},
+		{
+			name:      "KeepDaily: 日ごとに最新の1件を保持する",
+			retention: BackupRetention{KeepDaily: 3},
+			files:     files,
+			wantKept:  []string{"20250331-1500", "20250330-1500", "20250329-1500"},
+		},
+		{
+			name:      "KeepMonthly: 月ごとに最新の1件を保持する",
+			retention: BackupRetention{KeepMonthly: 2},
+			files:     files,
+			wantKept:  []string{"20250331-1500", "20250228-1500"},
+		},
+		{
+			name:      "条件の組み合わせ: いずれかに該当すれば保持する",
+			retention: BackupRetention{KeepLast: 2, KeepDaily: 2, KeepMonthly: 3},
+			files:     files,
+			wantKept:  []string{"20250331-1500", "20250331-0300", "20250330-1500", "20250228-1500", "20250131-1500"},
+		},
+		{
+			name:      "全て0でも最新のファイルは保持する",
+			retention: BackupRetention{},
+			files:     files,
+			wantKept:  []string{"20250331-1500"},
+		},
+		{
+			name:      "ファイルがない",
+			retention: BackupRetention{KeepLast: 1},
+			files:     nil,
+			wantKept:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expired := tt.retention.Expired(tt.files)
+
+			removed := make(map[string]bool, len(expired))
+			for i, f := range expired {
+				removed[f.Name] = true
+				if i > 0 && f.CreatedAt.After(expired[i-1].CreatedAt) {
+					t.Errorf("Expired() is not sorted newest first at %d", i)
+				}
+			}
+			var kept []string
+			for i := len(tt.files) - 1; i >= 0; i-- {
+				if !removed[tt.files[i].Name] {
+					kept = append(kept, tt.files[i].Name)
+				}
+			}
+			if !reflect.DeepEqual(kept, tt.wantKept) {
+				t.Errorf("kept = %v, want %v", kept, tt.wantKept)
+			}
+			if len(kept)+len(expired) != len(tt.files) {
+				t.Errorf("kept %d + expired %d != %d files", len(kept), len(expired), len(tt.files))
+			}
+		})
+	}
+}
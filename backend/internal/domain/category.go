@@ -30,6 +30,11 @@ func (ct CategoryType) String() string {
 	}
 }
 
+// CategoryTypes は全てのCategoryTypeを定義順に返す
+func CategoryTypes() []CategoryType {
+	return []CategoryType{CategoryTypeIncome, CategoryTypeOutgoing, CategoryTypeSaving, CategoryTypeInvesting}
+}
+
 // CategoryTypeLookup は文字列をCategoryTypeに変換するマップ
 var CategoryTypeLookup = map[string]CategoryType{
 	"income":    CategoryTypeIncome,
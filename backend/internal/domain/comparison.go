@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"math"
+	"sort"
+)
+
+// PriceComparison は2つの期間の金額の比較を表す
+type PriceComparison struct {
+	Current    int      // 対象の期間の金額
+	Comparison int      // 比較する期間の金額
+	Difference int      // 差額（Current - Comparison）
+	Percentage *float64 // 差額の比較する期間の金額に対する割合（%、小数第1位に丸める）。比較する期間の金額が 0 の場合は nil
+}
+
+// NewPriceComparison は2つの期間の金額から PriceComparison を作成する
+func NewPriceComparison(current, comparison int) PriceComparison {
+	c := PriceComparison{Current: current, Comparison: comparison, Difference: current - comparison}
+	if comparison != 0 {
+		p := math.Round(float64(c.Difference)/math.Abs(float64(comparison))*1000) / 10
+		c.Percentage = &p
+	}
+	return c
+}
+
+// CategoryAmount はカテゴリの期間の金額を表す
+type CategoryAmount struct {
+	CategoryID   int
+	CategoryName string
+	CategoryType CategoryType
+	Price        int
+}
+
+// CategoryComparison はカテゴリ別の2つの期間の金額の比較を表す
+type CategoryComparison struct {
+	CategoryID   int
+	CategoryName string
+	CategoryType CategoryType
+	PriceComparison
+}
+
+// CategoryTypeComparison はカテゴリの種類別の2つの期間の金額の比較を表す
+type CategoryTypeComparison struct {
+	CategoryType CategoryType
+	PriceComparison
+}
+
+// SummaryComparison は対象の期間と比較する期間のサマリーの比較を表す
+type SummaryComparison struct {
+	Current       string                    // 対象の期間（YYYYMM または会計年度）
+	Comparison    string                    // 比較する期間（YYYYMM または会計年度）
+	Categories    []*CategoryComparison     // カテゴリ ID の順。いずれの期間も金額が 0 のカテゴリは含めない
+	CategoryTypes []*CategoryTypeComparison // 種類の定義順。全ての種類を含める
+}
+
+// NewSummaryComparison は2つの期間のカテゴリ別の金額を、カテゴリ別・種類別に比較する
+// current, comparison は期間のラベル（YYYYMM または会計年度）とする
+func NewSummaryComparison(current, comparison string, currentAmounts, comparisonAmounts []CategoryAmount) *SummaryComparison {
+	type pair struct {
+		amount              CategoryAmount
+		current, comparison int
+	}
+	pairs := map[int]*pair{}
+	add := func(amounts []CategoryAmount, isCurrent bool) {
+		for _, a := range amounts {
+			p, ok := pairs[a.CategoryID]
+			if !ok {
+				p = &pair{amount: a}
+				pairs[a.CategoryID] = p
+			}
+			if isCurrent {
+				p.current += a.Price
+			} else {
+				p.comparison += a.Price
+			}
+		}
+	}
+	add(currentAmounts, true)
+	add(comparisonAmounts, false)
+
+	result := &SummaryComparison{Current: current, Comparison: comparison, Categories: []*CategoryComparison{}}
+	typeTotals := map[CategoryType][2]int{}
+	for _, p := range pairs {
+		totals := typeTotals[p.amount.CategoryType]
+		typeTotals[p.amount.CategoryType] = [2]int{totals[0] + p.current, totals[1] + p.comparison}
+		if p.current == 0 && p.comparison == 0 {
+			continue
+		}
+		result.Categories = append(result.Categories, &CategoryComparison{
+			CategoryID:      p.amount.CategoryID,
+			CategoryName:    p.amount.CategoryName,
+			CategoryType:    p.amount.CategoryType,
+			PriceComparison: NewPriceComparison(p.current, p.comparison),
+		})
+	}
+	sort.Slice(result.Categories, func(i, j int) bool {
+		return result.Categories[i].CategoryID < result.Categories[j].CategoryID
+	})
+
+	for _, t := range CategoryTypes() {
+		totals := typeTotals[t]
+		result.CategoryTypes = append(result.CategoryTypes, &CategoryTypeComparison{
+			CategoryType:    t,
+			PriceComparison: NewPriceComparison(totals[0], totals[1]),
+		})
+	}
+	return result
+}
@@ -0,0 +1,86 @@
+package domain
+
+import "testing"
+
+func TestNewPriceComparison(t *testing.T) {
+	tests := []struct {
+		name           string
+		current        int
+		comparison     int
+		wantDifference int
+		wantPercentage *float64
+	}{
+		{name: "正常系: 増加（小数第1位に丸める）", current: 52000, comparison: 48000, wantDifference: 4000, wantPercentage: floatPtr(8.3)},
+		{name: "正常系: 減少", current: 30000, comparison: 40000, wantDifference: -10000, wantPercentage: floatPtr(-25)},
+		{name: "正常系: 比較する期間の金額が 0 の場合は割合なし", current: 1000, comparison: 0, wantDifference: 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewPriceComparison(tt.current, tt.comparison)
+			if got.Current != tt.current || got.Comparison != tt.comparison || got.Difference != tt.wantDifference {
+				t.Errorf("NewPriceComparison() = %+v", got)
+			}
+			if (got.Percentage == nil) != (tt.wantPercentage == nil) || (got.Percentage != nil && *got.Percentage != *tt.wantPercentage) {
+				t.Errorf("Percentage = %v, want %v", got.Percentage, tt.wantPercentage)
+			}
+		})
+	}
+}
+
+func TestNewSummaryComparison(t *testing.T) {
+	current := []CategoryAmount{
+		{CategoryID: 210, CategoryName: "食費", CategoryType: CategoryTypeOutgoing, Price: 52000},
+		{CategoryID: 100, CategoryName: "月給", CategoryType: CategoryTypeIncome, Price: 300000},
+		{CategoryID: 220, CategoryName: "電気代", CategoryType: CategoryTypeOutgoing, Price: 0},
+	}
+	comparison := []CategoryAmount{
+		{CategoryID: 210, CategoryName: "食費", CategoryType: CategoryTypeOutgoing, Price: 48000},
+		{CategoryID: 230, CategoryName: "日用品", CategoryType: CategoryTypeOutgoing, Price: 2000},
+	}
+
+	got := NewSummaryComparison("202510", "202410", current, comparison)
+	if got.Current != "202510" || got.Comparison != "202410" {
+		t.Errorf("unexpected periods: %s, %s", got.Current, got.Comparison)
+	}
+
+	// カテゴリ ID の順で、いずれの期間も 0 のカテゴリ（220）は含めない
+	wantCategories := []struct{ id, current, comparison int }{{100, 300000, 0}, {210, 52000, 48000}, {230, 0, 2000}}
+	if len(got.Categories) != len(wantCategories) {
+		t.Fatalf("expected %d categories, got %d", len(wantCategories), len(got.Categories))
+	}
+	for i, want := range wantCategories {
+		c := got.Categories[i]
+		if c.CategoryID != want.id || c.Current != want.current || c.Comparison != want.comparison {
+			t.Errorf("Categories[%d] = %+v, want %+v", i, c, want)
+		}
+	}
+
+	// 全ての種類を定義順に含める
+	wantTypes := []struct {
+		categoryType        CategoryType
+		current, comparison int
+	}{
+		{CategoryTypeIncome, 300000, 0},
+		{CategoryTypeOutgoing, 52000, 50000},
+		{CategoryTypeSaving, 0, 0},
+		{CategoryTypeInvesting, 0, 0},
+	}
+	if len(got.CategoryTypes) != len(wantTypes) {
+		t.Fatalf("expected %d category types, got %d", len(wantTypes), len(got.CategoryTypes))
+	}
+	for i, want := range wantTypes {
+		c := got.CategoryTypes[i]
+		if c.CategoryType != want.categoryType || c.Current != want.current || c.Comparison != want.comparison {
+			t.Errorf("CategoryTypes[%d] = %+v, want %+v", i, c, want)
+		}
+	}
+	if p := got.CategoryTypes[1].Percentage; p == nil || *p != 4 {
+		t.Errorf("expected outgoing percentage 4, got %v", p)
+	}
+}
+
+// floatPtr は float64 のポインタを返す
+func floatPtr(v float64) *float64 {
+	return &v
+}
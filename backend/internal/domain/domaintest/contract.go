@@ -0,0 +1,705 @@
+// Package domaintest はドメイン層のインターフェースの実装が満たすべき振る舞いを検証するテストを提供する
+// 各実装のテストから呼び出し、全ての実装が同じ振る舞いをすることを保証する
+package domaintest
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/azuki774/mawinter/internal/domain"
+)
+
+// RepositoryFactory はテストごとに新しいリポジトリを生成する
+// 生成されるリポジトリは、初期カテゴリ（マイグレーションで登録される23件）のみを持ち、レコード・確定済みの月を持たない
+type RepositoryFactory func(t *testing.T) Repositories
+
+// Repositories は検証するリポジトリと、ドメインのインターフェースにない操作でテストの状態を用意する関数
+type Repositories struct {
+	Categories domain.CategoryRepository
+	Records    domain.RecordRepository
+	// LockMonth は月（YYYYMM）を確定済みにする
+	LockMonth func(t *testing.T, yyyymm string)
+	// RemoveCategory はレコードを残したままカテゴリを削除する
+	RemoveCategory func(t *testing.T, categoryID int)
+}
+
+// RunRepositoryContract はカテゴリリポジトリとレコードリポジトリの共通の振る舞いを検証する
+func RunRepositoryContract(t *testing.T, factory RepositoryFactory) {
+	t.Run("Category/FindAll", func(t *testing.T) {
+		testCategoryFindAll(t, factory(t).Categories)
+	})
+
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repo domain.RecordRepository)
+	}{
+		{name: "Record/CreateAndFindByID", fn: testCreateAndFindByID},
+		{name: "Record/Create_UnknownCategory", fn: testCreateUnknownCategory},
+		{name: "Record/Create_DefaultDatetime", fn: testCreateDefaultDatetime},
+		{name: "Record/FindAllAndCount", fn: testFindAllAndCount},
+		{name: "Record/Update", fn: testUpdate},
+		{name: "Record/Trash", fn: testTrash},
+		{name: "Record/Purge", fn: testPurge},
+		{name: "Record/History", fn: testHistory},
+		{name: "Record/GetAvailablePeriods", fn: testGetAvailablePeriods},
+		{name: "Record/GetYearSummary", fn: testGetYearSummary},
+		{name: "Record/GetYearSummaryAsOf", fn: testGetYearSummaryAsOf},
+		{name: "Record/GetPeriodSummary", fn: testGetPeriodSummary},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, factory(t).Records)
+		})
+	}
+
+	t.Run("Record/MonthLock", func(t *testing.T) {
+		testMonthLock(t, factory(t))
+	})
+	t.Run("Record/FindByID_MissingCategory", func(t *testing.T) {
+		testFindByIDMissingCategory(t, factory(t))
+	})
+}
+
+// local はローカルタイムゾーンの日時を返す
+func local(year int, month time.Month, day, hour, min, sec int) time.Time {
+	return time.Date(year, month, day, hour, min, sec, 0, time.Local)
+}
+
+// mustCreate はレコードを作成し、失敗した場合はテストを終了する
+func mustCreate(t *testing.T, repo domain.RecordRepository, categoryID int, datetime time.Time, price int, memo string) *domain.Record {
+	t.Helper()
+
+	record, err := repo.Create(context.Background(), &domain.Record{
+		CategoryID: categoryID,
+		Datetime:   datetime,
+		From:       "contract",
+		Type:       "",
+		Price:      price,
+		Memo:       memo,
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	return record
+}
+
+// ids はレコードのIDの一覧を返す
+func ids(records []*domain.Record) []int {
+	result := make([]int, len(records))
+	for i, r := range records {
+		result[i] = r.ID
+	}
+	return result
+}
+
+// equalInts は2つのスライスが等しいかを返す
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// equalStrings は2つのスライスが等しいかを返す
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func testCategoryFindAll(t *testing.T, repo domain.CategoryRepository) {
+	categories, err := repo.FindAll(context.Background())
+	if err != nil {
+		t.Fatalf("FindAll() error = %v", err)
+	}
+	if len(categories) != 23 {
+		t.Fatalf("expected 23 categories, got %d", len(categories))
+	}
+	for i := 1; i < len(categories); i++ {
+		if categories[i-1].CategoryID >= categories[i].CategoryID {
+			t.Errorf("categories are not sorted by category_id: %d before %d", categories[i-1].CategoryID, categories[i].CategoryID)
+		}
+	}
+	for _, c := range categories {
+		if c.CategoryID == 210 && (c.Name != "食費" || c.CategoryType != domain.CategoryTypeOutgoing) {
+			t.Errorf("unexpected category 210: %+v", c)
+		}
+	}
+}
+
+func testCreateAndFindByID(t *testing.T, repo domain.RecordRepository) {
+	ctx := context.Background()
+	datetime := local(2024, time.May, 10, 12, 30, 0)
+
+	first := mustCreate(t, repo, 210, datetime, 1280, "コンビニ")
+	second := mustCreate(t, repo, 100, datetime, 300000, "給料")
+
+	if first.ID <= 0 || second.ID <= first.ID {
+		t.Errorf("expected increasing positive IDs, got %d and %d", first.ID, second.ID)
+	}
+	if first.CategoryName != "食費" {
+		t.Errorf("expected category name 食費, got %s", first.CategoryName)
+	}
+
+	found, err := repo.FindByID(ctx, first.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.CategoryID != 210 || found.CategoryName != "食費" || found.Price != 1280 || found.Memo != "コンビニ" || found.From != "contract" {
+		t.Errorf("unexpected record: %+v", found)
+	}
+	if !found.Datetime.Equal(datetime) {
+		t.Errorf("expected datetime %v, got %v", datetime, found.Datetime)
+	}
+	if found.DeletedAt != nil {
+		t.Errorf("expected no deleted_at, got %v", found.DeletedAt)
+	}
+
+	if _, err := repo.FindByID(ctx, second.ID+1000); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for unknown id, got %v", err)
+	}
+}
+
+func testCreateUnknownCategory(t *testing.T, repo domain.RecordRepository) {
+	_, err := repo.Create(context.Background(), &domain.Record{
+		CategoryID: 999,
+		Datetime:   local(2024, time.May, 10, 0, 0, 0),
+		Price:      100,
+	})
+	if err == nil {
+		t.Error("expected error for unknown category, got nil")
+	}
+}
+
+func testCreateDefaultDatetime(t *testing.T, repo domain.RecordRepository) {
+	ctx := context.Background()
+	// DB によっては秒未満を切り捨てるため、秒単位で比較する
+	before := time.Now().Truncate(time.Second)
+	created, err := repo.Create(ctx, &domain.Record{CategoryID: 210, Price: 100})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if created.Datetime.Before(before) || created.Datetime.After(time.Now()) {
+		t.Fatalf("expected the current datetime, got %v", created.Datetime)
+	}
+
+	// 挿入したレコード・履歴・戻り値は同じ日時を持つ
+	sameSecond := func(a, b time.Time) bool { return a.Truncate(time.Second).Equal(b.Truncate(time.Second)) }
+	found, err := repo.FindByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if !sameSecond(found.Datetime, created.Datetime) {
+		t.Errorf("expected stored datetime %v, got %v", created.Datetime, found.Datetime)
+	}
+	versions, err := repo.FindHistory(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("FindHistory() error = %v", err)
+	}
+	if len(versions) != 1 || !sameSecond(versions[0].Record.Datetime, created.Datetime) {
+		t.Errorf("expected history datetime %v, got %+v", created.Datetime, versions)
+	}
+}
+
+func testMonthLock(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	repo := repos.Records
+	locked := mustCreate(t, repo, 210, local(2024, time.May, 10, 0, 0, 0), 100, "確定済みの月")
+	trashed := mustCreate(t, repo, 210, local(2024, time.May, 11, 0, 0, 0), 200, "ゴミ箱")
+	if err := repo.Delete(ctx, trashed.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	unlocked := mustCreate(t, repo, 210, local(2024, time.June, 1, 0, 0, 0), 300, "未確定の月")
+	repos.LockMonth(t, "202405")
+
+	tests := []struct {
+		name string
+		fn   func() error
+	}{
+		{name: "作成", fn: func() error {
+			_, err := repo.Create(ctx, &domain.Record{CategoryID: 210, Datetime: local(2024, time.May, 20, 0, 0, 0), Price: 100})
+			return err
+		}},
+		{name: "更新", fn: func() error {
+			_, err := repo.Update(ctx, &domain.Record{ID: locked.ID, CategoryID: 210, Price: 999})
+			return err
+		}},
+		{name: "確定済みの月への移動", fn: func() error {
+			_, err := repo.Update(ctx, &domain.Record{ID: unlocked.ID, CategoryID: 210, Datetime: local(2024, time.May, 31, 0, 0, 0), Price: 300})
+			return err
+		}},
+		{name: "ゴミ箱への移動", fn: func() error { return repo.Delete(ctx, locked.ID) }},
+		{name: "復元", fn: func() error {
+			_, err := repo.Restore(ctx, trashed.ID)
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		if err := tt.fn(); !errors.Is(err, domain.ErrMonthLocked) {
+			t.Errorf("%s: expected ErrMonthLocked, got %v", tt.name, err)
+		}
+	}
+
+	// 確定済みの月のレコードは変更されず、他の月のレコードは変更できる
+	found, err := repo.FindByID(ctx, locked.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.Price != 100 {
+		t.Errorf("locked record must not be changed: %+v", found)
+	}
+	if _, err := repo.Update(ctx, &domain.Record{ID: unlocked.ID, CategoryID: 210, Price: 400}); err != nil {
+		t.Errorf("Update() in an unlocked month error = %v", err)
+	}
+	if n, err := repo.Count(ctx, "202405", 0); err != nil || n != 1 {
+		t.Errorf("expected 1 record in the locked month, got %d (%v)", n, err)
+	}
+}
+
+func testFindByIDMissingCategory(t *testing.T, repos Repositories) {
+	record := mustCreate(t, repos.Records, 230, local(2024, time.May, 10, 0, 0, 0), 100, "削除されたカテゴリ")
+	repos.RemoveCategory(t, 230)
+
+	// レコードは存在するため ErrNotFound ではなく、カテゴリが存在しないことのエラーを返す
+	if _, err := repos.Records.FindByID(context.Background(), record.ID); err == nil || errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected a missing category error, got %v", err)
+	}
+}
+
+func testFindAllAndCount(t *testing.T, repo domain.RecordRepository) {
+	ctx := context.Background()
+
+	march := mustCreate(t, repo, 210, local(2024, time.March, 31, 23, 59, 59), 100, "3月末")
+	april := mustCreate(t, repo, 210, local(2024, time.April, 1, 0, 0, 0), 200, "4月初")
+	aprilOther := mustCreate(t, repo, 220, local(2024, time.April, 30, 23, 59, 59), 300, "4月末")
+	may := mustCreate(t, repo, 210, local(2024, time.May, 1, 0, 0, 0), 400, "5月初")
+
+	tests := []struct {
+		name       string
+		num        int
+		offset     int
+		yyyymm     string
+		categoryID int
+		wantIDs    []int
+		wantCount  int
+	}{
+		{
+			name:      "全件をID降順で取得",
+			num:       20,
+			wantIDs:   []int{may.ID, aprilOther.ID, april.ID, march.ID},
+			wantCount: 4,
+		},
+		{
+			name:      "ページネーション",
+			num:       2,
+			offset:    1,
+			wantIDs:   []int{aprilOther.ID, april.ID},
+			wantCount: 4,
+		},
+		{
+			name:      "月の境界",
+			num:       20,
+			yyyymm:    "202404",
+			wantIDs:   []int{aprilOther.ID, april.ID},
+			wantCount: 2,
+		},
+		{
+			name:       "月とカテゴリ",
+			num:        20,
+			yyyymm:     "202404",
+			categoryID: 210,
+			wantIDs:    []int{april.ID},
+			wantCount:  1,
+		},
+		{
+			name:      "該当なし",
+			num:       20,
+			yyyymm:    "202312",
+			wantIDs:   []int{},
+			wantCount: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, err := repo.FindAll(ctx, tt.num, tt.offset, tt.yyyymm, tt.categoryID)
+			if err != nil {
+				t.Fatalf("FindAll() error = %v", err)
+			}
+			if got := ids(records); !equalInts(got, tt.wantIDs) {
+				t.Errorf("FindAll() ids = %v, want %v", got, tt.wantIDs)
+			}
+
+			count, err := repo.Count(ctx, tt.yyyymm, tt.categoryID)
+			if err != nil {
+				t.Fatalf("Count() error = %v", err)
+			}
+			if count != tt.wantCount {
+				t.Errorf("Count() = %d, want %d", count, tt.wantCount)
+			}
+		})
+	}
+
+	if _, err := repo.FindAll(ctx, 20, 0, "2024", 0); !errors.Is(err, domain.ErrValidation) {
+		t.Errorf("expected ErrValidation for invalid yyyymm, got %v", err)
+	}
+	if _, err := repo.Count(ctx, "2024", 0); !errors.Is(err, domain.ErrValidation) {
+		t.Errorf("expected ErrValidation for invalid yyyymm, got %v", err)
+	}
+}
+
+func testUpdate(t *testing.T, repo domain.RecordRepository) {
+	ctx := context.Background()
+	datetime := local(2024, time.May, 10, 12, 0, 0)
+	created := mustCreate(t, repo, 210, datetime, 1000, "before")
+
+	// 日時がゼロ値の場合は変更しない
+	updated, err := repo.Update(ctx, &domain.Record{
+		ID:         created.ID,
+		CategoryID: 220,
+		From:       "updated",
+		Type:       "D",
+		Price:      2000,
+		Memo:       "after",
+		Tags:       []string{"旅行", "出張"},
+	})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if updated.CategoryID != 220 || updated.CategoryName != "電気代" || updated.Price != 2000 || updated.Memo != "after" || updated.Type != "D" || updated.From != "updated" {
+		t.Errorf("unexpected updated record: %+v", updated)
+	}
+	if len(created.Tags) != 0 || strings.Join(updated.Tags, ",") != "旅行,出張" {
+		t.Errorf("unexpected tags: created %#v, updated %#v", created.Tags, updated.Tags)
+	}
+	if !updated.Datetime.Equal(datetime) {
+		t.Errorf("expected datetime to be unchanged %v, got %v", datetime, updated.Datetime)
+	}
+
+	found, err := repo.FindByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.Price != 2000 || found.CategoryID != 220 || strings.Join(found.Tags, ",") != "旅行,出張" {
+		t.Errorf("update was not persisted: %+v", found)
+	}
+
+	if _, err := repo.Update(ctx, &domain.Record{ID: created.ID + 1000, CategoryID: 210}); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for unknown id, got %v", err)
+	}
+}
+
+func testTrash(t *testing.T, repo domain.RecordRepository) {
+	ctx := context.Background()
+	kept := mustCreate(t, repo, 210, local(2024, time.May, 1, 0, 0, 0), 100, "kept")
+	trashed := mustCreate(t, repo, 210, local(2024, time.May, 2, 0, 0, 0), 200, "trashed")
+
+	if err := repo.Delete(ctx, trashed.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := repo.Delete(ctx, trashed.ID); !errors.Is(err, domain.ErrConflict) {
+		t.Errorf("expected ErrConflict when deleting a trashed record, got %v", err)
+	}
+	if err := repo.Delete(ctx, trashed.ID+1000); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected ErrNotFound when deleting an unknown record, got %v", err)
+	}
+
+	if _, err := repo.FindByID(ctx, trashed.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected trashed record to be hidden from FindByID, got %v", err)
+	}
+	records, err := repo.FindAll(ctx, 20, 0, "", 0)
+	if err != nil {
+		t.Fatalf("FindAll() error = %v", err)
+	}
+	if got := ids(records); !equalInts(got, []int{kept.ID}) {
+		t.Errorf("FindAll() ids = %v, want %v", got, []int{kept.ID})
+	}
+	if _, err := repo.Update(ctx, &domain.Record{ID: trashed.ID, CategoryID: 210}); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected ErrNotFound when updating a trashed record, got %v", err)
+	}
+
+	deleted, err := repo.FindDeleted(ctx, 20, 0)
+	if err != nil {
+		t.Fatalf("FindDeleted() error = %v", err)
+	}
+	if len(deleted) != 1 || deleted[0].ID != trashed.ID || deleted[0].DeletedAt == nil || deleted[0].CategoryName != "食費" {
+		t.Fatalf("unexpected trash: %+v", deleted)
+	}
+
+	if _, err := repo.Restore(ctx, kept.ID); !errors.Is(err, domain.ErrConflict) {
+		t.Errorf("expected ErrConflict when restoring a record not in the trash, got %v", err)
+	}
+	restored, err := repo.Restore(ctx, trashed.ID)
+	if err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	if restored.ID != trashed.ID || restored.DeletedAt != nil {
+		t.Errorf("unexpected restored record: %+v", restored)
+	}
+
+	count, err := repo.Count(ctx, "", 0)
+	if err != nil {
+		t.Fatalf("Count() error = %v", err)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 records after restore, got %d", count)
+	}
+}
+
+func testPurge(t *testing.T, repo domain.RecordRepository) {
+	ctx := context.Background()
+	record := mustCreate(t, repo, 210, local(2024, time.May, 1, 0, 0, 0), 100, "purged")
+	if err := repo.Delete(ctx, record.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	// 削除日時より前を指定した場合は削除しない
+	n, err := repo.Purge(ctx, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("Purge() error = %v", err)
+	}
+	if n != 0 {
+		t.Errorf("expected 0 purged, got %d", n)
+	}
+
+	n, err = repo.Purge(ctx, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Purge() error = %v", err)
+	}
+	if n != 1 {
+		t.Errorf("expected 1 purged, got %d", n)
+	}
+
+	deleted, err := repo.FindDeleted(ctx, 20, 0)
+	if err != nil {
+		t.Fatalf("FindDeleted() error = %v", err)
+	}
+	if len(deleted) != 0 {
+		t.Errorf("expected empty trash, got %d records", len(deleted))
+	}
+	if _, err := repo.Restore(ctx, record.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected ErrNotFound when restoring a purged record, got %v", err)
+	}
+}
+
+func testHistory(t *testing.T, repo domain.RecordRepository) {
+	ctx := context.Background()
+	record := mustCreate(t, repo, 210, local(2024, time.May, 1, 0, 0, 0), 100, "v1")
+	if _, err := repo.Update(ctx, &domain.Record{ID: record.ID, CategoryID: 210, From: "contract", Price: 200, Memo: "v2"}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if err := repo.Delete(ctx, record.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := repo.Restore(ctx, record.ID); err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+
+	versions, err := repo.FindHistory(ctx, record.ID)
+	if err != nil {
+		t.Fatalf("FindHistory() error = %v", err)
+	}
+
+	wantOps := []domain.RecordOperation{
+		domain.RecordOperationCreate,
+		domain.RecordOperationUpdate,
+		domain.RecordOperationDelete,
+		domain.RecordOperationRestore,
+	}
+	if len(versions) != len(wantOps) {
+		t.Fatalf("expected %d versions, got %d", len(wantOps), len(versions))
+	}
+	for i, v := range versions {
+		if v.Version != i+1 {
+			t.Errorf("versions[%d].Version = %d, want %d", i, v.Version, i+1)
+		}
+		if v.Operation != wantOps[i] {
+			t.Errorf("versions[%d].Operation = %s, want %s", i, v.Operation, wantOps[i])
+		}
+		if v.Record.ID != record.ID || v.Record.CategoryName != "食費" {
+			t.Errorf("versions[%d].Record = %+v", i, v.Record)
+		}
+	}
+	if versions[0].Record.Price != 100 || versions[1].Record.Price != 200 {
+		t.Errorf("unexpected prices in history: %d, %d", versions[0].Record.Price, versions[1].Record.Price)
+	}
+
+	if _, err := repo.FindHistory(ctx, record.ID+1000); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for unknown id, got %v", err)
+	}
+}
+
+func testGetAvailablePeriods(t *testing.T, repo domain.RecordRepository) {
+	ctx := context.Background()
+
+	yyyymm, fy, err := repo.GetAvailablePeriods(ctx)
+	if err != nil {
+		t.Fatalf("GetAvailablePeriods() error = %v", err)
+	}
+	if yyyymm == nil || fy == nil || len(yyyymm) != 0 || len(fy) != 0 {
+		t.Errorf("expected empty non-nil slices, got %v, %v", yyyymm, fy)
+	}
+
+	mustCreate(t, repo, 210, local(2023, time.May, 10, 0, 0, 0), 100, "")
+	mustCreate(t, repo, 210, local(2024, time.March, 31, 23, 59, 59), 100, "")
+	mustCreate(t, repo, 210, local(2024, time.April, 1, 0, 0, 0), 100, "")
+	mustCreate(t, repo, 210, local(2024, time.April, 15, 0, 0, 0), 100, "")
+	trashed := mustCreate(t, repo, 210, local(2025, time.January, 1, 0, 0, 0), 100, "")
+	if err := repo.Delete(ctx, trashed.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	yyyymm, fy, err = repo.GetAvailablePeriods(ctx)
+	if err != nil {
+		t.Fatalf("GetAvailablePeriods() error = %v", err)
+	}
+	if want := []string{"202404", "202403", "202305"}; !equalStrings(yyyymm, want) {
+		t.Errorf("yyyymm = %v, want %v", yyyymm, want)
+	}
+	if want := []string{"2024", "2023"}; !equalStrings(fy, want) {
+		t.Errorf("fy = %v, want %v", fy, want)
+	}
+}
+
+// summariesByCategory はサマリーをカテゴリID順に並べる
+func summariesByCategory(summaries []*domain.CategoryYearSummary) []*domain.CategoryYearSummary {
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].CategoryID < summaries[j].CategoryID
+	})
+	return summaries
+}
+
+func testGetYearSummary(t *testing.T, repo domain.RecordRepository) {
+	ctx := context.Background()
+
+	mustCreate(t, repo, 210, local(2024, time.March, 31, 23, 59, 59), 1, "前年度")
+	mustCreate(t, repo, 210, local(2024, time.April, 1, 0, 0, 0), 100, "")
+	mustCreate(t, repo, 210, local(2024, time.April, 20, 0, 0, 0), 200, "")
+	mustCreate(t, repo, 100, local(2025, time.March, 31, 23, 59, 59), 5000, "")
+	mustCreate(t, repo, 100, local(2025, time.April, 1, 0, 0, 0), 7, "翌年度")
+	trashed := mustCreate(t, repo, 210, local(2024, time.June, 1, 0, 0, 0), 999, "")
+	if err := repo.Delete(ctx, trashed.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	summaries, err := repo.GetYearSummary(ctx, 2024)
+	if err != nil {
+		t.Fatalf("GetYearSummary() error = %v", err)
+	}
+	summaries = summariesByCategory(summaries)
+	if len(summaries) != 2 {
+		t.Fatalf("expected 2 summaries, got %d", len(summaries))
+	}
+
+	salary, food := summaries[0], summaries[1]
+	if salary.CategoryID != 100 || salary.CategoryName != "月給" || salary.CategoryType != domain.CategoryTypeIncome {
+		t.Errorf("unexpected summary: %+v", salary)
+	}
+	if salary.Price[11] != 5000 || salary.Total != 5000 || salary.Count != 1 {
+		t.Errorf("unexpected summary for 100: %+v", salary)
+	}
+	if food.Price[0] != 300 || food.Total != 300 || food.Count != 2 {
+		t.Errorf("unexpected summary for 210: %+v", food)
+	}
+}
+
+func testGetYearSummaryAsOf(t *testing.T, repo domain.RecordRepository) {
+	ctx := context.Background()
+	before := time.Now().Add(-time.Hour)
+
+	record := mustCreate(t, repo, 210, local(2024, time.May, 1, 0, 0, 0), 100, "")
+	if _, err := repo.Update(ctx, &domain.Record{ID: record.ID, CategoryID: 210, From: "contract", Price: 300}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	trashed := mustCreate(t, repo, 220, local(2024, time.May, 1, 0, 0, 0), 50, "")
+	if err := repo.Delete(ctx, trashed.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	summaries, err := repo.GetYearSummaryAsOf(ctx, 2024, before)
+	if err != nil {
+		t.Fatalf("GetYearSummaryAsOf() error = %v", err)
+	}
+	if len(summaries) != 0 {
+		t.Errorf("expected no summaries before any change, got %d", len(summaries))
+	}
+
+	summaries, err = repo.GetYearSummaryAsOf(ctx, 2024, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("GetYearSummaryAsOf() error = %v", err)
+	}
+	if len(summaries) != 1 {
+		t.Fatalf("expected 1 summary, got %d", len(summaries))
+	}
+	if summaries[0].CategoryID != 210 || summaries[0].Price[1] != 300 || summaries[0].Count != 1 {
+		t.Errorf("unexpected summary: %+v", summaries[0])
+	}
+}
+
+func testGetPeriodSummary(t *testing.T, repo domain.RecordRepository) {
+	ctx := context.Background()
+
+	mustCreate(t, repo, 210, local(2024, time.December, 31, 23, 59, 59), 1, "前年")
+	mustCreate(t, repo, 210, local(2025, time.January, 1, 0, 0, 0), 100, "")
+	mustCreate(t, repo, 210, local(2025, time.March, 31, 23, 59, 59), 200, "")
+	mustCreate(t, repo, 210, local(2025, time.October, 18, 12, 0, 0), 400, "")
+	mustCreate(t, repo, 100, local(2025, time.December, 31, 23, 59, 59), 5000, "")
+	mustCreate(t, repo, 100, local(2026, time.January, 1, 0, 0, 0), 7, "翌年")
+	trashed := mustCreate(t, repo, 210, local(2025, time.June, 1, 0, 0, 0), 999, "")
+	if err := repo.Delete(ctx, trashed.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	period, err := domain.CalendarYearPeriod(2025, domain.SummaryBucketQuarter)
+	if err != nil {
+		t.Fatalf("CalendarYearPeriod() error = %v", err)
+	}
+	summaries, err := repo.GetPeriodSummary(ctx, period)
+	if err != nil {
+		t.Fatalf("GetPeriodSummary() error = %v", err)
+	}
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].CategoryID < summaries[j].CategoryID })
+	if len(summaries) != 2 {
+		t.Fatalf("expected 2 summaries, got %d", len(summaries))
+	}
+
+	salary, food := summaries[0], summaries[1]
+	if salary.CategoryID != 100 || salary.CategoryName != "月給" || salary.CategoryType != domain.CategoryTypeIncome {
+		t.Errorf("unexpected summary: %+v", salary)
+	}
+	if !equalInts(salary.Price, []int{0, 0, 0, 5000}) || salary.Total != 5000 || salary.Count != 1 {
+		t.Errorf("unexpected summary for 100: %+v", salary)
+	}
+	if !equalInts(food.Price, []int{300, 0, 0, 400}) || food.Total != 700 || food.Count != 3 {
+		t.Errorf("unexpected summary for 210: %+v", food)
+	}
+
+	// 週ごとに区切った任意の期間（2025-10-15 は水曜日）
+	period, err = domain.NewSummaryPeriod(local(2025, time.October, 15, 0, 0, 0), local(2025, time.October, 22, 0, 0, 0), domain.SummaryBucketWeek)
+	if err != nil {
+		t.Fatalf("NewSummaryPeriod() error = %v", err)
+	}
+	summaries, err = repo.GetPeriodSummary(ctx, period)
+	if err != nil {
+		t.Fatalf("GetPeriodSummary() error = %v", err)
+	}
+	if len(summaries) != 1 || !equalInts(summaries[0].Price, []int{400, 0}) || summaries[0].Count != 1 {
+		t.Errorf("unexpected weekly summaries: %+v", summaries)
+	}
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// エラーの種類を表すセンチネルエラー
+// 各エラー型は errors.Is でこれらと一致する
+var (
+	// ErrNotFound は対象が存在しないことを表す
+	ErrNotFound = errors.New("not found")
+	// ErrValidation は入力値が不正であることを表す
+	ErrValidation = errors.New("validation failed")
+	// ErrConflict は現在の状態では実行できない操作であることを表す
+	ErrConflict = errors.New("conflict")
+	// ErrMonthLocked は確定済みの月のレコードを変更しようとしたことを表す
+	ErrMonthLocked = errors.New("month is locked")
+)
+
+// NotFoundError は指定されたリソースが存在しないエラー
+type NotFoundError struct {
+	Resource string // リソースの種類（例: "record"）
+	ID       int
+}
+
+// NewNotFoundError は NotFoundError を生成する
+func NewNotFoundError(resource string, id int) *NotFoundError {
+	return &NotFoundError{Resource: resource, ID: id}
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s not found: %d", e.Resource, e.ID)
+}
+
+// Is は ErrNotFound と一致する
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// FieldError は項目ごとの検証エラー
+type FieldError struct {
+	Field   string // 項目名（リクエストの JSON のキー名）
+	Message string
+}
+
+// ValidationError は入力値の検証エラー
+// 違反を1つずつ返すのではなく、全ての違反をまとめて保持する
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// NewValidationError は1つの項目の違反を持つ ValidationError を生成する
+func NewValidationError(field, message string) *ValidationError {
+	return &ValidationError{Fields: []FieldError{{Field: field, Message: message}}}
+}
+
+// Add は項目の違反を追加する
+func (e *ValidationError) Add(field, message string) {
+	e.Fields = append(e.Fields, FieldError{Field: field, Message: message})
+}
+
+// Err は違反がある場合に自身を、ない場合に nil を返す
+func (e *ValidationError) Err() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Field + ": " + f.Message
+	}
+	return "validation failed: " + strings.Join(msgs, "; ")
+}
+
+// Is は ErrValidation と一致する
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// ConflictError はリソースの現在の状態と矛盾する操作のエラー
+// 例: ゴミ箱にあるレコードの削除、ゴミ箱にないレコードの復元
+type ConflictError struct {
+	Message string
+}
+
+// NewConflictError は ConflictError を生成する
+func NewConflictError(format string, args ...any) *ConflictError {
+	return &ConflictError{Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *ConflictError) Error() string {
+	return e.Message
+}
+
+// Is は ErrConflict と一致する
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// MonthLockedError は確定済み（Monthly_Confirm）の月のレコードを変更しようとしたエラー
+type MonthLockedError struct {
+	YYYYMM string
+}
+
+func (e *MonthLockedError) Error() string {
+	return fmt.Sprintf("month %s is locked", e.YYYYMM)
+}
+
+// Is は ErrMonthLocked と一致する
+func (e *MonthLockedError) Is(target error) bool {
+	return target == ErrMonthLocked
+}
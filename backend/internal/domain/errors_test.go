@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestErrors_Is(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		target error
+	}{
+		{name: "NotFoundError は ErrNotFound", err: NewNotFoundError("record", 1), target: ErrNotFound},
+		{name: "ValidationError は ErrValidation", err: NewValidationError("price", "must not be negative"), target: ErrValidation},
+		{name: "ConflictError は ErrConflict", err: NewConflictError("record %d is already in trash", 1), target: ErrConflict},
+		{name: "MonthLockedError は ErrMonthLocked", err: &MonthLockedError{YYYYMM: "202501"}, target: ErrMonthLocked},
+		{name: "ラップされていても一致する", err: fmt.Errorf("failed to delete: %w", NewNotFoundError("record", 1)), target: ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, tt.target) {
+				t.Errorf("errors.Is(%v, %v) = false", tt.err, tt.target)
+			}
+			for _, other := range []error{ErrNotFound, ErrValidation, ErrConflict, ErrMonthLocked} {
+				if other != tt.target && errors.Is(tt.err, other) {
+					t.Errorf("errors.Is(%v, %v) = true", tt.err, other)
+				}
+			}
+		})
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	verr := &ValidationError{}
+	if verr.Err() != nil {
+		t.Fatalf("expected nil error without violations, got %v", verr.Err())
+	}
+
+	verr.Add("price", "must not be negative")
+	verr.Add("memo", "must be at most 255 characters")
+	err := verr.Err()
+	if err == nil {
+		t.Fatal("expected error with violations")
+	}
+	if want := "validation failed: price: must not be negative; memo: must be at most 255 characters"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	var got *ValidationError
+	if !errors.As(fmt.Errorf("wrapped: %w", err), &got) || len(got.Fields) != 2 {
+		t.Errorf("expected ValidationError with 2 fields, got %+v", got)
+	}
+}
@@ -0,0 +1,13 @@
+package domain
+
+import "context"
+
+// HealthChecker は依存するコンポーネント（データベースなど）の状態を確認するインターフェース
+type HealthChecker interface {
+	// Name はコンポーネント名を返す
+	Name() string
+
+	// Check はコンポーネントの状態を確認し、利用できない場合はエラーを返す
+	// detail には状態の補足（スキーマのバージョンなど）を返す
+	Check(ctx context.Context) (detail string, err error)
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// SummaryBucket は期間のサマリーで金額を区切る単位
+type SummaryBucket string
+
+const (
+	// SummaryBucketDay は日ごとに区切る
+	SummaryBucketDay SummaryBucket = "day"
+	// SummaryBucketWeek は週（月曜始まり）ごとに区切る
+	SummaryBucketWeek SummaryBucket = "week"
+	// SummaryBucketMonth は月ごとに区切る
+	SummaryBucketMonth SummaryBucket = "month"
+	// SummaryBucketQuarter は四半期（1〜3月・4〜6月・7〜9月・10〜12月）ごとに区切る
+	SummaryBucketQuarter SummaryBucket = "quarter"
+)
+
+// MaxSummaryBuckets は期間のサマリーで区切る数の上限（日ごとで1年分）
+const MaxSummaryBuckets = 366
+
+// IsValid は区切る単位が定義済みの値かどうかを返す
+func (b SummaryBucket) IsValid() bool {
+	switch b {
+	case SummaryBucketDay, SummaryBucketWeek, SummaryBucketMonth, SummaryBucketQuarter:
+		return true
+	}
+	return false
+}
+
+// start は日時を含む区切りの開始日時を返す
+func (b SummaryBucket) start(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	switch b {
+	case SummaryBucketWeek:
+		return day.AddDate(0, 0, -(int(t.Weekday())+6)%7)
+	case SummaryBucketMonth:
+		return day.AddDate(0, 0, 1-t.Day())
+	case SummaryBucketQuarter:
+		return time.Date(t.Year(), t.Month()-(t.Month()-1)%3, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return day
+	}
+}
+
+// next は区切りの開始日時から次の区切りの開始日時を返す
+func (b SummaryBucket) next(start time.Time) time.Time {
+	switch b {
+	case SummaryBucketWeek:
+		return start.AddDate(0, 0, 7)
+	case SummaryBucketMonth:
+		return start.AddDate(0, 1, 0)
+	case SummaryBucketQuarter:
+		return start.AddDate(0, 3, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// SummaryRange は [From, To) の期間を表す
+type SummaryRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// SummaryPeriod は期間のサマリーを集計する期間 [From, To) と、金額を区切る単位を表す
+type SummaryPeriod struct {
+	From   time.Time
+	To     time.Time
+	Bucket SummaryBucket
+}
+
+// NewSummaryPeriod は期間と区切る単位を検証し、SummaryPeriod を返す
+// 違反がある場合は、全ての違反をまとめた ValidationError を返す
+func NewSummaryPeriod(from, to time.Time, bucket SummaryBucket) (SummaryPeriod, error) {
+	verr := &ValidationError{}
+	if !bucket.IsValid() {
+		verr.Add("bucket", fmt.Sprintf("must be one of day, week, month, quarter: %q", bucket))
+	}
+	if !from.Before(to) {
+		verr.Add("to", "must be after from")
+	}
+	period := SummaryPeriod{From: from, To: to, Bucket: bucket}
+	if verr.Err() == nil {
+		if len(period.Buckets()) > MaxSummaryBuckets {
+			verr.Add("bucket", fmt.Sprintf("period must have at most %d buckets", MaxSummaryBuckets))
+		}
+	}
+	if err := verr.Err(); err != nil {
+		return SummaryPeriod{}, err
+	}
+	return period, nil
+}
+
+// CalendarYearPeriod は暦年（1月〜12月）の SummaryPeriod を返す
+func CalendarYearPeriod(year int, bucket SummaryBucket) (SummaryPeriod, error) {
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.Local)
+	return NewSummaryPeriod(from, from.AddDate(1, 0, 0), bucket)
+}
+
+// Buckets は期間を区切る単位で分けた期間を古い順に返す
+// 区切りは単位の境界（週は月曜、四半期は1・4・7・10月の1日）に揃え、最初と最後の区切りは期間に収まるように切り詰める
+// 区切る単位を検証していない場合は日ごとに分ける
+func (p SummaryPeriod) Buckets() []SummaryRange {
+	var buckets []SummaryRange
+	for start := p.From; start.Before(p.To); {
+		end := p.Bucket.next(p.Bucket.start(start))
+		if end.After(p.To) {
+			end = p.To
+		}
+		buckets = append(buckets, SummaryRange{From: start, To: end})
+		// 上限を大きく超える期間で全ての区切りを作らないようにする
+		if len(buckets) > MaxSummaryBuckets {
+			break
+		}
+		start = end
+	}
+	return buckets
+}
+
+// CategoryPeriodSummary はカテゴリ別の期間のサマリーを表す
+// CategoryYearSummary と同じ形で、金額を SummaryPeriod.Buckets の区切りごとに持つ
+type CategoryPeriodSummary struct {
+	CategoryID   int
+	CategoryName string
+	CategoryType CategoryType
+	Count        int   // 該当カテゴリの取引回数
+	Price        []int // 区切りごとの金額（SummaryPeriod.Buckets の順）
+	Total        int   // 合計金額
+}
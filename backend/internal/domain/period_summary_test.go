@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSummaryPeriod_Buckets(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+	}
+
+	tests := []struct {
+		name   string
+		period SummaryPeriod
+		want   []string // 区切りの初日
+	}{
+		{
+			name:   "正常系: 暦年を四半期で区切る",
+			period: SummaryPeriod{From: date(2025, 1, 1), To: date(2026, 1, 1), Bucket: SummaryBucketQuarter},
+			want:   []string{"20250101", "20250401", "20250701", "20251001"},
+		},
+		{
+			name:   "正常系: 月の途中からの期間は最初の区切りを切り詰める",
+			period: SummaryPeriod{From: date(2025, 2, 15), To: date(2025, 4, 10), Bucket: SummaryBucketMonth},
+			want:   []string{"20250215", "20250301", "20250401"},
+		},
+		{
+			name: "正常系: 週は月曜始まり",
+			// 2025-10-15 は水曜日
+			period: SummaryPeriod{From: date(2025, 10, 15), To: date(2025, 10, 28), Bucket: SummaryBucketWeek},
+			want:   []string{"20251015", "20251020", "20251027"},
+		},
+		{
+			name:   "正常系: 日ごと",
+			period: SummaryPeriod{From: date(2024, 2, 28), To: date(2024, 3, 2), Bucket: SummaryBucketDay},
+			want:   []string{"20240228", "20240229", "20240301"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buckets := tt.period.Buckets()
+			var got []string
+			for i, b := range buckets {
+				got = append(got, b.From.Format("20060102"))
+				// 区切りは隙間なく続き、期間に収まる
+				if i > 0 && !b.From.Equal(buckets[i-1].To) {
+					t.Errorf("bucket %d does not follow the previous one: %+v", i, buckets)
+				}
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Buckets() = %v, want %v", got, tt.want)
+			}
+			if !buckets[len(buckets)-1].To.Equal(tt.period.To) {
+				t.Errorf("last bucket must end at %v, got %v", tt.period.To, buckets[len(buckets)-1].To)
+			}
+		})
+	}
+}
+
+func TestNewSummaryPeriod(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name       string
+		to         time.Time
+		bucket     SummaryBucket
+		wantFields []string
+	}{
+		{name: "正常系: 1年を日ごと", to: from.AddDate(1, 0, 0), bucket: SummaryBucketDay},
+		{name: "正常系: 複数年を四半期ごと", to: from.AddDate(5, 0, 0), bucket: SummaryBucketQuarter},
+		{name: "異常系: 未定義の単位・終了が開始以前", to: from, bucket: "year", wantFields: []string{"bucket", "to"}},
+		{name: "異常系: 区切りの数が上限を超える", to: from.AddDate(1, 0, 2), bucket: SummaryBucketDay, wantFields: []string{"bucket"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSummaryPeriod(from, tt.to, tt.bucket)
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("expected ValidationError, got %v", err)
+			}
+			var fields []string
+			for _, f := range verr.Fields {
+				fields = append(fields, f.Field)
+			}
+			if strings.Join(fields, ",") != strings.Join(tt.wantFields, ",") {
+				t.Errorf("expected field errors %v, got %v", tt.wantFields, fields)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// QuickEntryTokenKind は短い文の語をどの項目として解釈したかを表す
+type QuickEntryTokenKind string
+
+const (
+	QuickEntryCategory QuickEntryTokenKind = "category"
+	QuickEntryPrice    QuickEntryTokenKind = "price"
+	QuickEntryDate     QuickEntryTokenKind = "date"
+	QuickEntryMemo     QuickEntryTokenKind = "memo"
+)
+
+// QuickEntryToken は短い文の語と、その解釈
+type QuickEntryToken struct {
+	Text string
+	Kind QuickEntryTokenKind
+}
+
+// QuickEntry は「食費 1280 コンビニ 昨日」のような短い文を解釈した結果
+type QuickEntry struct {
+	Record   *Record // 日付を含まない場合、Datetime はゼロ値（作成時の日時）
+	Category *Category
+	Tokens   []QuickEntryToken
+	Rules    []string // 適用した自動分類の規則の名前
+}
+
+// weekdays は曜日の表記（日曜始まり。time.Weekday と同じ順）
+var weekdays = []string{"日", "月", "火", "水", "木", "金", "土"}
+
+// ParseQuickEntry は空白区切りの短い文をレコードとして解釈する
+// 各語は先頭から順に、次のように解釈する
+//   - 日付: 今日・昨日・一昨日、今週/先週の曜日（月曜始まり）、曜日のみ（直近のその曜日）、M/D・YYYY/M/D・YYYY-M-D
+//   - カテゴリ: カテゴリ名または別名（aliases）。数値のみの語は、金額になる数値が他にある場合にカテゴリ ID として扱う
+//   - 金額: 数値（1,280・1280円・¥1280 の表記や全角数字を含む）
+//   - メモ: 上記以外の語を空白区切りでつなげたもの
+//
+// rules が nil でない場合は規則で未設定の項目を埋め、カテゴリを含まない文は規則が設定するカテゴリとする
+// カテゴリまたは金額を解釈できない場合は ValidationError を返す
+func ParseQuickEntry(text string, categories []*Category, aliases map[string]int, rules *RuleSet, now time.Time) (*QuickEntry, error) {
+	byName := map[string]*Category{}
+	byID := map[int]*Category{}
+	for _, c := range categories {
+		byName[normalizeWord(c.Name)] = c
+		byID[c.CategoryID] = c
+	}
+	// 別名は存在するカテゴリを指すもののみ使う。カテゴリ名と重複する場合はカテゴリ名を優先する
+	for alias, id := range aliases {
+		key := normalizeWord(alias)
+		if _, ok := byName[key]; !ok && byID[id] != nil {
+			byName[key] = byID[id]
+		}
+	}
+
+	words := strings.Fields(text)
+	// 数値のみの語をカテゴリ ID とするかの判定に、金額として解釈できる語の数を使う
+	prices := 0
+	for _, w := range words {
+		if _, ok := parsePrice(w); ok {
+			prices++
+		}
+	}
+
+	entry := &QuickEntry{Record: &Record{}}
+	var memo []string
+	hasPrice, hasDate := false, false
+	for _, w := range words {
+		kind := QuickEntryMemo
+		if d, ok := parseRelativeDate(w, now); ok && !hasDate {
+			entry.Record.Datetime, hasDate, kind = d, true, QuickEntryDate
+		} else if c, ok := byName[normalizeWord(w)]; ok && entry.Category == nil {
+			entry.Category, kind = c, QuickEntryCategory
+		} else if price, ok := parsePrice(w); ok {
+			id, isID := parseCategoryID(w)
+			switch {
+			case entry.Category == nil && isID && byID[id] != nil && prices > 1:
+				entry.Category, kind = byID[id], QuickEntryCategory
+				prices--
+			case !hasPrice:
+				entry.Record.Price, hasPrice, kind = price, true, QuickEntryPrice
+			}
+		}
+		if kind == QuickEntryMemo {
+			memo = append(memo, w)
+		}
+		entry.Tokens = append(entry.Tokens, QuickEntryToken{Text: w, Kind: kind})
+	}
+	entry.Record.Memo = strings.Join(memo, " ")
+
+	if rules != nil {
+		// 日付を含まない場合は作成時の日時（現在）として曜日の条件を判定する
+		r := *entry.Record
+		if r.Datetime.IsZero() {
+			r.Datetime = now
+		}
+		if entry.Category != nil {
+			r.CategoryID = entry.Category.CategoryID
+		}
+		entry.Rules = rules.Fill(&r)
+		entry.Record.Type, entry.Record.Tags = r.Type, r.Tags
+		if entry.Category == nil {
+			entry.Category = byID[r.CategoryID]
+		}
+	}
+
+	verr := &ValidationError{}
+	if entry.Category == nil {
+		verr.Add("text", "no category found; use a category name, alias or ID, or add a rule")
+	} else {
+		entry.Record.CategoryID = entry.Category.CategoryID
+		entry.Record.CategoryName = entry.Category.Name
+	}
+	if !hasPrice {
+		verr.Add("text", "no price found")
+	}
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// normalizeWord は全角英数字を半角に、半角カナを全角に、英字を小文字にそろえる
+func normalizeWord(s string) string {
+	return strings.ToLower(norm.NFKC.String(s))
+}
+
+// parseCategoryID は数値のみの語をカテゴリ ID として解釈する
+func parseCategoryID(s string) (int, bool) {
+	id, err := strconv.Atoi(normalizeWord(s))
+	return id, err == nil && id > 0
+}
+
+// parsePrice は語を金額として解釈する
+// 桁区切りのカンマ、先頭の ¥、末尾の 円 を許可する
+func parsePrice(s string) (int, bool) {
+	s = normalizeWord(s)
+	s = strings.TrimPrefix(s, "¥")
+	s = strings.TrimSuffix(s, "円")
+	s = strings.ReplaceAll(s, ",", "")
+	if s == "" || strings.ContainsAny(s, "+-") {
+		return 0, false
+	}
+	price, err := strconv.Atoi(s)
+	return price, err == nil
+}
+
+// parseRelativeDate は語を now を基準とした日付として解釈する
+// 結果は now のタイムゾーンの 0 時とする
+func parseRelativeDate(s string, now time.Time) (time.Time, bool) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	s = normalizeWord(s)
+
+	switch s {
+	case "今日", "きょう":
+		return today, true
+	case "昨日", "きのう":
+		return today.AddDate(0, 0, -1), true
+	case "一昨日", "おととい":
+		return today.AddDate(0, 0, -2), true
+	}
+
+	// 今週・先週の曜日（月曜始まり）、曜日のみ（今日を含む直近のその曜日）
+	weeks, rest := -1, s // 何週前か（-1: 週の指定なし）
+	if r, ok := strings.CutPrefix(s, "今週"); ok {
+		weeks, rest = 0, r
+	} else if r, ok := strings.CutPrefix(s, "先週"); ok {
+		weeks, rest = 1, r
+	}
+	if name, ok := strings.CutSuffix(strings.TrimSuffix(rest, "日"), "曜"); ok {
+		for i, n := range weekdays {
+			if name != n {
+				continue
+			}
+			if weeks < 0 {
+				return today.AddDate(0, 0, -((int(today.Weekday()) - i + 7) % 7)), true
+			}
+			// 月曜日からの日数で数える
+			monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+			return monday.AddDate(0, 0, (i+6)%7-7*weeks), true
+		}
+	}
+
+	// M/D・YYYY/M/D・YYYY-M-D
+	sep := "/"
+	if strings.Contains(s, "-") {
+		sep = "-"
+	}
+	parts := strings.Split(s, sep)
+	nums := make([]int, len(parts))
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return time.Time{}, false
+		}
+		nums[i] = n
+	}
+	var year, month, day int
+	switch {
+	case len(nums) == 2 && sep == "/":
+		year, month, day = today.Year(), nums[0], nums[1]
+		// 年を省略した日付が未来になる場合は前年とする（年始に前年末の日付を入力する場合）
+		// 前年に存在しない日付（閏年の年始に入力した 2/29 など）は、下で日付として扱わない
+		if month > int(today.Month()) || (month == int(today.Month()) && day > today.Day()) {
+			year--
+		}
+	case len(nums) == 3 && nums[0] >= 1000:
+		year, month, day = nums[0], nums[1], nums[2]
+	default:
+		return time.Time{}, false
+	}
+	d := time.Date(year, time.Month(month), day, 0, 0, 0, 0, now.Location())
+	// 存在しない日付（2/30 など）は日付として扱わない
+	if d.Month() != time.Month(month) || d.Day() != day {
+		return time.Time{}, false
+	}
+	return d, true
+}
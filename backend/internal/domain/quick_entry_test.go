@@ -0,0 +1,199 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseQuickEntry(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	// 2025-10-19 は日曜日
+	now := time.Date(2025, 10, 19, 21, 30, 0, 0, jst)
+	date := func(month time.Month, day int) time.Time { return time.Date(2025, month, day, 0, 0, 0, 0, jst) }
+	categories := []*Category{
+		{ID: 1, CategoryID: 100, Name: "月給", CategoryType: CategoryTypeIncome},
+		{ID: 2, CategoryID: 210, Name: "食費", CategoryType: CategoryTypeOutgoing},
+		{ID: 3, CategoryID: 230, Name: "Amazon", CategoryType: CategoryTypeOutgoing},
+	}
+	aliases := map[string]int{"ランチ": 210, "ごはん": 999}
+	rules, err := NewRuleSet([]Rule{
+		{Name: "amazon", Match: RuleMatch{MemoContains: "アマゾン"}, Set: RuleAction{CategoryID: 230, Tags: []string{"通販"}}},
+		{Name: "存在しないカテゴリ", Match: RuleMatch{MemoContains: "不明"}, Set: RuleAction{CategoryID: 999}},
+	})
+	if err != nil {
+		t.Fatalf("NewRuleSet() error = %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		text         string
+		wantCategory int
+		wantPrice    int
+		wantDatetime time.Time
+		wantMemo     string
+		wantKinds    []QuickEntryTokenKind
+		wantRules    int
+		wantErrors   int       // 0 の場合は解釈できる
+		now          time.Time // ゼロ値の場合は 2025-10-19
+	}{
+		{
+			name:         "正常系: カテゴリ名・金額・メモ・相対日付",
+			text:         "食費 1280 コンビニ 昨日",
+			wantCategory: 210,
+			wantPrice:    1280,
+			wantDatetime: date(10, 18),
+			wantMemo:     "コンビニ",
+			wantKinds:    []QuickEntryTokenKind{QuickEntryCategory, QuickEntryPrice, QuickEntryMemo, QuickEntryDate},
+		},
+		{
+			name:         "正常系: カテゴリ ID と金額",
+			text:         "210 1280",
+			wantCategory: 210,
+			wantPrice:    1280,
+			wantKinds:    []QuickEntryTokenKind{QuickEntryCategory, QuickEntryPrice},
+		},
+		{
+			name:         "正常系: 金額の後のカテゴリ ID",
+			text:         "1280 210",
+			wantCategory: 210,
+			wantPrice:    1280,
+		},
+		{
+			name:         "正常系: 別名・金額の表記・全角の空白",
+			text:         "ランチ　¥1,280　定食",
+			wantCategory: 210,
+			wantPrice:    1280,
+			wantMemo:     "定食",
+		},
+		{
+			name:         "正常系: 全角数字・円・英字の大文字小文字を区別しない",
+			text:         "amazon １２８０円 本 先週金曜",
+			wantCategory: 230,
+			wantPrice:    1280,
+			wantDatetime: date(10, 10),
+			wantMemo:     "本",
+		},
+		{
+			name:         "正常系: 今週の曜日は月曜始まり",
+			text:         "食費 500 今週月曜日",
+			wantCategory: 210,
+			wantPrice:    500,
+			wantDatetime: date(10, 13),
+		},
+		{
+			name:         "正常系: 曜日のみの場合は今日を含む直近の曜日",
+			text:         "食費 500 水曜",
+			wantCategory: 210,
+			wantPrice:    500,
+			wantDatetime: date(10, 15),
+		},
+		{
+			name:         "正常系: 年を省略した日付が未来になる場合は前年",
+			text:         "食費 500 12/31",
+			wantCategory: 210,
+			wantPrice:    500,
+			wantDatetime: time.Date(2024, 12, 31, 0, 0, 0, 0, jst),
+		},
+		{
+			name:         "正常系: 前年に存在しない日付はメモ",
+			text:         "食費 500 2/29",
+			now:          time.Date(2028, 1, 10, 12, 0, 0, 0, jst),
+			wantCategory: 210,
+			wantPrice:    500,
+			wantMemo:     "2/29",
+		},
+		{
+			name:         "正常系: 年月日の日付、2つ目以降の数値と日付はメモ",
+			text:         "食費 500 2025-10-01 3個 300 今日",
+			wantCategory: 210,
+			wantPrice:    500,
+			wantDatetime: date(10, 1),
+			wantMemo:     "3個 300 今日",
+		},
+		{
+			name:         "正常系: 数値が1つのみの場合はカテゴリ ID ではなく金額",
+			text:         "月給 100",
+			wantCategory: 100,
+			wantPrice:    100,
+		},
+		{
+			name:         "正常系: カテゴリを含まない場合は規則のカテゴリ",
+			text:         "アマゾン 1280",
+			wantCategory: 230,
+			wantPrice:    1280,
+			wantMemo:     "アマゾン",
+			wantRules:    1,
+		},
+		{
+			name:         "正常系: カテゴリを含む場合も規則のタグを付ける",
+			text:         "食費 1280 アマゾン",
+			wantCategory: 210,
+			wantPrice:    1280,
+			wantMemo:     "アマゾン",
+			wantRules:    1,
+		},
+		{
+			name:       "異常系: 存在しないカテゴリを設定する規則は使わない",
+			text:       "不明 1280",
+			wantErrors: 1,
+		},
+		{
+			name:       "異常系: 存在しないカテゴリを指す別名は使わない",
+			text:       "ごはん 1280",
+			wantErrors: 1,
+		},
+		{
+			name:       "異常系: カテゴリも金額もない",
+			text:       "コンビニ 2/30",
+			wantErrors: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := now
+			if !tt.now.IsZero() {
+				now = tt.now
+			}
+			entry, err := ParseQuickEntry(tt.text, categories, aliases, rules, now)
+			if tt.wantErrors > 0 {
+				var verr *ValidationError
+				if !errors.As(err, &verr) || len(verr.Fields) != tt.wantErrors {
+					t.Fatalf("expected %d field errors, got %v", tt.wantErrors, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			r := entry.Record
+			if r.CategoryID != tt.wantCategory || entry.Category.CategoryID != tt.wantCategory {
+				t.Errorf("expected category %d, got %d", tt.wantCategory, r.CategoryID)
+			}
+			if r.Price != tt.wantPrice {
+				t.Errorf("expected price %d, got %d", tt.wantPrice, r.Price)
+			}
+			if !r.Datetime.Equal(tt.wantDatetime) {
+				t.Errorf("expected datetime %v, got %v", tt.wantDatetime, r.Datetime)
+			}
+			if r.Memo != tt.wantMemo {
+				t.Errorf("expected memo %q, got %q", tt.wantMemo, r.Memo)
+			}
+			if len(entry.Rules) != tt.wantRules {
+				t.Errorf("expected %d rules, got %v", tt.wantRules, entry.Rules)
+			}
+			if tt.wantRules > 0 && len(entry.Record.Tags) == 0 {
+				t.Errorf("expected tags from rules, got %v", entry.Record.Tags)
+			}
+			if tt.wantKinds != nil {
+				for i, tok := range entry.Tokens {
+					if tok.Kind != tt.wantKinds[i] {
+						t.Errorf("token %q: expected %s, got %s", tok.Text, tt.wantKinds[i], tok.Kind)
+					}
+				}
+			}
+		})
+	}
+}
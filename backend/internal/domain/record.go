@@ -12,6 +12,30 @@ type Record struct {
 	Type         string
 	Price        int
 	Memo         string
+	Tags         []string   // 自由に付けられる分類（重複なし・付けた順）
+	DeletedAt    *time.Time // ゴミ箱に移動された日時（未削除の場合は nil）
+}
+
+// RecordOperation はレコード履歴に記録される操作の種類を表す
+type RecordOperation string
+
+const (
+	// RecordOperationCreate は作成を表す
+	RecordOperationCreate RecordOperation = "create"
+	// RecordOperationUpdate は更新を表す
+	RecordOperationUpdate RecordOperation = "update"
+	// RecordOperationDelete はゴミ箱への移動を表す
+	RecordOperationDelete RecordOperation = "delete"
+	// RecordOperationRestore はゴミ箱からの復元を表す
+	RecordOperationRestore RecordOperation = "restore"
+)
+
+// RecordVersion はある時点でのレコードの内容（履歴の1版）を表す
+type RecordVersion struct {
+	Version    int             // 1から始まる版番号
+	Operation  RecordOperation // この版を作成した操作
+	Record     Record          // この版でのレコードの内容
+	RecordedAt time.Time       // この版が記録された日時
 }
 
 // CategoryYearSummary はカテゴリ別年次サマリーを表す
@@ -19,7 +43,21 @@ type CategoryYearSummary struct {
 	CategoryID   int
 	CategoryName string
 	CategoryType CategoryType
-	Count        int      // 該当カテゴリの取引回数
-	Price        [12]int  // 12ヶ月分の金額（4月〜3月の順）
-	Total        int      // 合計金額
+	Count        int     // 該当カテゴリの取引回数
+	Price        [12]int // 12ヶ月分の金額（4月〜3月の順）
+	Total        int     // 合計金額
+}
+
+// FiscalYear は日時が属する会計年度（4月始まり）を返す
+// 例: 2025年3月 → 2024, 2025年4月 → 2025
+func FiscalYear(t time.Time) int {
+	if t.Month() < time.April {
+		return t.Year() - 1
+	}
+	return t.Year()
+}
+
+// FiscalMonthIndex は日時の会計年度内での月インデックス（4月=0 〜 3月=11）を返す
+func FiscalMonthIndex(t time.Time) int {
+	return (int(t.Month()) + 8) % 12
 }
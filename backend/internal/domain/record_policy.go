@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// レコードの文字列の項目の最大文字数（Record テーブルの varchar の長さ）
+const (
+	MaxRecordFromLength = 64
+	MaxRecordTypeLength = 64
+	MaxRecordMemoLength = 255
+)
+
+// レコードのタグの上限
+// タグはカンマ区切りで Record テーブルの tags（varchar(255)）に保存するため、合計がこれに収まるようにする
+const (
+	MaxRecordTags      = 10
+	MaxRecordTagLength = 20
+)
+
+// RecordPolicy はレコードの検証のうち、運用に応じて変更できる規則
+// ゼロ値は最も厳しい規則（未来の日付・金額 0 を許可しない）
+type RecordPolicy struct {
+	AllowFutureDate bool // 翌日以降の日付を許可する（予定の支出の登録など）
+	AllowZeroPrice  bool // 金額 0 を許可する
+}
+
+// Validate はレコードの内容を検証し、全ての違反をまとめた ValidationError を返す
+// categories は登録されているカテゴリ、now は未来の日付の判定に使う現在日時
+// 日時がゼロ値の場合（更新で日時を変更しない場合）は日時を検証しない
+func (p RecordPolicy) Validate(record *Record, categories []*Category, now time.Time) error {
+	verr := &ValidationError{}
+
+	switch {
+	case record.CategoryID == 0:
+		// 作成時に省略され、自動分類の規則にも一致しなかった場合
+		verr.Add("category_id", "is required")
+	case !hasCategory(categories, record.CategoryID):
+		verr.Add("category_id", fmt.Sprintf("category does not exist: %d", record.CategoryID))
+	}
+
+	switch {
+	case record.Price < 0:
+		verr.Add("price", "must not be negative")
+	case record.Price == 0 && !p.AllowZeroPrice:
+		verr.Add("price", "must not be zero")
+	}
+
+	// 日付のみの指定（YYYYMMDD）はタイムゾーンによって当日の時刻が現在より後になるため、翌日以降を未来とする
+	tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
+	if !record.Datetime.IsZero() && !record.Datetime.Before(tomorrow) && !p.AllowFutureDate {
+		verr.Add("datetime", "must not be in the future")
+	}
+
+	checkLength(verr, "from", record.From, MaxRecordFromLength)
+	checkLength(verr, "type", record.Type, MaxRecordTypeLength)
+	checkLength(verr, "memo", record.Memo, MaxRecordMemoLength)
+
+	if len(record.Tags) > MaxRecordTags {
+		verr.Add("tags", fmt.Sprintf("must have at most %d tags, got %d", MaxRecordTags, len(record.Tags)))
+	}
+	for i, tag := range record.Tags {
+		field := fmt.Sprintf("tags[%d]", i)
+		switch {
+		case strings.TrimSpace(tag) == "":
+			verr.Add(field, "must not be empty")
+		case strings.Contains(tag, ","):
+			verr.Add(field, "must not contain commas")
+		default:
+			checkLength(verr, field, tag, MaxRecordTagLength)
+		}
+	}
+
+	return verr.Err()
+}
+
+// NormalizeTags はタグの前後の空白を取り除き、空のタグと重複を除いたものを返す
+// 順序は最初に現れた順を保つ
+func NormalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
+// hasCategory は categories に categoryID のカテゴリが含まれるかを返す
+func hasCategory(categories []*Category, categoryID int) bool {
+	for _, c := range categories {
+		if c.CategoryID == categoryID {
+			return true
+		}
+	}
+	return false
+}
+
+// checkLength は文字数（バイト数ではない）が max を超える場合に違反を追加する
+func checkLength(verr *ValidationError, field, value string, max int) {
+	if n := utf8.RuneCountInString(value); n > max {
+		verr.Add(field, fmt.Sprintf("must be at most %d characters, got %d", max, n))
+	}
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRecordPolicy_Validate(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	now := time.Date(2025, 10, 19, 8, 0, 0, 0, jst)
+	categories := []*Category{
+		{ID: 1, CategoryID: 100, Name: "月給", CategoryType: CategoryTypeIncome},
+		{ID: 2, CategoryID: 210, Name: "食費", CategoryType: CategoryTypeOutgoing},
+	}
+	valid := func() *Record {
+		return &Record{CategoryID: 210, Datetime: now, Price: 1280, From: "discord", Memo: "コンビニ"}
+	}
+
+	tests := []struct {
+		name       string
+		policy     RecordPolicy
+		modify     func(r *Record)
+		wantFields []string
+	}{
+		{
+			name:   "正常系: 規則を満たすレコード",
+			modify: func(r *Record) {},
+		},
+		{
+			name: "正常系: 当日の日付のみの指定は未来としない",
+			// 2025-10-19T00:00:00Z は JST の 09:00 で現在より後
+			modify: func(r *Record) { r.Datetime = time.Date(2025, 10, 19, 0, 0, 0, 0, time.UTC) },
+		},
+		{
+			name:   "正常系: 日時がゼロ値の場合は検証しない",
+			modify: func(r *Record) { r.Datetime = time.Time{} },
+		},
+		{
+			name:   "正常系: 文字数はバイト数ではなく文字数で数える",
+			modify: func(r *Record) { r.Memo = strings.Repeat("あ", MaxRecordMemoLength) },
+		},
+		{
+			name:       "異常系: 存在しないカテゴリ",
+			modify:     func(r *Record) { r.CategoryID = 999 },
+			wantFields: []string{"category_id"},
+		},
+		{
+			name:       "異常系: 負の金額",
+			policy:     RecordPolicy{AllowZeroPrice: true},
+			modify:     func(r *Record) { r.Price = -1 },
+			wantFields: []string{"price"},
+		},
+		{
+			name:       "異常系: 金額 0 は許可しない",
+			modify:     func(r *Record) { r.Price = 0 },
+			wantFields: []string{"price"},
+		},
+		{
+			name:   "正常系: 金額 0 を許可する",
+			policy: RecordPolicy{AllowZeroPrice: true},
+			modify: func(r *Record) { r.Price = 0 },
+		},
+		{
+			name:       "異常系: 翌日の日付",
+			modify:     func(r *Record) { r.Datetime = time.Date(2025, 10, 20, 0, 0, 0, 0, jst) },
+			wantFields: []string{"datetime"},
+		},
+		{
+			name:   "正常系: 未来の日付を許可する",
+			policy: RecordPolicy{AllowFutureDate: true},
+			modify: func(r *Record) { r.Datetime = time.Date(2026, 1, 1, 0, 0, 0, 0, jst) },
+		},
+		{
+			name:   "正常系: タグ",
+			modify: func(r *Record) { r.Tags = []string{"旅行", strings.Repeat("あ", MaxRecordTagLength)} },
+		},
+		{
+			name: "異常系: タグの数・空のタグ・カンマを含むタグ・長すぎるタグ",
+			modify: func(r *Record) {
+				r.Tags = []string{" ", "a,b", strings.Repeat("あ", MaxRecordTagLength+1), "4", "5", "6", "7", "8", "9", "10", "11"}
+			},
+			wantFields: []string{"tags", "tags[0]", "tags[1]", "tags[2]"},
+		},
+		{
+			name: "異常系: 全ての違反をまとめて返す",
+			modify: func(r *Record) {
+				r.CategoryID = 999
+				r.Price = 0
+				r.Datetime = now.AddDate(0, 1, 0)
+				r.From = strings.Repeat("a", MaxRecordFromLength+1)
+				r.Type = strings.Repeat("a", MaxRecordTypeLength+1)
+				r.Memo = strings.Repeat("あ", MaxRecordMemoLength+1)
+			},
+			wantFields: []string{"category_id", "price", "datetime", "from", "type", "memo"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := valid()
+			tt.modify(record)
+
+			err := tt.policy.Validate(record, categories, now)
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("expected ValidationError, got %v", err)
+			}
+			var fields []string
+			for _, f := range verr.Fields {
+				fields = append(fields, f.Field)
+			}
+			if strings.Join(fields, ",") != strings.Join(tt.wantFields, ",") {
+				t.Errorf("expected field errors %v, got %v", tt.wantFields, fields)
+			}
+		})
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	got := NormalizeTags([]string{" 旅行 ", "", "出張", "旅行", "  "})
+	if strings.Join(got, ",") != "旅行,出張" {
+		t.Errorf("NormalizeTags() = %v", got)
+	}
+	if got := NormalizeTags(nil); got == nil || len(got) != 0 {
+		t.Errorf("expected empty non-nil slice, got %#v", got)
+	}
+}
@@ -1,12 +1,19 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // RecordRepository はレコードリポジトリのインターフェース
 type RecordRepository interface {
 	// Create は新しいレコードを作成する
 	Create(ctx context.Context, record *Record) (*Record, error)
 
+	// Update は既存のレコードを更新し、新しい版を履歴に記録する
+	// record.Datetime がゼロ値の場合は日時を変更しない
+	Update(ctx context.Context, record *Record) (*Record, error)
+
 	// FindByID は指定されたIDのレコードを取得する
 	FindByID(ctx context.Context, id int) (*Record, error)
 
@@ -21,14 +28,36 @@ type RecordRepository interface {
 	// yyyymm, categoryID が指定された場合はそれらでフィルタした件数を返す
 	Count(ctx context.Context, yyyymm string, categoryID int) (int, error)
 
-	// Delete は指定されたIDのレコードを削除する
+	// Delete は指定されたIDのレコードをゴミ箱に移動する（論理削除）
+	// 既にゴミ箱にある、または存在しない場合はエラーを返す
 	Delete(ctx context.Context, id int) error
 
+	// FindDeleted はゴミ箱内のレコードを削除日時の新しい順に取得する
+	FindDeleted(ctx context.Context, num, offset int) ([]*Record, error)
+
+	// Restore はゴミ箱内のレコードを元に戻す
+	// ゴミ箱に存在しない場合はエラーを返す
+	Restore(ctx context.Context, id int) (*Record, error)
+
+	// Purge は before より前にゴミ箱へ移動されたレコードを物理削除し、削除件数を返す
+	Purge(ctx context.Context, before time.Time) (int, error)
+
 	// GetAvailablePeriods はDBに登録されているレコードのYYYYMMとFY(年度)の一覧を取得する
 	// 返される配列はいずれも新しい順にソートされている
 	GetAvailablePeriods(ctx context.Context) (yyyymm []string, fy []string, err error)
 
+	// FindHistory は指定されたIDのレコードの履歴を版の古い順に取得する
+	// 履歴が存在しない場合はエラーを返す
+	FindHistory(ctx context.Context, id int) ([]*RecordVersion, error)
+
 	// GetYearSummary は指定された会計年度のカテゴリ別サマリーを取得する
 	// year: 会計年度（例: 2024 → 2024年4月〜2025年3月）
 	GetYearSummary(ctx context.Context, year int) ([]*CategoryYearSummary, error)
+
+	// GetPeriodSummary は指定された期間のカテゴリ別サマリーを、区切る単位ごとの金額とともに取得する
+	// 各サマリーの Price は period.Buckets() と同じ長さ・順序とする
+	GetPeriodSummary(ctx context.Context, period SummaryPeriod) ([]*CategoryPeriodSummary, error)
+
+	// GetYearSummaryAsOf は asOf 時点の履歴から、指定された会計年度のカテゴリ別サマリーを計算する
+	GetYearSummaryAsOf(ctx context.Context, year int, asOf time.Time) ([]*CategoryYearSummary, error)
 }
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFiscalYear(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want int
+	}{
+		{
+			name: "4月は当年度",
+			t:    time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+			want: 2025,
+		},
+		{
+			name: "12月は当年度",
+			t:    time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC),
+			want: 2025,
+		},
+		{
+			name: "1月は前年度",
+			t:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: 2025,
+		},
+		{
+			name: "3月は前年度",
+			t:    time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC),
+			want: 2025,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FiscalYear(tt.t); got != tt.want {
+				t.Errorf("FiscalYear() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFiscalMonthIndex(t *testing.T) {
+	tests := []struct {
+		name  string
+		month time.Month
+		want  int
+	}{
+		{name: "4月", month: time.April, want: 0},
+		{name: "12月", month: time.December, want: 8},
+		{name: "1月", month: time.January, want: 9},
+		{name: "3月", month: time.March, want: 11},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FiscalMonthIndex(time.Date(2025, tt.month, 15, 0, 0, 0, 0, time.UTC))
+			if got != tt.want {
+				t.Errorf("FiscalMonthIndex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
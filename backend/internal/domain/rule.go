@@ -0,0 +1,248 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Rule はメモ・登録元・金額・曜日からレコードを自動で分類する規則
+type Rule struct {
+	Name     string
+	Priority int // 大きいほど優先する。同じ場合は定義順
+	Match    RuleMatch
+	Set      RuleAction
+}
+
+// RuleMatch は規則を適用するレコードの条件
+// 指定した条件を全て満たす場合に一致する。ゼロ値の条件は判定しない
+type RuleMatch struct {
+	MemoContains string         // メモに含む文字列（英字の大文字小文字・全角半角を区別しない）
+	MemoRegex    string         // メモに一致する正規表現
+	From         string         // 登録元（完全一致）
+	MinPrice     *int           // 金額の下限（以上）
+	MaxPrice     *int           // 金額の上限（以下）
+	Weekdays     []time.Weekday // 日時の曜日のいずれか
+}
+
+// RuleAction は規則に一致したレコードに設定する値
+type RuleAction struct {
+	CategoryID int      // 0 の場合は設定しない
+	Type       string   // 空の場合は設定しない
+	Tags       []string // 追加するタグ
+}
+
+// RuleResult はレコードに一致した規則をまとめた結果
+// カテゴリと種別は、それを設定する規則のうち最も優先度の高いものの値とする
+// タグは一致した全ての規則のタグを優先度の順に合わせたものとする
+type RuleResult struct {
+	Rules      []string // 一致した規則の名前（優先度の順）
+	CategoryID int
+	Type       string
+	Tags       []string
+}
+
+// RuleChange は規則を既存のレコードに適用した場合の変更
+type RuleChange struct {
+	Before  *Record
+	After   *Record
+	Rules   []string // 一致した規則の名前（優先度の順）
+	Skipped string   // 適用できなかった理由（検証の違反、確定済みの月など）。適用できた場合は空
+}
+
+// RuleSet は検証済みの規則を優先度の順に並べたもの
+type RuleSet struct {
+	rules []compiledRule
+}
+
+// compiledRule は正規表現などを判定用に変換した規則
+type compiledRule struct {
+	Rule
+	contains string
+	regex    *regexp.Regexp
+}
+
+// weekdayNames は曜日の英語の略称（time.Weekday と同じ順）
+var weekdayNames = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// ParseWeekday は曜日の英語の略称（mon, tue など。大文字小文字を区別しない）を time.Weekday に変換する
+func ParseWeekday(s string) (time.Weekday, bool) {
+	i := slices.Index(weekdayNames, strings.ToLower(s))
+	return time.Weekday(i), i >= 0
+}
+
+// WeekdayName は曜日の英語の略称を返す
+func WeekdayName(d time.Weekday) string {
+	return weekdayNames[d]
+}
+
+// NewRuleSet は規則を検証し、優先度の順に並べた RuleSet を返す
+// 違反がある場合は、全ての違反を rules[i].match.memo_regex のような項目名でまとめた ValidationError を返す
+func NewRuleSet(rules []Rule) (*RuleSet, error) {
+	verr := &ValidationError{}
+	set := &RuleSet{rules: make([]compiledRule, 0, len(rules))}
+	names := map[string]bool{}
+	for i, rule := range rules {
+		field := func(name string) string { return fmt.Sprintf("rules[%d].%s", i, name) }
+		m, a := rule.Match, rule.Set
+
+		switch {
+		case strings.TrimSpace(rule.Name) == "":
+			verr.Add(field("name"), "is required")
+		case names[rule.Name]:
+			verr.Add(field("name"), fmt.Sprintf("duplicate rule name %q", rule.Name))
+		}
+		names[rule.Name] = true
+
+		compiled := compiledRule{Rule: rule, contains: normalizeWord(m.MemoContains)}
+		if m.MemoRegex != "" {
+			re, err := regexp.Compile(m.MemoRegex)
+			if err != nil {
+				verr.Add(field("match.memo_regex"), fmt.Sprintf("invalid regular expression: %v", err))
+			}
+			compiled.regex = re
+		}
+		if m.MemoContains == "" && m.MemoRegex == "" && m.From == "" && m.MinPrice == nil && m.MaxPrice == nil && len(m.Weekdays) == 0 {
+			verr.Add(field("match"), "must have at least one condition")
+		}
+		if m.MinPrice != nil && m.MaxPrice != nil && *m.MinPrice > *m.MaxPrice {
+			verr.Add(field("match.max_price"), "must be greater than or equal to min_price")
+		}
+		for _, d := range m.Weekdays {
+			if d < time.Sunday || d > time.Saturday {
+				verr.Add(field("match.weekdays"), fmt.Sprintf("invalid weekday: %d", d))
+			}
+		}
+
+		if a.CategoryID == 0 && a.Type == "" && len(a.Tags) == 0 {
+			verr.Add(field("set"), "must set at least one of category_id, type or tags")
+		}
+		if a.CategoryID < 0 {
+			verr.Add(field("set.category_id"), "must be positive")
+		}
+		checkLength(verr, field("set.type"), a.Type, MaxRecordTypeLength)
+		for j, tag := range a.Tags {
+			if tag == "" || strings.Contains(tag, ",") || utf8.RuneCountInString(tag) > MaxRecordTagLength {
+				verr.Add(field(fmt.Sprintf("set.tags[%d]", j)), fmt.Sprintf("must be 1 to %d characters without commas", MaxRecordTagLength))
+			}
+		}
+
+		set.rules = append(set.rules, compiled)
+	}
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	// 優先度が同じ場合は定義順を保つ
+	sort.SliceStable(set.rules, func(i, j int) bool {
+		return set.rules[i].Priority > set.rules[j].Priority
+	})
+	return set, nil
+}
+
+// Rules は規則を優先度の順に返す
+func (s *RuleSet) Rules() []Rule {
+	rules := make([]Rule, len(s.rules))
+	for i, r := range s.rules {
+		rules[i] = r.Rule
+	}
+	return rules
+}
+
+// Len は規則の数を返す
+func (s *RuleSet) Len() int {
+	return len(s.rules)
+}
+
+// Evaluate はレコードに一致する規則をまとめた結果を返す
+// 一致する規則がない場合は Rules が空の結果を返す
+func (s *RuleSet) Evaluate(record *Record) RuleResult {
+	var result RuleResult
+	var tags []string
+	for _, r := range s.rules {
+		if !r.matches(record) {
+			continue
+		}
+		result.Rules = append(result.Rules, r.Name)
+		if result.CategoryID == 0 {
+			result.CategoryID = r.Set.CategoryID
+		}
+		if result.Type == "" {
+			result.Type = r.Set.Type
+		}
+		tags = append(tags, r.Set.Tags...)
+	}
+	result.Tags = NormalizeTags(tags)
+	return result
+}
+
+// Fill はレコードの未設定の項目（カテゴリ ID が 0、種別が空）を一致した規則の値で埋め、タグを追加する
+// 新しく登録するレコードに使う。レコードを直接変更し、一致した規則の名前を返す
+func (s *RuleSet) Fill(record *Record) []string {
+	result := s.Evaluate(record)
+	if record.CategoryID == 0 {
+		record.CategoryID = result.CategoryID
+	}
+	if record.Type == "" {
+		record.Type = result.Type
+	}
+	if len(result.Tags) > 0 {
+		record.Tags = NormalizeTags(append(slices.Clone(record.Tags), result.Tags...))
+	}
+	return result.Rules
+}
+
+// Reclassify は既存のレコードに規則を適用した結果を返す
+// 規則が設定するカテゴリと種別で上書きし、タグを追加する。レコードは変更しない
+// 一致する規則がないか、適用しても内容が変わらない場合は nil を返す
+func (s *RuleSet) Reclassify(record *Record) *RuleChange {
+	result := s.Evaluate(record)
+	if len(result.Rules) == 0 {
+		return nil
+	}
+
+	after := *record
+	if result.CategoryID != 0 {
+		after.CategoryID = result.CategoryID
+	}
+	if result.Type != "" {
+		after.Type = result.Type
+	}
+	after.Tags = NormalizeTags(append(slices.Clone(record.Tags), result.Tags...))
+	if after.CategoryID == record.CategoryID && after.Type == record.Type && slices.Equal(after.Tags, record.Tags) {
+		return nil
+	}
+	if after.CategoryID != record.CategoryID {
+		after.CategoryName = ""
+	}
+	before := *record
+	return &RuleChange{Before: &before, After: &after, Rules: result.Rules}
+}
+
+// matches はレコードが規則の条件を全て満たすかを返す
+func (r *compiledRule) matches(record *Record) bool {
+	m := r.Match
+	if r.contains != "" && !strings.Contains(normalizeWord(record.Memo), r.contains) {
+		return false
+	}
+	if r.regex != nil && !r.regex.MatchString(record.Memo) {
+		return false
+	}
+	if m.From != "" && record.From != m.From {
+		return false
+	}
+	if m.MinPrice != nil && record.Price < *m.MinPrice {
+		return false
+	}
+	if m.MaxPrice != nil && record.Price > *m.MaxPrice {
+		return false
+	}
+	if len(m.Weekdays) > 0 && !slices.Contains(m.Weekdays, record.Datetime.Weekday()) {
+		return false
+	}
+	return true
+}
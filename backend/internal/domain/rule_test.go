@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewRuleSet(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name       string
+		rules      []Rule
+		wantFields []string
+	}{
+		{
+			name: "正常系: 全ての条件と設定",
+			rules: []Rule{{
+				Name:  "コンビニ",
+				Match: RuleMatch{MemoContains: "セブン", MemoRegex: "^セブン", From: "bank", MinPrice: intPtr(1), MaxPrice: intPtr(1000), Weekdays: []time.Weekday{time.Monday}},
+				Set:   RuleAction{CategoryID: 210, Type: "card", Tags: []string{"コンビニ"}},
+			}},
+		},
+		{
+			name:       "異常系: 名前・条件・設定がない",
+			rules:      []Rule{{}},
+			wantFields: []string{"rules[0].name", "rules[0].match", "rules[0].set"},
+		},
+		{
+			name: "異常系: 名前の重複・不正な正規表現・金額の範囲・タグ",
+			rules: []Rule{
+				{Name: "a", Match: RuleMatch{From: "bank"}, Set: RuleAction{CategoryID: 210}},
+				{
+					Name:  "a",
+					Match: RuleMatch{MemoRegex: "(", MinPrice: intPtr(100), MaxPrice: intPtr(10)},
+					Set:   RuleAction{Tags: []string{"a,b"}},
+				},
+			},
+			wantFields: []string{"rules[1].name", "rules[1].match.memo_regex", "rules[1].match.max_price", "rules[1].set.tags[0]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRuleSet(tt.rules)
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("expected ValidationError, got %v", err)
+			}
+			var fields []string
+			for _, f := range verr.Fields {
+				fields = append(fields, f.Field)
+			}
+			if strings.Join(fields, ",") != strings.Join(tt.wantFields, ",") {
+				t.Errorf("expected field errors %v, got %v", tt.wantFields, fields)
+			}
+		})
+	}
+}
+
+func TestRuleSet_Evaluate(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	// 2025-10-18 は土曜日
+	saturday := time.Date(2025, 10, 18, 12, 0, 0, 0, time.UTC)
+	set, err := NewRuleSet([]Rule{
+		{Name: "コンビニ", Match: RuleMatch{MemoContains: "ｾﾌﾞﾝ"}, Set: RuleAction{CategoryID: 210, Tags: []string{"コンビニ"}}},
+		{Name: "週末の外食", Priority: 10, Match: RuleMatch{MemoRegex: "ランチ|ディナー", Weekdays: []time.Weekday{time.Saturday, time.Sunday}}, Set: RuleAction{CategoryID: 220, Tags: []string{"外食"}}},
+		{Name: "カード", Match: RuleMatch{From: "card", MinPrice: intPtr(1000)}, Set: RuleAction{Type: "credit", Tags: []string{"カード"}}},
+	})
+	if err != nil {
+		t.Fatalf("NewRuleSet() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		record Record
+		want   RuleResult
+	}{
+		{
+			name:   "正常系: 英字の大文字小文字・全角半角を区別せずメモに含む文字列",
+			record: Record{Memo: "セブンイレブン", Datetime: saturday, Price: 500},
+			want:   RuleResult{Rules: []string{"コンビニ"}, CategoryID: 210, Tags: []string{"コンビニ"}},
+		},
+		{
+			name:   "正常系: 優先度の高い規則のカテゴリを使い、タグは全ての規則のもの",
+			record: Record{Memo: "セブンでランチ", From: "card", Datetime: saturday, Price: 1200},
+			want:   RuleResult{Rules: []string{"週末の外食", "コンビニ", "カード"}, CategoryID: 220, Type: "credit", Tags: []string{"外食", "コンビニ", "カード"}},
+		},
+		{
+			name:   "正常系: 曜日・金額の条件を満たさない",
+			record: Record{Memo: "ランチ", From: "card", Datetime: saturday.AddDate(0, 0, 2), Price: 999},
+			want:   RuleResult{Tags: []string{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := set.Evaluate(&tt.record)
+			if strings.Join(got.Rules, ",") != strings.Join(tt.want.Rules, ",") ||
+				got.CategoryID != tt.want.CategoryID || got.Type != tt.want.Type ||
+				strings.Join(got.Tags, ",") != strings.Join(tt.want.Tags, ",") {
+				t.Errorf("Evaluate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuleSet_FillAndReclassify(t *testing.T) {
+	set, err := NewRuleSet([]Rule{
+		{Name: "電気", Match: RuleMatch{MemoContains: "電気"}, Set: RuleAction{CategoryID: 220, Type: "auto", Tags: []string{"光熱費"}}},
+	})
+	if err != nil {
+		t.Fatalf("NewRuleSet() error = %v", err)
+	}
+
+	// 登録時は未設定の項目のみ埋める
+	record := &Record{CategoryID: 210, Memo: "電気代", Tags: []string{"家"}}
+	if rules := set.Fill(record); len(rules) != 1 {
+		t.Errorf("expected 1 matched rule, got %v", rules)
+	}
+	if record.CategoryID != 210 || record.Type != "auto" || strings.Join(record.Tags, ",") != "家,光熱費" {
+		t.Errorf("unexpected filled record: %+v", record)
+	}
+
+	// 既存のレコードはカテゴリを上書きする
+	existing := &Record{ID: 1, CategoryID: 210, CategoryName: "食費", Memo: "電気代"}
+	change := set.Reclassify(existing)
+	if change == nil {
+		t.Fatal("expected change")
+	}
+	if change.After.CategoryID != 220 || change.After.CategoryName != "" || change.Before.CategoryID != 210 || existing.CategoryID != 210 {
+		t.Errorf("unexpected change: before %+v, after %+v", change.Before, change.After)
+	}
+
+	// 適用済みのレコードは変更しない
+	if change := set.Reclassify(change.After); change != nil {
+		t.Errorf("expected no change, got %+v", change.After)
+	}
+	if change := set.Reclassify(&Record{Memo: "ガス代"}); change != nil {
+		t.Errorf("expected no change for unmatched record, got %+v", change.After)
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	if d, ok := ParseWeekday("Mon"); !ok || d != time.Monday || WeekdayName(d) != "mon" {
+		t.Errorf("ParseWeekday(Mon) = %v, %v", d, ok)
+	}
+	if _, ok := ParseWeekday("月"); ok {
+		t.Error("expected error for unknown weekday")
+	}
+}
@@ -0,0 +1,183 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CategorySuggestion はメモなどから推定したカテゴリの候補
+type CategorySuggestion struct {
+	CategoryID   int
+	CategoryName string       // SuggestionModel は設定しない
+	CategoryType CategoryType // SuggestionModel は設定しない
+	Confidence   float64      // 0〜1。全てのカテゴリの候補の合計が 1 になる
+}
+
+// SuggestionModel は過去のレコードのメモ・登録元・金額からカテゴリを推定するモデル（多項ナイーブベイズ）
+// レコードごとに学習した内容を保持し、レコードの追加・変更・削除を差分で反映できる
+// 並行して使う場合は呼び出し元で排他すること
+type SuggestionModel struct {
+	records map[int]suggestionEntry // レコード ID → 学習した内容
+	docs    map[int]int             // カテゴリ ID → レコード数
+	counts  map[int]map[string]int  // カテゴリ ID → 特徴 → 出現数
+	totals  map[int]int             // カテゴリ ID → 特徴の出現数の合計
+	vocab   map[string]int          // 特徴 → 出現数（全カテゴリ）
+}
+
+// suggestionEntry はレコードから学習した内容
+type suggestionEntry struct {
+	categoryID int
+	features   []string
+}
+
+// NewSuggestionModel は何も学習していない SuggestionModel を返す
+func NewSuggestionModel() *SuggestionModel {
+	return &SuggestionModel{
+		records: map[int]suggestionEntry{},
+		docs:    map[int]int{},
+		counts:  map[int]map[string]int{},
+		totals:  map[int]int{},
+		vocab:   map[string]int{},
+	}
+}
+
+// Len は学習したレコードの数を返す
+func (m *SuggestionModel) Len() int {
+	return len(m.records)
+}
+
+// Observe はレコードを学習する。学習済みのレコードは新しい内容で置き換える
+// ゴミ箱内のレコードは学習から除く
+func (m *SuggestionModel) Observe(record *Record) {
+	m.Forget(record.ID)
+	if record.DeletedAt != nil || record.CategoryID == 0 {
+		return
+	}
+
+	entry := suggestionEntry{categoryID: record.CategoryID, features: suggestionFeatures(record)}
+	m.records[record.ID] = entry
+	m.docs[entry.categoryID]++
+	counts := m.counts[entry.categoryID]
+	if counts == nil {
+		counts = map[string]int{}
+		m.counts[entry.categoryID] = counts
+	}
+	for _, f := range entry.features {
+		counts[f]++
+		m.vocab[f]++
+	}
+	m.totals[entry.categoryID] += len(entry.features)
+}
+
+// Forget は学習したレコードを除く。学習していない場合は何もしない
+func (m *SuggestionModel) Forget(id int) {
+	entry, ok := m.records[id]
+	if !ok {
+		return
+	}
+	delete(m.records, id)
+
+	c := entry.categoryID
+	if m.docs[c]--; m.docs[c] == 0 {
+		delete(m.docs, c)
+	}
+	for _, f := range entry.features {
+		if m.counts[c][f]--; m.counts[c][f] == 0 {
+			delete(m.counts[c], f)
+		}
+		if m.vocab[f]--; m.vocab[f] == 0 {
+			delete(m.vocab, f)
+		}
+	}
+	if m.totals[c] -= len(entry.features); m.totals[c] == 0 {
+		delete(m.totals, c)
+		delete(m.counts, c)
+	}
+}
+
+// Suggest はレコード（メモ・登録元・金額）のカテゴリの候補を確からしい順に最大 limit 件返す
+// 学習したレコードに現れない特徴のみの場合は、推定できないため空のスライスを返す
+func (m *SuggestionModel) Suggest(record *Record, limit int) []CategorySuggestion {
+	suggestions := make([]CategorySuggestion, 0)
+
+	// 学習したレコードに現れない特徴は、全てのカテゴリで同じ確率になるため使わない
+	var features []string
+	for _, f := range suggestionFeatures(record) {
+		if m.vocab[f] > 0 {
+			features = append(features, f)
+		}
+	}
+	if len(features) == 0 || limit <= 0 {
+		return suggestions
+	}
+
+	// 対数尤度（ラプラス平滑化）を計算し、softmax で合計 1 の確率にする
+	vocab := float64(len(m.vocab))
+	docs := float64(len(m.records))
+	best := math.Inf(-1)
+	scores := make(map[int]float64, len(m.docs))
+	for c, n := range m.docs {
+		score := math.Log(float64(n) / docs)
+		total := float64(m.totals[c])
+		for _, f := range features {
+			score += math.Log((float64(m.counts[c][f]) + 1) / (total + vocab))
+		}
+		scores[c] = score
+		best = max(best, score)
+	}
+	sum := 0.0
+	for c, score := range scores {
+		scores[c] = math.Exp(score - best)
+		sum += scores[c]
+	}
+	for c, score := range scores {
+		suggestions = append(suggestions, CategorySuggestion{CategoryID: c, Confidence: score / sum})
+	}
+
+	// 確からしさが同じ場合はカテゴリ ID の順とする
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Confidence != suggestions[j].Confidence {
+			return suggestions[i].Confidence > suggestions[j].Confidence
+		}
+		return suggestions[i].CategoryID < suggestions[j].CategoryID
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+// suggestionFeatures はレコードの推定に使う特徴を返す
+//   - メモの語（記号・空白区切り。英字の大文字小文字・全角半角をそろえる。数値のみの語は除く）
+//   - 空白で区切らない日本語のため、ASCII 以外を含む語の2文字ずつの組
+//   - 登録元
+//   - 金額の桁（10 倍ごとを2つに分けた範囲）
+func suggestionFeatures(record *Record) []string {
+	var features []string
+	words := strings.FieldsFunc(normalizeWord(record.Memo), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	for _, w := range words {
+		if _, err := strconv.Atoi(w); err == nil {
+			continue
+		}
+		features = append(features, "w:"+w)
+		if utf8.RuneCountInString(w) >= 2 && strings.IndexFunc(w, func(r rune) bool { return r > unicode.MaxASCII }) >= 0 {
+			runes := []rune(w)
+			for i := 0; i+1 < len(runes); i++ {
+				features = append(features, "b:"+string(runes[i:i+2]))
+			}
+		}
+	}
+	if record.From != "" {
+		features = append(features, "f:"+record.From)
+	}
+	if record.Price > 0 {
+		features = append(features, "p:"+strconv.Itoa(int(math.Floor(2*math.Log10(float64(record.Price))))))
+	}
+	return features
+}
@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSuggestionModel_Suggest(t *testing.T) {
+	model := NewSuggestionModel()
+	for i, r := range []Record{
+		{CategoryID: 210, Memo: "セブンイレブン 朝食", From: "card", Price: 480},
+		{CategoryID: 210, Memo: "ローソン", From: "card", Price: 320},
+		{CategoryID: 210, Memo: "セブンイレブン", From: "card", Price: 650},
+		{CategoryID: 220, Memo: "電気代 10月", From: "bank", Price: 8200},
+		{CategoryID: 220, Memo: "ガス代", From: "bank", Price: 4300},
+		{CategoryID: 300, Memo: "Amazon 本", From: "card", Price: 1500},
+	} {
+		r.ID = i + 1
+		model.Observe(&r)
+	}
+
+	tests := []struct {
+		name   string
+		record Record
+		limit  int
+		want   []int
+	}{
+		{
+			name:   "正常系: 全角半角を区別せず、メモの一部（2文字の組）で推定する",
+			record: Record{Memo: "ｾﾌﾞﾝ"},
+			limit:  3,
+			want:   []int{210, 220, 300},
+		},
+		{
+			name:   "正常系: 登録元と金額の桁で推定する",
+			record: Record{Memo: "水道代", From: "bank", Price: 5000},
+			limit:  1,
+			want:   []int{220},
+		},
+		{
+			name:   "正常系: メモの語の一部で推定する",
+			record: Record{Memo: "電気"},
+			limit:  1,
+			want:   []int{220},
+		},
+		{
+			name:   "正常系: 英字の大文字小文字を区別しない",
+			record: Record{Memo: "amazon"},
+			limit:  1,
+			want:   []int{300},
+		},
+		{
+			name:   "異常系: 学習したレコードに現れない特徴のみの場合は推定しない",
+			record: Record{Memo: "家賃 2024"},
+			limit:  3,
+			want:   []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := model.Suggest(&tt.record, tt.limit)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Suggest() = %+v, want categories %v", got, tt.want)
+			}
+			for i, s := range got {
+				if s.CategoryID != tt.want[i] {
+					t.Errorf("Suggest()[%d] = %+v, want category %d", i, s, tt.want[i])
+				}
+				if s.Confidence <= 0 || s.Confidence > 1 || (i > 0 && s.Confidence > got[i-1].Confidence) {
+					t.Errorf("unexpected confidence: %+v", got)
+				}
+			}
+		})
+	}
+
+	// 全てのカテゴリの候補の確からしさの合計は 1
+	sum := 0.0
+	for _, s := range model.Suggest(&Record{Memo: "セブン"}, 10) {
+		sum += s.Confidence
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		t.Errorf("expected total confidence 1, got %v", sum)
+	}
+}
+
+func TestSuggestionModel_ObserveAndForget(t *testing.T) {
+	model := NewSuggestionModel()
+	model.Observe(&Record{ID: 1, CategoryID: 210, Memo: "コンビニ"})
+	model.Observe(&Record{ID: 2, CategoryID: 210, Memo: "スーパー"})
+	if got := model.Suggest(&Record{Memo: "コンビニ"}, 1); len(got) != 1 || got[0].CategoryID != 210 {
+		t.Fatalf("unexpected suggestions: %+v", got)
+	}
+
+	// 学習済みのレコードは新しい内容で置き換える
+	model.Observe(&Record{ID: 1, CategoryID: 220, Memo: "コンビニ"})
+	if got := model.Suggest(&Record{Memo: "コンビニ"}, 1); len(got) != 1 || got[0].CategoryID != 220 {
+		t.Errorf("expected reclassified category 220, got %+v", got)
+	}
+	if model.Len() != 2 {
+		t.Errorf("expected 2 records, got %d", model.Len())
+	}
+
+	// ゴミ箱に移動したレコード・削除したレコードは学習から除く
+	deletedAt := time.Now()
+	model.Observe(&Record{ID: 1, CategoryID: 220, Memo: "コンビニ", DeletedAt: &deletedAt})
+	if got := model.Suggest(&Record{Memo: "コンビニ"}, 3); len(got) != 0 {
+		t.Errorf("expected no suggestions, got %+v", got)
+	}
+	model.Forget(2)
+	model.Forget(3)
+	if model.Len() != 0 || len(model.vocab) != 0 || len(model.counts) != 0 || len(model.docs) != 0 || len(model.totals) != 0 {
+		t.Errorf("expected empty model, got %+v", model)
+	}
+}
@@ -1,51 +1,517 @@
 package config
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"net/url"
 	"os"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// データベースドライバ
+const (
+	DBDriverMySQL    = "mysql"
+	DBDriverSQLite   = "sqlite"
+	DBDriverPostgres = "postgres"
+)
+
+// データの保存先
+const (
+	StorageDatabase = "database" // DB_DRIVER で指定したデータベース
+	StorageMemory   = "memory"   // メモリ上に保持し終了時に破棄
+)
+
+// client コマンドの出力形式
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+	OutputCSV   = "csv"
 )
 
+// ログの出力先
+const (
+	LogOutputStdout = "stdout"
+	LogOutputStderr = "stderr"
+)
+
+// redacted は秘匿情報を表示する際の置き換え文字列
+const redacted = "********"
+
+// Config はアプリケーション全体の設定
+// 値はデフォルト値 → 設定ファイル → 環境変数 → コマンドラインフラグの順に上書きされる
+type Config struct {
+	Server    ServerConfig    `yaml:"server"`
+	Database  DBInfo          `yaml:"database"`
+	Telemetry TelemetryConfig `yaml:"telemetry"`
+	Logging   LogInfo         `yaml:"logging"`
+	Features  FeaturesConfig  `yaml:"features"`
+	Client    ClientConfig    `yaml:"client"`
+}
+
+// ServerConfig は HTTP サーバの設定
+type ServerConfig struct {
+	Host        string         `yaml:"host"`
+	Port        int            `yaml:"port"`
+	Storage     string         `yaml:"storage"`      // database, memory
+	AutoMigrate bool           `yaml:"auto_migrate"` // 起動時に未適用のマイグレーションを適用するか
+	Timeouts    TimeoutsConfig `yaml:"timeouts"`
+}
+
+// TimeoutsConfig は HTTP サーバのタイムアウト設定
+// 0 の場合はタイムアウトしない
+type TimeoutsConfig struct {
+	ReadHeader    time.Duration `yaml:"read_header"`
+	Read          time.Duration `yaml:"read"`
+	Write         time.Duration `yaml:"write"`
+	Idle          time.Duration `yaml:"idle"`
+	Shutdown      time.Duration `yaml:"shutdown"`
+	ShutdownDelay time.Duration `yaml:"shutdown_delay"` // readiness を 503 にしてから新しい接続の受け付けを止めるまでの時間
+}
+
 // DBInfo はデータベース接続情報を保持する構造体
 type DBInfo struct {
-	Host string
-	Port string
-	User string
-	Pass string
-	Name string
+	Driver   string            `yaml:"driver"`
+	Host     string            `yaml:"host"`
+	Port     string            `yaml:"port"` // 未指定の場合はドライバのデフォルト（MySQL: 3306, PostgreSQL: 5432）
+	User     string            `yaml:"user"`
+	Pass     string            `yaml:"password"`
+	Name     string            `yaml:"name"`
+	Path     string            `yaml:"path"`     // SQLite のデータベースファイルのパス
+	TLS      string            `yaml:"tls"`      // TLS の使用方法（TLSMode*）。未指定の場合はドライバのデフォルト
+	Timezone string            `yaml:"timezone"` // 日時を解釈するタイムゾーン（例: Asia/Tokyo）。未指定の場合はサーバのローカルタイムゾーン
+	Params   map[string]string `yaml:"params"`   // DSN に追加するパラメータ
+	Pool     PoolConfig        `yaml:"pool"`
+	Retry    RetryConfig       `yaml:"retry"`
+}
+
+// TLS の使用方法
+const (
+	TLSModeDisable    = "disable"     // 使用しない
+	TLSModePreferred  = "preferred"   // サーバが対応している場合のみ使用する
+	TLSModeSkipVerify = "skip-verify" // 使用するが証明書を検証しない
+	TLSModeVerify     = "verify"      // 使用し、証明書とホスト名を検証する
+)
+
+// PoolConfig はコネクションプールの設定
+type PoolConfig struct {
+	MaxOpenConns    int           `yaml:"max_open_conns"`     // 最大接続数（0: 無制限）
+	MaxIdleConns    int           `yaml:"max_idle_conns"`     // アイドル状態で保持する最大接続数
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`  // 接続を再利用する最大期間（0: 無制限）
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"` // アイドル状態の接続を保持する最大期間（0: 無制限）
+}
+
+// RetryConfig は起動時のデータベース接続のリトライ設定
+// 接続に失敗した場合、InitialInterval から MaxInterval まで待ち時間を倍にしながら Timeout まで再試行する
+type RetryConfig struct {
+	Timeout         time.Duration `yaml:"timeout"` // 0 の場合はリトライしない
+	InitialInterval time.Duration `yaml:"initial_interval"`
+	MaxInterval     time.Duration `yaml:"max_interval"`
+}
+
+// TelemetryConfig はトレース・メトリクスの設定
+type TelemetryConfig struct {
+	OTLPServer string `yaml:"otlp_server"` // 未指定の場合は OTLP で送信しない
+	Metrics    bool   `yaml:"metrics"`     // /metrics で Prometheus 形式のメトリクスを公開するか
+}
+
+// LogInfo はログ出力の設定を保持する構造体
+type LogInfo struct {
+	Level  string `yaml:"level"`  // debug, info, warn, error
+	Format string `yaml:"format"` // json, text
+	Source bool   `yaml:"source"` // 呼び出し元のファイル名と行番号を出力するか
+	Output string `yaml:"output"` // stdout, stderr
+}
+
+// FeaturesConfig は機能ごとの設定
+type FeaturesConfig struct {
+	TrashRetention  time.Duration  `yaml:"trash_retention"`   // ゴミ箱内のレコードを保持する期間
+	AllowFutureDate bool           `yaml:"allow_future_date"` // 翌日以降の日付のレコードを許可する
+	AllowZeroPrice  bool           `yaml:"allow_zero_price"`  // 金額 0 のレコードを許可する
+	CategoryAliases map[string]int `yaml:"category_aliases"`  // 短い文の解釈に使うカテゴリの別名（別名 → カテゴリ ID）
+	Rules           []RuleConfig   `yaml:"rules"`             // レコードを自動で分類する規則
+	Backup          BackupConfig   `yaml:"backup"`
+}
+
+// RuleConfig はレコードを自動で分類する規則の設定
+type RuleConfig struct {
+	Name     string          `yaml:"name"`
+	Priority int             `yaml:"priority"` // 大きいほど優先する。同じ場合は記述順
+	Match    RuleMatchConfig `yaml:"match"`    // 指定した条件を全て満たすレコードに適用する
+	Set      RuleSetConfig   `yaml:"set"`
+}
+
+// RuleMatchConfig は規則を適用するレコードの条件
+type RuleMatchConfig struct {
+	MemoContains string   `yaml:"memo_contains"` // メモに含む文字列
+	MemoRegex    string   `yaml:"memo_regex"`    // メモに一致する正規表現
+	From         string   `yaml:"from"`          // 登録元（完全一致）
+	MinPrice     *int     `yaml:"min_price"`     // 金額の下限（以上）
+	MaxPrice     *int     `yaml:"max_price"`     // 金額の上限（以下）
+	Weekdays     []string `yaml:"weekdays"`      // 曜日（mon, tue, wed, thu, fri, sat, sun）
 }
 
-// LoadDBInfo は環境変数からデータベース接続情報を読み込む
-func LoadDBInfo() (*DBInfo, error) {
-	dbInfo := &DBInfo{
-		Host: getEnv("DB_HOST", "localhost"),
-		Port: getEnv("DB_PORT", "3306"),
-		User: getEnv("DB_USER", "root"),
-		Pass: getEnv("DB_PASS", ""),
-		Name: getEnv("DB_NAME", "mawinter"),
+// RuleSetConfig は規則に一致したレコードに設定する値
+type RuleSetConfig struct {
+	CategoryID int      `yaml:"category_id"`
+	Type       string   `yaml:"type"`
+	Tags       []string `yaml:"tags"` // 追加するタグ
+}
+
+// ruleWeekdays は規則の曜日に指定できる値
+var ruleWeekdays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// BackupConfig は自動バックアップの設定
+type BackupConfig struct {
+	Dir         string        `yaml:"dir"` // 未指定の場合は自動バックアップを行わない
+	Interval    time.Duration `yaml:"interval"`
+	KeepLast    int           `yaml:"keep_last"`
+	KeepDaily   int           `yaml:"keep_daily"`
+	KeepMonthly int           `yaml:"keep_monthly"`
+}
+
+// ClientConfig はリモートのサーバに接続するコマンド（record, categories, summary）の設定
+type ClientConfig struct {
+	URL     string        `yaml:"url"`     // サーバの URL（例: http://localhost:8080）。API は URL の /api 以下に送信する
+	Token   string        `yaml:"token"`   // Authorization: Bearer で送信するトークン。未指定の場合は送信しない
+	Output  string        `yaml:"output"`  // table, json, csv
+	Timeout time.Duration `yaml:"timeout"` // リクエストのタイムアウト（0: なし）
+}
+
+// Default はデフォルト値の設定を返す
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Host:    "0.0.0.0",
+			Port:    8080,
+			Storage: StorageDatabase,
+			Timeouts: TimeoutsConfig{
+				ReadHeader: 10 * time.Second,
+				Read:       30 * time.Second,
+				Write:      30 * time.Second,
+				Idle:       120 * time.Second,
+				Shutdown:   20 * time.Second,
+			},
+		},
+		Database: DBInfo{
+			Driver: DBDriverMySQL,
+			Host:   "localhost",
+			User:   "root",
+			Name:   "mawinter",
+			Path:   "mawinter.db",
+			Pool: PoolConfig{
+				MaxOpenConns:    10,
+				MaxIdleConns:    5,
+				ConnMaxLifetime: 30 * time.Minute,
+				ConnMaxIdleTime: 5 * time.Minute,
+			},
+			Retry: RetryConfig{
+				Timeout:         time.Minute,
+				InitialInterval: time.Second,
+				MaxInterval:     15 * time.Second,
+			},
+		},
+		Telemetry: TelemetryConfig{
+			Metrics: true,
+		},
+		Logging: LogInfo{
+			Level:  "info",
+			Format: "json",
+			Source: true,
+			Output: LogOutputStdout,
+		},
+		Features: FeaturesConfig{
+			TrashRetention: 30 * 24 * time.Hour,
+			Backup: BackupConfig{
+				Interval:    24 * time.Hour,
+				KeepLast:    7,
+				KeepDaily:   7,
+				KeepMonthly: 12,
+			},
+		},
+		Client: ClientConfig{
+			URL:     "http://localhost:8080",
+			Output:  OutputTable,
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// Load はデフォルト値に設定ファイル（path が空の場合は読み込まない）と環境変数の値を上書きした設定を返す
+// 検証は行わないため、フラグで上書きした後に Validate を呼び出すこと
+func Load(path string) (*Config, error) {
+	cfg := Default()
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+	if err := cfg.applyEnv(os.LookupEnv, os.ReadFile); err != nil {
+		return nil, err
+	}
+	if cfg.Database.Port == "" {
+		cfg.Database.Port = defaultDBPort(cfg.Database.Driver)
 	}
+	return cfg, nil
+}
 
-	// 必須項目のバリデーション
-	if dbInfo.Host == "" {
-		return nil, fmt.Errorf("DB_HOST is required")
+// defaultDBPort はドライバのデフォルトのポート番号を返す
+func defaultDBPort(driver string) string {
+	switch driver {
+	case DBDriverMySQL:
+		return "3306"
+	case DBDriverPostgres:
+		return "5432"
 	}
-	if dbInfo.Port == "" {
-		return nil, fmt.Errorf("DB_PORT is required")
+	return ""
+}
+
+// loadFile は YAML 形式の設定ファイルを読み込む
+// 未知のキーはタイプミスの可能性が高いためエラーとする
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
 	}
-	if dbInfo.User == "" {
-		return nil, fmt.Errorf("DB_USER is required")
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("invalid config file %s: %w", path, err)
 	}
-	if dbInfo.Name == "" {
-		return nil, fmt.Errorf("DB_NAME is required")
+	return nil
+}
+
+// Validate は設定値を検証する
+// 不正な値が複数ある場合は全てのエラーをまとめて返す
+func (c *Config) Validate() error {
+	var errs []error
+	invalid := func(key, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", key, fmt.Sprintf(format, args...)))
 	}
 
-	return dbInfo, nil
+	// server
+	if c.Server.Port < 1 || c.Server.Port > 65535 {
+		invalid("server.port", "must be between 1 and 65535, got %d", c.Server.Port)
+	}
+	switch c.Server.Storage {
+	case StorageDatabase, StorageMemory:
+	default:
+		invalid("server.storage", "must be one of %s, %s, got %q", StorageDatabase, StorageMemory, c.Server.Storage)
+	}
+	timeouts := []struct {
+		key   string
+		value time.Duration
+	}{
+		{"server.timeouts.read_header", c.Server.Timeouts.ReadHeader},
+		{"server.timeouts.read", c.Server.Timeouts.Read},
+		{"server.timeouts.write", c.Server.Timeouts.Write},
+		{"server.timeouts.idle", c.Server.Timeouts.Idle},
+		{"server.timeouts.shutdown", c.Server.Timeouts.Shutdown},
+		{"server.timeouts.shutdown_delay", c.Server.Timeouts.ShutdownDelay},
+	}
+	for _, t := range timeouts {
+		if t.value < 0 {
+			invalid(t.key, "must not be negative, got %s", t.value)
+		}
+	}
+
+	// database（メモリストレージの場合は使用しない）
+	if c.Server.Storage != StorageMemory {
+		errs = append(errs, c.Database.validate()...)
+	}
+
+	// logging
+	errs = append(errs, c.Logging.validate()...)
+
+	// features
+	if c.Features.TrashRetention <= 0 {
+		invalid("features.trash_retention", "must be positive, got %s", c.Features.TrashRetention)
+	}
+	for alias, id := range c.Features.CategoryAliases {
+		if strings.TrimSpace(alias) == "" || strings.ContainsFunc(alias, unicode.IsSpace) {
+			invalid("features.category_aliases", "alias must not be empty or contain spaces, got %q", alias)
+		}
+		if id <= 0 {
+			invalid("features.category_aliases", "category id of %q must be positive, got %d", alias, id)
+		}
+	}
+	// 規則の内容は規則を読み込むときに検証するため、ここでは曜日の書式のみ検証する
+	for i, rule := range c.Features.Rules {
+		for _, d := range rule.Match.Weekdays {
+			if !slices.Contains(ruleWeekdays, strings.ToLower(d)) {
+				invalid(fmt.Sprintf("features.rules[%d].match.weekdays", i), "must be one of %s, got %q", strings.Join(ruleWeekdays, ", "), d)
+			}
+		}
+	}
+	if b := c.Features.Backup; b.Dir != "" {
+		if c.Server.Storage == StorageMemory {
+			invalid("features.backup.dir", "is not supported with server.storage=%s", StorageMemory)
+		}
+		if b.Interval < time.Minute {
+			invalid("features.backup.interval", "must be at least 1m, got %s", b.Interval)
+		}
+		if b.KeepLast < 1 {
+			invalid("features.backup.keep_last", "must be at least 1, got %d", b.KeepLast)
+		}
+		if b.KeepDaily < 0 {
+			invalid("features.backup.keep_daily", "must not be negative, got %d", b.KeepDaily)
+		}
+		if b.KeepMonthly < 0 {
+			invalid("features.backup.keep_monthly", "must not be negative, got %d", b.KeepMonthly)
+		}
+	}
+
+	// client
+	if u, err := url.Parse(c.Client.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		invalid("client.url", "must be an absolute http or https URL, got %q", c.Client.URL)
+	}
+	switch c.Client.Output {
+	case OutputTable, OutputJSON, OutputCSV:
+	default:
+		invalid("client.output", "must be one of %s, %s, %s, got %q", OutputTable, OutputJSON, OutputCSV, c.Client.Output)
+	}
+	if c.Client.Timeout < 0 {
+		invalid("client.timeout", "must not be negative, got %s", c.Client.Timeout)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// validate はデータベース接続情報を検証する
+func (d *DBInfo) validate() []error {
+	var errs []error
+	required := func(key, value string) {
+		if value == "" {
+			errs = append(errs, fmt.Errorf("database.%s: is required for driver %s", key, d.Driver))
+		}
+	}
+
+	switch d.Driver {
+	case DBDriverMySQL, DBDriverPostgres:
+		required("host", d.Host)
+		required("port", d.Port)
+		required("user", d.User)
+		required("name", d.Name)
+	case DBDriverSQLite:
+		required("path", d.Path)
+	default:
+		errs = append(errs, fmt.Errorf("database.driver: must be one of %s, %s, %s, got %q", DBDriverMySQL, DBDriverPostgres, DBDriverSQLite, d.Driver))
+	}
+
+	switch d.TLS {
+	case "", TLSModeDisable, TLSModePreferred, TLSModeSkipVerify, TLSModeVerify:
+	default:
+		errs = append(errs, fmt.Errorf("database.tls: must be one of %s, %s, %s, %s, got %q", TLSModeDisable, TLSModePreferred, TLSModeSkipVerify, TLSModeVerify, d.TLS))
+	}
+	if d.Timezone != "" {
+		if _, err := time.LoadLocation(d.Timezone); err != nil {
+			errs = append(errs, fmt.Errorf("database.timezone: unknown time zone %q", d.Timezone))
+		}
+	}
+	for key := range d.Params {
+		if key == "" {
+			errs = append(errs, fmt.Errorf("database.params: parameter name must not be empty"))
+		}
+	}
+
+	if d.Pool.MaxOpenConns < 0 {
+		errs = append(errs, fmt.Errorf("database.pool.max_open_conns: must not be negative, got %d", d.Pool.MaxOpenConns))
+	}
+	if d.Pool.MaxIdleConns < 0 {
+		errs = append(errs, fmt.Errorf("database.pool.max_idle_conns: must not be negative, got %d", d.Pool.MaxIdleConns))
+	}
+	if d.Pool.MaxOpenConns > 0 && d.Pool.MaxIdleConns > d.Pool.MaxOpenConns {
+		errs = append(errs, fmt.Errorf("database.pool.max_idle_conns: must not exceed max_open_conns (%d), got %d", d.Pool.MaxOpenConns, d.Pool.MaxIdleConns))
+	}
+	if d.Pool.ConnMaxLifetime < 0 {
+		errs = append(errs, fmt.Errorf("database.pool.conn_max_lifetime: must not be negative, got %s", d.Pool.ConnMaxLifetime))
+	}
+	if d.Pool.ConnMaxIdleTime < 0 {
+		errs = append(errs, fmt.Errorf("database.pool.conn_max_idle_time: must not be negative, got %s", d.Pool.ConnMaxIdleTime))
+	}
+
+	if d.Retry.Timeout < 0 {
+		errs = append(errs, fmt.Errorf("database.retry.timeout: must not be negative, got %s", d.Retry.Timeout))
+	}
+	if d.Retry.Timeout > 0 {
+		if d.Retry.InitialInterval <= 0 {
+			errs = append(errs, fmt.Errorf("database.retry.initial_interval: must be positive, got %s", d.Retry.InitialInterval))
+		}
+		if d.Retry.MaxInterval < d.Retry.InitialInterval {
+			errs = append(errs, fmt.Errorf("database.retry.max_interval: must not be less than initial_interval (%s), got %s", d.Retry.InitialInterval, d.Retry.MaxInterval))
+		}
+	}
+	return errs
+}
+
+// validate はログ出力の設定値を検証する
+func (l *LogInfo) validate() []error {
+	var errs []error
+	switch l.Level {
+	case "debug", "info", "warn", "error":
+	default:
+		errs = append(errs, fmt.Errorf("logging.level: must be one of debug, info, warn, error, got %q", l.Level))
+	}
+	switch l.Format {
+	case "json", "text":
+	default:
+		errs = append(errs, fmt.Errorf("logging.format: must be one of json, text, got %q", l.Format))
+	}
+	switch l.Output {
+	case LogOutputStdout, LogOutputStderr:
+	default:
+		errs = append(errs, fmt.Errorf("logging.output: must be one of stdout, stderr, got %q", l.Output))
+	}
+	return errs
+}
+
+// Redacted はパスワードなどの秘匿情報を伏せた設定のコピーを返す
+func (c *Config) Redacted() *Config {
+	cp := *c
+	if cp.Database.Pass != "" {
+		cp.Database.Pass = redacted
+	}
+	if cp.Client.Token != "" {
+		cp.Client.Token = redacted
+	}
+	// Params は元の設定と共有しないようコピーしてから伏せる
+	if c.Database.Params != nil {
+		cp.Database.Params = make(map[string]string, len(c.Database.Params))
+		for key, value := range c.Database.Params {
+			if isSecretParam(key) {
+				value = redacted
+			}
+			cp.Database.Params[key] = value
+		}
+	}
+	return &cp
+}
+
+// secretParamWords は値を秘匿情報とみなす DSN のパラメータ名に含まれる語
+var secretParamWords = []string{"pass", "token", "secret", "credential"}
+
+// isSecretParam は DSN のパラメータ（postgres の password・sslpassword など）が秘匿情報かを返す
+func isSecretParam(key string) bool {
+	key = strings.ToLower(key)
+	return slices.ContainsFunc(secretParamWords, func(w string) bool { return strings.Contains(key, w) })
 }
 
-// getEnv は環境変数を取得し、存在しない場合はデフォルト値を返す
-func getEnv(key, defaultValue string) string {
-	value := os.Getenv(key)
-	if value == "" {
-		return defaultValue
+// WriteYAML は設定を YAML 形式で書き出す
+func (c *Config) WriteYAML(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(c); err != nil {
+		return err
 	}
-	return value
+	return enc.Close()
 }
@@ -0,0 +1,332 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	configFile := writeFile(t, "config.yaml", `
+server:
+  port: 9090
+  timeouts:
+    shutdown: 5s
+database:
+  driver: postgres
+  host: db
+  password: from-file
+logging:
+  format: text
+`)
+
+	tests := []struct {
+		name      string
+		path      string
+		env       map[string]string
+		wantErr   string
+		checkFunc func(t *testing.T, cfg *Config)
+	}{
+		{
+			name: "正常系: 設定ファイルがなければデフォルト値",
+			checkFunc: func(t *testing.T, cfg *Config) {
+				if cfg.Server.Port != 8080 || cfg.Database.Driver != DBDriverMySQL || cfg.Database.Port != "3306" {
+					t.Errorf("unexpected defaults: %+v", cfg)
+				}
+			},
+		},
+		{
+			name: "正常系: 設定ファイルの値で上書きし、指定のない項目はデフォルト値",
+			path: configFile,
+			checkFunc: func(t *testing.T, cfg *Config) {
+				if cfg.Server.Port != 9090 || cfg.Server.Timeouts.Shutdown != 5*time.Second || cfg.Server.Timeouts.Read != 30*time.Second {
+					t.Errorf("unexpected server config: %+v", cfg.Server)
+				}
+				if cfg.Database.Host != "db" || cfg.Database.Pass != "from-file" || cfg.Database.Port != "5432" {
+					t.Errorf("unexpected database config: %+v", cfg.Database)
+				}
+				if cfg.Logging.Format != "text" || cfg.Logging.Level != "info" {
+					t.Errorf("unexpected logging config: %+v", cfg.Logging)
+				}
+			},
+		},
+		{
+			name: "正常系: 環境変数は設定ファイルより優先する",
+			path: configFile,
+			env:  map[string]string{"SERVER_PORT": "7000", "DB_PASS": "from-env", "LOG_LEVEL": "DEBUG", "BACKUP_INTERVAL": "1h"},
+			checkFunc: func(t *testing.T, cfg *Config) {
+				if cfg.Server.Port != 7000 || cfg.Database.Pass != "from-env" || cfg.Logging.Level != "debug" || cfg.Features.Backup.Interval != time.Hour {
+					t.Errorf("env overrides were not applied: %+v", cfg)
+				}
+			},
+		},
+		{
+			name: "正常系: _FILE の環境変数はファイルの内容を値とする",
+			env:  map[string]string{"DB_PASS_FILE": writeFile(t, "db_pass", "secret\n")},
+			checkFunc: func(t *testing.T, cfg *Config) {
+				if cfg.Database.Pass != "secret" {
+					t.Errorf("expected password from file, got %q", cfg.Database.Pass)
+				}
+			},
+		},
+		{
+			name:    "異常系: 値と _FILE の両方を指定",
+			env:     map[string]string{"DB_PASS": "a", "DB_PASS_FILE": "/run/secrets/db_pass"},
+			wantErr: "both DB_PASS and DB_PASS_FILE are set",
+		},
+		{
+			name:    "異常系: 環境変数の型が不正",
+			env:     map[string]string{"SERVER_PORT": "http"},
+			wantErr: "invalid SERVER_PORT",
+		},
+		{
+			name:    "異常系: 設定ファイルに未知のキー",
+			path:    writeFile(t, "typo.yaml", "server:\n  prot: 8080\n"),
+			wantErr: "field prot not found",
+		},
+		{
+			name:    "異常系: 設定ファイルが存在しない",
+			path:    filepath.Join(t.TempDir(), "missing.yaml"),
+			wantErr: "failed to read config file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range Default().envVars() {
+				t.Setenv(v.name, "")
+				t.Setenv(v.name+fileSuffix, "")
+			}
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			cfg, err := Load(tt.path)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Load() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			tt.checkFunc(t, cfg)
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(cfg *Config)
+		wantErrs []string
+	}{
+		{
+			name:   "正常系: デフォルト値",
+			modify: func(cfg *Config) { cfg.Database.Port = "3306" },
+		},
+		{
+			name: "正常系: メモリストレージではデータベースの設定を検証しない",
+			modify: func(cfg *Config) {
+				cfg.Server.Storage = StorageMemory
+				cfg.Database.Driver = ""
+			},
+		},
+		{
+			name: "異常系: 不正な値を全て報告する",
+			modify: func(cfg *Config) {
+				cfg.Server.Port = 0
+				cfg.Server.Timeouts.Write = -time.Second
+				cfg.Database.Driver = "oracle"
+				cfg.Logging.Level = "verbose"
+				cfg.Features.Backup.Dir = "/backups"
+				cfg.Features.Backup.Interval = time.Second
+			},
+			wantErrs: []string{"server.port", "server.timeouts.write", "database.driver", "logging.level", "features.backup.interval"},
+		},
+		{
+			name: "異常系: ドライバに必要な項目がない",
+			modify: func(cfg *Config) {
+				cfg.Database.Driver = DBDriverSQLite
+				cfg.Database.Path = ""
+			},
+			wantErrs: []string{"database.path: is required for driver sqlite"},
+		},
+		{
+			name: "異常系: メモリストレージでは自動バックアップを使えない",
+			modify: func(cfg *Config) {
+				cfg.Server.Storage = StorageMemory
+				cfg.Features.Backup.Dir = "/backups"
+			},
+			wantErrs: []string{"features.backup.dir"},
+		},
+		{
+			name: "異常系: 不正な接続オプション・プール・リトライの設定",
+			modify: func(cfg *Config) {
+				cfg.Database.Port = "3306"
+				cfg.Database.TLS = "always"
+				cfg.Database.Timezone = "Mars/Olympus"
+				cfg.Database.Pool.MaxOpenConns = 2
+				cfg.Database.Pool.MaxIdleConns = 5
+				cfg.Database.Retry.MaxInterval = time.Millisecond
+			},
+			wantErrs: []string{"database.tls", "database.timezone", "database.pool.max_idle_conns", "database.retry.max_interval"},
+		},
+		{
+			name: "異常系: 不正なカテゴリの別名",
+			modify: func(cfg *Config) {
+				cfg.Database.Port = "3306"
+				cfg.Features.CategoryAliases = map[string]int{"ランチ": 210, "コンビニ 弁当": 210, "雑費": 0}
+			},
+			wantErrs: []string{`alias must not be empty or contain spaces, got "コンビニ 弁当"`, `category id of "雑費" must be positive`},
+		},
+		{
+			name: "異常系: 自動分類の規則の不正な曜日",
+			modify: func(cfg *Config) {
+				cfg.Database.Port = "3306"
+				cfg.Features.Rules = []RuleConfig{
+					{Name: "コンビニ", Match: RuleMatchConfig{MemoContains: "セブン", Weekdays: []string{"Sat", "sun"}}, Set: RuleSetConfig{CategoryID: 210}},
+					{Name: "週末", Match: RuleMatchConfig{Weekdays: []string{"土"}}, Set: RuleSetConfig{CategoryID: 210}},
+				}
+			},
+			wantErrs: []string{`features.rules[1].match.weekdays: must be one of sun, mon, tue, wed, thu, fri, sat, got "土"`},
+		},
+		{
+			name: "異常系: 不正なクライアントの設定",
+			modify: func(cfg *Config) {
+				cfg.Database.Port = "3306"
+				cfg.Client.URL = "localhost:8080"
+				cfg.Client.Output = "xml"
+				cfg.Client.Timeout = -time.Second
+			},
+			wantErrs: []string{"client.url", "client.output", "client.timeout"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			tt.modify(cfg)
+
+			err := cfg.Validate()
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected validation error")
+			}
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestConfig_Redacted(t *testing.T) {
+	cfg := Default()
+	cfg.Database.Pass = "secret"
+	cfg.Client.Token = "token-secret"
+	cfg.Database.Params = map[string]string{"sslpassword": "ssl-secret", "Password": "param-secret", "auth_token": "auth-secret", "sslmode": "require"}
+
+	var buf bytes.Buffer
+	if err := cfg.Redacted().WriteYAML(&buf); err != nil {
+		t.Fatalf("WriteYAML() error = %v", err)
+	}
+	if strings.Contains(buf.String(), "secret") || !strings.Contains(buf.String(), redacted) {
+		t.Errorf("password and token are not redacted:\n%s", buf.String())
+	}
+	// 元の設定は変更しない
+	if cfg.Database.Pass != "secret" || cfg.Database.Params["sslpassword"] != "ssl-secret" {
+		t.Errorf("original config was modified: %q, %v", cfg.Database.Pass, cfg.Database.Params)
+	}
+	// 秘匿情報でないパラメータはそのまま表示する
+	if !strings.Contains(buf.String(), "sslmode: require") {
+		t.Errorf("params are not shown:\n%s", buf.String())
+	}
+
+	// 出力した YAML は設定ファイルとして読み込める
+	path := writeFile(t, "effective.yaml", buf.String())
+	loaded := Default()
+	if err := loaded.loadFile(path); err != nil {
+		t.Fatalf("failed to load written config: %v", err)
+	}
+	if loaded.Features.TrashRetention != cfg.Features.TrashRetention {
+		t.Errorf("trash retention = %s, want %s", loaded.Features.TrashRetention, cfg.Features.TrashRetention)
+	}
+}
+
+func TestApplyEnv_ReadFileError(t *testing.T) {
+	cfg := Default()
+	lookup := func(key string) (string, bool) {
+		if key == "DB_PASS_FILE" {
+			return "/run/secrets/db_pass", true
+		}
+		return "", false
+	}
+	readFile := func(string) ([]byte, error) { return nil, errors.New("permission denied") }
+
+	err := cfg.applyEnv(lookup, readFile)
+	if err == nil || !strings.Contains(err.Error(), "failed to read DB_PASS_FILE") {
+		t.Errorf("applyEnv() error = %v", err)
+	}
+}
+
+func TestDBInfo_DSN(t *testing.T) {
+	tests := []struct {
+		name   string
+		dbInfo DBInfo
+		want   string
+	}{
+		{
+			name:   "MySQL: デフォルトはローカルタイムゾーン",
+			dbInfo: DBInfo{Driver: DBDriverMySQL, Host: "db", Port: "3306", User: "root", Pass: "pw", Name: "mawinter"},
+			want:   "root:pw@tcp(db:3306)/mawinter?charset=utf8mb4&loc=Local&parseTime=True",
+		},
+		{
+			name:   "MySQL: タイムゾーン・TLS・追加パラメータ",
+			dbInfo: DBInfo{Driver: DBDriverMySQL, Host: "db", Port: "3306", User: "root", Name: "mawinter", Timezone: "Asia/Tokyo", TLS: TLSModeVerify, Params: map[string]string{"timeout": "5s"}},
+			want:   "root:@tcp(db:3306)/mawinter?charset=utf8mb4&loc=Asia%2FTokyo&parseTime=True&timeout=5s&tls=true",
+		},
+		{
+			name:   "PostgreSQL: タイムゾーンと TLS",
+			dbInfo: DBInfo{Driver: DBDriverPostgres, Host: "db", Port: "5432", User: "postgres", Pass: "p@ss", Name: "mawinter", Timezone: "UTC", TLS: TLSModeSkipVerify},
+			want:   "postgres://postgres:p%40ss@db:5432/mawinter?TimeZone=UTC&sslmode=require",
+		},
+		{
+			name:   "SQLite: WAL を有効にする",
+			dbInfo: DBInfo{Driver: DBDriverSQLite, Path: "/var/lib/mawinter/mawinter.db"},
+			want:   "file:/var/lib/mawinter/mawinter.db?_pragma=busy_timeout%285000%29&_pragma=journal_mode%28WAL%29",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.dbInfo.DSN()
+			if err != nil {
+				t.Fatalf("DSN() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("DSN() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// mysqlTLS は TLS の使用方法に対応する MySQL ドライバの tls パラメータ
+var mysqlTLS = map[string]string{
+	TLSModeDisable:    "false",
+	TLSModePreferred:  "preferred",
+	TLSModeSkipVerify: "skip-verify",
+	TLSModeVerify:     "true",
+}
+
+// postgresSSLMode は TLS の使用方法に対応する PostgreSQL の sslmode パラメータ
+var postgresSSLMode = map[string]string{
+	TLSModeDisable:    "disable",
+	TLSModePreferred:  "prefer",
+	TLSModeSkipVerify: "require",
+	TLSModeVerify:     "verify-full",
+}
+
+// DSN はドライバに応じたデータベースの接続文字列を返す
+// Params で指定したパラメータは TLS・タイムゾーンなどの設定より優先する
+func (d *DBInfo) DSN() (string, error) {
+	switch d.Driver {
+	case DBDriverSQLite:
+		// 同時書き込み時にロック解除を待機し、読み込みと書き込みを並行できるよう WAL を有効にする
+		params := url.Values{"_pragma": {"busy_timeout(5000)", "journal_mode(WAL)"}}
+		d.addParams(params)
+		return fmt.Sprintf("file:%s?%s", d.Path, params.Encode()), nil
+	case DBDriverPostgres:
+		params := url.Values{}
+		// 年・月の集計をローカル時刻で行うため、セッションのタイムゾーンを合わせる
+		if tz := d.location().String(); tz != "Local" {
+			params.Set("TimeZone", tz)
+		}
+		if d.TLS != "" {
+			params.Set("sslmode", postgresSSLMode[d.TLS])
+		}
+		d.addParams(params)
+
+		dsn := url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(d.User, d.Pass),
+			Host:     d.Host + ":" + d.Port,
+			Path:     d.Name,
+			RawQuery: params.Encode(),
+		}
+		return dsn.String(), nil
+	case DBDriverMySQL:
+		params := url.Values{
+			"charset":   {"utf8mb4"},
+			"parseTime": {"True"},
+			"loc":       {d.location().String()},
+		}
+		if d.TLS != "" {
+			params.Set("tls", mysqlTLS[d.TLS])
+		}
+		d.addParams(params)
+
+		return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?%s", d.User, d.Pass, d.Host, d.Port, d.Name, params.Encode()), nil
+	default:
+		return "", fmt.Errorf("unsupported database driver: %s", d.Driver)
+	}
+}
+
+// location は日時を解釈するタイムゾーンを返す
+// 未指定または不正な場合はサーバのローカルタイムゾーンとする（不正な値は Validate で検出する）
+func (d *DBInfo) location() *time.Location {
+	if d.Timezone == "" {
+		return time.Local
+	}
+	loc, err := time.LoadLocation(d.Timezone)
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
+
+// addParams は追加のパラメータを params に上書きする
+func (d *DBInfo) addParams(params url.Values) {
+	for key, value := range d.Params {
+		params.Set(key, value)
+	}
+}
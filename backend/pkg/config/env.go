@@ -0,0 +1,147 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fileSuffix は値をファイルから読み込む環境変数の接尾辞
+// 例えば DB_PASS_FILE=/run/secrets/db_pass の場合、ファイルの内容を DB_PASS の値として使う
+const fileSuffix = "_FILE"
+
+// envVar は環境変数と設定項目の対応
+type envVar struct {
+	name string
+	set  func(value string) error
+}
+
+// envVars は設定を上書きする環境変数の一覧を返す
+func (c *Config) envVars() []envVar {
+	return []envVar{
+		{"SERVER_HOST", setString(&c.Server.Host)},
+		{"SERVER_PORT", setInt(&c.Server.Port)},
+		{"SERVER_STORAGE", setString(&c.Server.Storage)},
+		{"SERVER_AUTO_MIGRATE", setBool(&c.Server.AutoMigrate)},
+		{"SERVER_READ_HEADER_TIMEOUT", setDuration(&c.Server.Timeouts.ReadHeader)},
+		{"SERVER_READ_TIMEOUT", setDuration(&c.Server.Timeouts.Read)},
+		{"SERVER_WRITE_TIMEOUT", setDuration(&c.Server.Timeouts.Write)},
+		{"SERVER_IDLE_TIMEOUT", setDuration(&c.Server.Timeouts.Idle)},
+		{"SERVER_SHUTDOWN_TIMEOUT", setDuration(&c.Server.Timeouts.Shutdown)},
+		{"SERVER_SHUTDOWN_DELAY", setDuration(&c.Server.Timeouts.ShutdownDelay)},
+
+		{"DB_DRIVER", setString(&c.Database.Driver)},
+		{"DB_HOST", setString(&c.Database.Host)},
+		{"DB_PORT", setString(&c.Database.Port)},
+		{"DB_USER", setString(&c.Database.User)},
+		{"DB_PASS", setString(&c.Database.Pass)},
+		{"DB_NAME", setString(&c.Database.Name)},
+		{"DB_PATH", setString(&c.Database.Path)},
+		{"DB_TLS", setLower(&c.Database.TLS)},
+		{"DB_TIMEZONE", setString(&c.Database.Timezone)},
+		{"DB_MAX_OPEN_CONNS", setInt(&c.Database.Pool.MaxOpenConns)},
+		{"DB_MAX_IDLE_CONNS", setInt(&c.Database.Pool.MaxIdleConns)},
+		{"DB_CONN_MAX_LIFETIME", setDuration(&c.Database.Pool.ConnMaxLifetime)},
+		{"DB_CONN_MAX_IDLE_TIME", setDuration(&c.Database.Pool.ConnMaxIdleTime)},
+		{"DB_CONNECT_TIMEOUT", setDuration(&c.Database.Retry.Timeout)},
+
+		{"OTLP_SERVER", setString(&c.Telemetry.OTLPServer)},
+		{"METRICS_ENABLED", setBool(&c.Telemetry.Metrics)},
+
+		{"LOG_LEVEL", setLower(&c.Logging.Level)},
+		{"LOG_FORMAT", setLower(&c.Logging.Format)},
+		{"LOG_SOURCE", setBool(&c.Logging.Source)},
+		{"LOG_OUTPUT", setLower(&c.Logging.Output)},
+
+		{"TRASH_RETENTION", setDuration(&c.Features.TrashRetention)},
+		{"RECORD_ALLOW_FUTURE_DATE", setBool(&c.Features.AllowFutureDate)},
+		{"RECORD_ALLOW_ZERO_PRICE", setBool(&c.Features.AllowZeroPrice)},
+		{"BACKUP_DIR", setString(&c.Features.Backup.Dir)},
+		{"BACKUP_INTERVAL", setDuration(&c.Features.Backup.Interval)},
+		{"BACKUP_KEEP_LAST", setInt(&c.Features.Backup.KeepLast)},
+		{"BACKUP_KEEP_DAILY", setInt(&c.Features.Backup.KeepDaily)},
+		{"BACKUP_KEEP_MONTHLY", setInt(&c.Features.Backup.KeepMonthly)},
+
+		{"MAWINTER_URL", setString(&c.Client.URL)},
+		{"MAWINTER_TOKEN", setString(&c.Client.Token)},
+		{"MAWINTER_OUTPUT", setLower(&c.Client.Output)},
+		{"MAWINTER_TIMEOUT", setDuration(&c.Client.Timeout)},
+	}
+}
+
+// applyEnv は環境変数の値で設定を上書きする
+// 空の環境変数は未設定として扱う
+func (c *Config) applyEnv(lookup func(string) (string, bool), readFile func(string) ([]byte, error)) error {
+	for _, v := range c.envVars() {
+		value, ok := lookup(v.name)
+		ok = ok && value != ""
+
+		if path, found := lookup(v.name + fileSuffix); found && path != "" {
+			if ok {
+				return fmt.Errorf("both %s and %s%s are set; use only one of them", v.name, v.name, fileSuffix)
+			}
+			data, err := readFile(path)
+			if err != nil {
+				return fmt.Errorf("failed to read %s%s: %w", v.name, fileSuffix, err)
+			}
+			// Docker secrets などで付与される末尾の改行は値に含めない
+			value, ok = strings.TrimRight(string(data), "\r\n"), true
+		}
+
+		if !ok {
+			continue
+		}
+		if err := v.set(value); err != nil {
+			return fmt.Errorf("invalid %s: %w", v.name, err)
+		}
+	}
+	return nil
+}
+
+func setString(p *string) func(string) error {
+	return func(v string) error {
+		*p = v
+		return nil
+	}
+}
+
+func setLower(p *string) func(string) error {
+	return func(v string) error {
+		*p = strings.ToLower(v)
+		return nil
+	}
+}
+
+func setInt(p *int) func(string) error {
+	return func(v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", v)
+		}
+		*p = n
+		return nil
+	}
+}
+
+func setBool(p *bool) func(string) error {
+	return func(v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("%q is not a boolean", v)
+		}
+		*p = b
+		return nil
+	}
+}
+
+func setDuration(p *time.Duration) func(string) error {
+	return func(v string) error {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("%q is not a duration (e.g. 30s, 24h)", v)
+		}
+		*p = d
+		return nil
+	}
+}
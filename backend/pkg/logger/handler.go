@@ -0,0 +1,66 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ログに付与する相関情報のキー
+const (
+	TraceIDKey   = "trace_id"
+	SpanIDKey    = "span_id"
+	RequestIDKey = "request_id"
+)
+
+type requestIDKey struct{}
+
+// WithRequestID はリクエスト ID を保持したコンテキストを返す
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext はコンテキストからリクエスト ID を取得する
+// 設定されていない場合は空文字を返す
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// ContextHandler はコンテキストに含まれるトレース ID・スパン ID・リクエスト ID をログに付与する slog.Handler
+// slog.InfoContext などコンテキスト付きで出力したログにのみ付与される
+type ContextHandler struct {
+	slog.Handler
+}
+
+// NewContextHandler は h をラップした ContextHandler を作成する
+func NewContextHandler(h slog.Handler) *ContextHandler {
+	return &ContextHandler{Handler: h}
+}
+
+// Handle は相関情報を属性として追加してから、ラップしたハンドラに渡す
+func (h *ContextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if ctx != nil {
+		if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+			r.AddAttrs(
+				slog.String(TraceIDKey, sc.TraceID().String()),
+				slog.String(SpanIDKey, sc.SpanID().String()),
+			)
+		}
+		if id := RequestIDFromContext(ctx); id != "" {
+			r.AddAttrs(slog.String(RequestIDKey, id))
+		}
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+// WithAttrs は属性を追加したハンドラを返す
+func (h *ContextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return NewContextHandler(h.Handler.WithAttrs(attrs))
+}
+
+// WithGroup はグループを追加したハンドラを返す
+func (h *ContextHandler) WithGroup(name string) slog.Handler {
+	return NewContextHandler(h.Handler.WithGroup(name))
+}
@@ -0,0 +1,72 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestContextHandler(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	spanCtx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want map[string]string // 空文字は出力されないことを表す
+	}{
+		{
+			name: "トレースとリクエスト ID を付与する",
+			ctx:  WithRequestID(spanCtx, "req-1"),
+			want: map[string]string{TraceIDKey: traceID.String(), SpanIDKey: spanID.String(), RequestIDKey: "req-1"},
+		},
+		{
+			name: "スパンがなければリクエスト ID のみ付与する",
+			ctx:  WithRequestID(context.Background(), "req-2"),
+			want: map[string]string{TraceIDKey: "", SpanIDKey: "", RequestIDKey: "req-2"},
+		},
+		{
+			name: "相関情報がなければ何も付与しない",
+			ctx:  context.Background(),
+			want: map[string]string{TraceIDKey: "", SpanIDKey: "", RequestIDKey: ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			// WithAttrs・WithGroup 後のロガーでも付与されることを確認する
+			log := slog.New(NewContextHandler(slog.NewJSONHandler(&buf, nil))).With("component", "test")
+			log.InfoContext(tt.ctx, "hello")
+
+			var got map[string]any
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("failed to parse log: %v", err)
+			}
+			if got["component"] != "test" {
+				t.Errorf("component = %v, want test", got["component"])
+			}
+			for key, want := range tt.want {
+				v, ok := got[key]
+				if want == "" {
+					if ok {
+						t.Errorf("%s should not be set, got %v", key, v)
+					}
+					continue
+				}
+				if v != want {
+					t.Errorf("%s = %v, want %s", key, v, want)
+				}
+			}
+		})
+	}
+}
@@ -1,54 +1,130 @@
 package logger
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"runtime"
+	"strconv"
+	"strings"
 )
 
-// New はJSON形式のslogロガーを作成する
-// エラー時にスタックトレースと呼び出し元を表示する設定
-func New() *slog.Logger {
-	opts := &slog.HandlerOptions{
-		AddSource: true, // 呼び出し元のファイル名と行番号を表示
-		Level:     slog.LevelInfo,
-		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
-			// エラーレベル以上の場合、スタックトレース情報を追加
-			if a.Key == slog.LevelKey {
-				// 型アサーションを安全に実行
-				if level, ok := a.Value.Any().(slog.Level); ok {
-					if level >= slog.LevelError {
-						// スタックトレースを取得
-						stackTrace := getStackTrace()
-						return slog.Group("error_info",
-							slog.String("level", level.String()),
-							slog.String("stack_trace", stackTrace),
-						)
-					}
-				}
-			}
-			return a
-		},
-	}
-
-	handler := slog.NewJSONHandler(os.Stdout, opts)
-	return slog.New(handler)
-}
-
-// getStackTrace はスタックトレースを文字列として取得する
-func getStackTrace() string {
-	const maxDepth = 32
+// ログの出力形式
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// Options はロガーの設定
+type Options struct {
+	Level     slog.Leveler // 出力する最低レベル（nil の場合は Info）。*slog.LevelVar を渡すと実行中に変更できる
+	Format    string       // 出力形式（json, text）。空の場合は json
+	AddSource bool         // 呼び出し元のファイル名と行番号を出力するか
+	Output    io.Writer    // 出力先（nil の場合は標準出力）
+}
+
+// ParseLevel はログレベルの文字列（debug, info, warn, error）を slog.Level に変換する
+// 大文字・小文字は区別しない
+func ParseLevel(s string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(s)); err != nil {
+		return 0, fmt.Errorf("invalid log level %q: must be one of debug, info, warn, error", s)
+	}
+	return level, nil
+}
+
+// LevelName はログレベルを設定ファイルやフラグと同じ小文字の名前で返す
+func LevelName(level slog.Level) string {
+	return strings.ToLower(level.String())
+}
+
+// New は指定された設定で slog ロガーを作成する
+// エラー時にはスタックトレースを出力する
+// コンテキスト付きで出力したログにはトレース ID・スパン ID・リクエスト ID を付与する
+func New(opts Options) (*slog.Logger, error) {
+	if opts.Level == nil {
+		opts.Level = slog.LevelInfo
+	}
+	if opts.Output == nil {
+		opts.Output = os.Stdout
+	}
+
+	handlerOpts := &slog.HandlerOptions{
+		AddSource: opts.AddSource,
+		Level:     opts.Level,
+	}
+
+	var handler slog.Handler
+	switch opts.Format {
+	case FormatJSON, "":
+		handler = slog.NewJSONHandler(opts.Output, handlerOpts)
+	case FormatText:
+		handler = slog.NewTextHandler(opts.Output, handlerOpts)
+	default:
+		return nil, fmt.Errorf("invalid log format %q: must be one of %s, %s", opts.Format, FormatJSON, FormatText)
+	}
+
+	return slog.New(NewContextHandler(&stackTraceHandler{Handler: handler})), nil
+}
+
+// stackTraceKey はスタックトレースを出力する属性のキー
+const stackTraceKey = "stack_trace"
+
+// stackTraceHandler はエラーレベル以上のログにスタックトレースを付与する slog.Handler
+type stackTraceHandler struct {
+	slog.Handler
+}
+
+func (h *stackTraceHandler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level >= slog.LevelError {
+		r.AddAttrs(slog.String(stackTraceKey, formatStackTrace(callersFrom(r.PC))))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *stackTraceHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &stackTraceHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *stackTraceHandler) WithGroup(name string) slog.Handler {
+	return &stackTraceHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+// callersFrom は現在のコールスタックのうち、ログの呼び出し元（pc）以降のプログラムカウンタを返す
+// slog とこのパッケージ内部のフレームを除くため、pc が見つからない場合は取得したスタック全体を返す
+func callersFrom(pc uintptr) []uintptr {
+	const maxDepth = 64
 	var pcs [maxDepth]uintptr
-	n := runtime.Callers(3, pcs[:])
+	n := runtime.Callers(2, pcs[:])
+	for i := 0; i < n; i++ {
+		if pcs[i] == pc {
+			return pcs[i:n]
+		}
+	}
+	return pcs[:n]
+}
+
+// formatStackTrace はスタックトレースを「関数名\n\tファイル:行番号\n」の形式の文字列にする
+func formatStackTrace(pcs []uintptr) string {
+	if len(pcs) == 0 {
+		return ""
+	}
 
-	trace := ""
-	frames := runtime.CallersFrames(pcs[:n])
+	var b strings.Builder
+	frames := runtime.CallersFrames(pcs)
 	for {
 		frame, more := frames.Next()
-		trace += frame.Function + "\n\t" + frame.File + ":" + string(rune(frame.Line)) + "\n"
+		b.WriteString(frame.Function)
+		b.WriteString("\n\t")
+		b.WriteString(frame.File)
+		b.WriteString(":")
+		b.WriteString(strconv.Itoa(frame.Line))
+		b.WriteString("\n")
 		if !more {
 			break
 		}
 	}
-	return trace
+	return b.String()
 }
@@ -0,0 +1,147 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFormatStackTrace(t *testing.T) {
+	var pcs [1]uintptr
+	runtime.Callers(1, pcs[:])
+	_, file, line, _ := runtime.Caller(0)
+	line-- // runtime.Callers の呼び出し行
+
+	got := formatStackTrace(pcs[:])
+	want := "github.com/azuki774/mawinter/pkg/logger.TestFormatStackTrace\n\t" + file + ":" + strconv.Itoa(line) + "\n"
+	if got != want {
+		t.Errorf("formatStackTrace() = %q, want %q", got, want)
+	}
+
+	if got := formatStackTrace(nil); got != "" {
+		t.Errorf("formatStackTrace(nil) = %q, want empty", got)
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      Options
+		wantErr   bool
+		checkFunc func(t *testing.T, out string)
+	}{
+		{
+			name: "JSON 形式で出力する",
+			opts: Options{Format: FormatJSON},
+			checkFunc: func(t *testing.T, out string) {
+				var v map[string]any
+				if err := json.Unmarshal([]byte(strings.Split(out, "\n")[0]), &v); err != nil {
+					t.Fatalf("expected JSON output, got %q", out)
+				}
+				if _, ok := v[slog.SourceKey]; ok {
+					t.Errorf("source should not be added: %q", out)
+				}
+			},
+		},
+		{
+			name: "テキスト形式で呼び出し元を出力する",
+			opts: Options{Format: FormatText, AddSource: true},
+			checkFunc: func(t *testing.T, out string) {
+				if !strings.Contains(out, "msg=info") || !strings.Contains(out, "source=") {
+					t.Errorf("unexpected text output: %q", out)
+				}
+			},
+		},
+		{
+			name: "指定したレベル未満は出力しない",
+			opts: Options{Level: slog.LevelError},
+			checkFunc: func(t *testing.T, out string) {
+				if strings.Contains(out, `"msg":"info"`) || !strings.Contains(out, `"msg":"error"`) {
+					t.Errorf("unexpected output: %q", out)
+				}
+			},
+		},
+		{
+			name: "エラーレベルにはレベルを保ったままスタックトレースを付与する",
+			opts: Options{},
+			checkFunc: func(t *testing.T, out string) {
+				lines := strings.Split(strings.TrimSpace(out), "\n")
+				var info, errLog map[string]any
+				if err := json.Unmarshal([]byte(lines[0]), &info); err != nil {
+					t.Fatalf("failed to parse log: %v", err)
+				}
+				if err := json.Unmarshal([]byte(lines[1]), &errLog); err != nil {
+					t.Fatalf("failed to parse log: %v", err)
+				}
+				if _, ok := info[stackTraceKey]; ok {
+					t.Error("stack trace should not be added to info logs")
+				}
+				if errLog[slog.LevelKey] != "ERROR" {
+					t.Errorf("level = %v, want ERROR", errLog[slog.LevelKey])
+				}
+				trace, _ := errLog[stackTraceKey].(string)
+				// 先頭のフレームはログの呼び出し元で、行番号は数値で出力される
+				if !regexp.MustCompile(`^github\.com/azuki774/mawinter/pkg/logger\.TestNew\.func\d+\n\t\S+logger_test\.go:\d+\n`).MatchString(trace) {
+					t.Errorf("unexpected stack trace: %q", trace)
+				}
+			},
+		},
+		{
+			name:    "不正な形式",
+			opts:    Options{Format: "xml"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			tt.opts.Output = &buf
+			log, err := New(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			log.Info("info")
+			log.Error("error")
+			tt.checkFunc(t, buf.String())
+		})
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    slog.Level
+		wantErr bool
+	}{
+		{in: "debug", want: slog.LevelDebug},
+		{in: "INFO", want: slog.LevelInfo},
+		{in: "warn", want: slog.LevelWarn},
+		{in: "error", want: slog.LevelError},
+		{in: "verbose", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseLevel(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLevel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ParseLevel() = %v, want %v", got, tt.want)
+			}
+			if !tt.wantErr && LevelName(got) != strings.ToLower(tt.in) {
+				t.Errorf("LevelName() = %q, want %q", LevelName(got), strings.ToLower(tt.in))
+			}
+		})
+	}
+}
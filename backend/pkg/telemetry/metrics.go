@@ -0,0 +1,89 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	otelprom "go.opentelemetry.io/otel/exporters/prometheus"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// MetricsExportInterval は OTLP でメトリクスを送信する間隔
+const MetricsExportInterval = 30 * time.Second
+
+// InitMetrics は指定されたサービス向けのグローバルなメータープロバイダーを初期化する
+// メトリクスは常に Prometheus 形式で返却するハンドラから公開し、endpoint が指定された場合は同じメトリクスを OTLP でも送信する
+// 戻り値の bool は OTLP での送信が有効かどうかを表す
+func InitMetrics(ctx context.Context, endpoint, serviceName, version string) (http.Handler, ShutdownFunc, bool, error) {
+	res, err := newResource(serviceName, version)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	// Go ランタイム・プロセスのメトリクスも合わせて公開する
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	promExporter, err := otelprom.New(otelprom.WithRegisterer(registry))
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to build Prometheus exporter: %w", err)
+	}
+	opts := []sdkmetric.Option{
+		sdkmetric.WithResource(res),
+		sdkmetric.WithReader(promExporter),
+	}
+
+	endpoint = strings.TrimSpace(endpoint)
+	otlpEnabled := endpoint != ""
+	if otlpEnabled {
+		exporter, err := newMetricExporter(ctx, endpoint)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("failed to build OTLP metric exporter: %w", err)
+		}
+		opts = append(opts, sdkmetric.WithReader(
+			sdkmetric.NewPeriodicReader(exporter, sdkmetric.WithInterval(MetricsExportInterval)),
+		))
+	}
+
+	mp := sdkmetric.NewMeterProvider(opts...)
+	otel.SetMeterProvider(mp)
+
+	handler := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+	shutdown := func(ctx context.Context) error {
+		// 終了前に OTLP の送信先へ最新の値を送る
+		return errors.Join(mp.ForceFlush(ctx), mp.Shutdown(ctx))
+	}
+	return handler, shutdown, otlpEnabled, nil
+}
+
+func newMetricExporter(ctx context.Context, raw string) (sdkmetric.Exporter, error) {
+	ep, err := parseEndpoint(raw, metricsPath)
+	if err != nil {
+		return nil, err
+	}
+	// トレースの送信先としてパスまで指定されている場合は、同じコレクタのメトリクス用のパスに送る
+	if strings.HasSuffix(ep.path, tracesPath) {
+		ep.path = strings.TrimSuffix(ep.path, tracesPath) + metricsPath
+	}
+
+	opts := []otlpmetrichttp.Option{
+		otlpmetrichttp.WithURLPath(ep.path),
+		otlpmetrichttp.WithEndpoint(ep.host),
+	}
+	if ep.insecure {
+		opts = append(opts, otlpmetrichttp.WithInsecure())
+	}
+	return otlpmetrichttp.New(ctx, opts...)
+}
@@ -36,16 +36,9 @@ func Init(ctx context.Context, endpoint, serviceName, version string) (ShutdownF
 		return nil, false, fmt.Errorf("failed to build OTLP exporter: %w", err)
 	}
 
-	res, err := resource.Merge(
-		resource.Default(),
-		resource.NewWithAttributes(
-			semconv.SchemaURL,
-			semconv.ServiceName(serviceName),
-			semconv.ServiceVersion(version),
-		),
-	)
+	res, err := newResource(serviceName, version)
 	if err != nil {
-		return nil, false, fmt.Errorf("failed to build resource: %w", err)
+		return nil, false, err
 	}
 
 	tp := sdktrace.NewTracerProvider(
@@ -72,18 +65,66 @@ func newHTTPExporter(ctx context.Context, endpoint string) (sdktrace.SpanExporte
 	return otlptrace.New(ctx, client)
 }
 
+// newResource はサービス名とバージョンを含むリソースを作成する
+func newResource(serviceName, version string) (*resource.Resource, error) {
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+			semconv.ServiceVersion(version),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build resource: %w", err)
+	}
+	return res, nil
+}
+
 func buildHTTPClientOptions(raw string) ([]otlptracehttp.Option, error) {
-	urlPath := "/v1/traces"
+	ep, err := parseEndpoint(raw, tracesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithURLPath(ep.path),
+		otlptracehttp.WithEndpoint(ep.host),
+	}
+	if ep.insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+
+	return opts, nil
+}
+
+// OTLP/HTTP のシグナルごとのデフォルトのパス
+const (
+	tracesPath  = "/v1/traces"
+	metricsPath = "/v1/metrics"
+)
+
+// otlpEndpoint は OTLP_SERVER から解釈した送信先
+type otlpEndpoint struct {
+	host     string
+	path     string
+	insecure bool
+}
+
+// parseEndpoint は OTLP_SERVER の値を解釈する
+// パスが指定されていない場合は defaultPath を使用する
+func parseEndpoint(raw, defaultPath string) (otlpEndpoint, error) {
+	urlPath := defaultPath
 	useTLS := true
 	endpoint := raw
 
 	if strings.Contains(raw, "://") {
 		parsed, err := url.Parse(raw)
 		if err != nil {
-			return nil, fmt.Errorf("invalid OTLP endpoint: %w", err)
+			return otlpEndpoint{}, fmt.Errorf("invalid OTLP endpoint: %w", err)
 		}
 		if parsed.Host == "" {
-			return nil, fmt.Errorf("OTLP endpoint host is empty: %s", raw)
+			return otlpEndpoint{}, fmt.Errorf("OTLP endpoint host is empty: %s", raw)
 		}
 		endpoint = parsed.Host
 		if parsed.Path != "" && parsed.Path != "/" {
@@ -95,7 +136,7 @@ func buildHTTPClientOptions(raw string) ([]otlptracehttp.Option, error) {
 		case "https":
 			useTLS = true
 		default:
-			return nil, fmt.Errorf("unsupported OTLP endpoint scheme %q", parsed.Scheme)
+			return otlpEndpoint{}, fmt.Errorf("unsupported OTLP endpoint scheme %q", parsed.Scheme)
 		}
 	}
 
@@ -106,16 +147,8 @@ func buildHTTPClientOptions(raw string) ([]otlptracehttp.Option, error) {
 
 	endpoint = strings.TrimRight(endpoint, "/")
 	if endpoint == "" {
-		return nil, fmt.Errorf("OTLP endpoint host is empty: %s", raw)
-	}
-
-	opts := []otlptracehttp.Option{
-		otlptracehttp.WithURLPath(urlPath),
-		otlptracehttp.WithEndpoint(endpoint),
-	}
-	if !useTLS {
-		opts = append(opts, otlptracehttp.WithInsecure())
+		return otlpEndpoint{}, fmt.Errorf("OTLP endpoint host is empty: %s", raw)
 	}
 
-	return opts, nil
+	return otlpEndpoint{host: endpoint, path: urlPath, insecure: !useTLS}, nil
 }